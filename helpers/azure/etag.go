@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// WriteWithETag writes a resource using optimistic concurrency: a new resource is only created when it doesn't already
+// exist, and an existing resource is only updated when it still has the ETag which was last read into the state - so
+// that changes made outside of Terraform since then (for example by another configuration managing the same resource)
+// aren't silently overwritten. When no ETag is known the existing resource is updated unconditionally.
+//
+// The write function is called once with the `If-Match` and `If-None-Match` values to send, and should return the
+// HTTP Response alongside any error.
+func WriteWithETag(id fmt.Stringer, isNewResource bool, etag string, write func(ifMatch *string, ifNoneMatch *string) (*http.Response, error)) error {
+	var ifMatch, ifNoneMatch *string
+	if isNewResource {
+		ifNoneMatch = pointerToString("*")
+	} else if etag != "" {
+		ifMatch = pointerToString(etag)
+	}
+
+	resp, err := write(ifMatch, ifNoneMatch)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusPreconditionFailed {
+			if isNewResource {
+				return fmt.Errorf("%s was created outside of Terraform and should be imported into the State: %+v", id, err)
+			}
+			return fmt.Errorf("%s has been modified outside of Terraform since it was last read - refresh the State and review the plan before applying again: %+v", id, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func pointerToString(input string) *string {
+	return &input
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azure_test
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type etagTestId struct{}
+
+func (etagTestId) String() string {
+	return "Record Set \"example\""
+}
+
+func TestWriteWithETag(t *testing.T) {
+	testData := []struct {
+		name                string
+		isNewResource       bool
+		etag                string
+		statusCode          int
+		expectedIfMatch     string
+		expectedIfNoneMatch string
+		expectedError       string
+	}{
+		{
+			name:                "create",
+			isNewResource:       true,
+			statusCode:          http.StatusCreated,
+			expectedIfNoneMatch: "*",
+		},
+		{
+			name:                "create when created concurrently",
+			isNewResource:       true,
+			statusCode:          http.StatusPreconditionFailed,
+			expectedIfNoneMatch: "*",
+			expectedError:       "should be imported into the State",
+		},
+		{
+			name:            "update",
+			etag:            "abc123",
+			statusCode:      http.StatusOK,
+			expectedIfMatch: "abc123",
+		},
+		{
+			name:            "update when modified outside of terraform",
+			etag:            "abc123",
+			statusCode:      http.StatusPreconditionFailed,
+			expectedIfMatch: "abc123",
+			expectedError:   "modified outside of Terraform",
+		},
+		{
+			name:       "update without a known etag",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:            "other error",
+			etag:            "abc123",
+			statusCode:      http.StatusBadRequest,
+			expectedIfMatch: "abc123",
+			expectedError:   "bad request",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		calls := 0
+		err := azure.WriteWithETag(etagTestId{}, v.isNewResource, v.etag, func(ifMatch *string, ifNoneMatch *string) (*http.Response, error) {
+			calls++
+			if actual := pointer.From(ifMatch); actual != v.expectedIfMatch {
+				t.Fatalf("expected If-Match to be %q but got %q", v.expectedIfMatch, actual)
+			}
+			if actual := pointer.From(ifNoneMatch); actual != v.expectedIfNoneMatch {
+				t.Fatalf("expected If-None-Match to be %q but got %q", v.expectedIfNoneMatch, actual)
+			}
+
+			resp := &http.Response{StatusCode: v.statusCode}
+			if v.statusCode >= 300 {
+				return resp, errors.New("bad request")
+			}
+			return resp, nil
+		})
+
+		if calls != 1 {
+			t.Fatalf("expected the write to be made once but it was made %d times", calls)
+		}
+		if v.expectedError == "" && err != nil {
+			t.Fatalf("expected no error but got %+v", err)
+		}
+		if v.expectedError != "" && (err == nil || !strings.Contains(err.Error(), v.expectedError)) {
+			t.Fatalf("expected an error containing %q but got %v", v.expectedError, err)
+		}
+	}
+}
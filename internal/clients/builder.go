@@ -28,7 +28,9 @@ type ClientBuilder struct {
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 
+	AuditLogFilePath           string
 	CustomCorrelationRequestID string
+	MaxConcurrentReads         int
 	MetadataHost               string
 	PartnerID                  string
 	SubscriptionID             string
@@ -117,6 +119,11 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf("unable to determine resource manager endpoint for the current environment")
 	}
 
+	auditLogger, err := common.NewAuditLogger(builder.AuditLogFilePath)
+	if err != nil {
+		return nil, err
+	}
+
 	client := Client{
 		Account: account,
 	}
@@ -154,6 +161,9 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
+		MaxConcurrentReads:      builder.MaxConcurrentReads,
+
+		AuditLogger: auditLogger,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
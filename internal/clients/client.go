@@ -11,6 +11,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/validation"
 	aadb2c_v2021_04_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview"
 	analysisservices_v2017_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01"
+	apicenter_v2024_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01"
 	azurestackhci_v2024_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01"
 	datadog_v2021_03_01 "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01"
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
@@ -29,6 +30,7 @@ import (
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
 	advisor "github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/client"
 	analysisServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/client"
+	apiCenter "github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/client"
 	apiManagement "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/client"
 	appConfiguration "github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/client"
 	applicationInsights "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/client"
@@ -162,9 +164,13 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// ReadLimiter bounds the number of requests made concurrently when reading the child items of resources
+	ReadLimiter *common.ReadLimiter
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
+	ApiCenter                         *apicenter_v2024_03_01.Client
 	ApiManagement                     *apiManagement.Client
 	AppConfiguration                  *appConfiguration.Client
 	AppInsights                       *applicationInsights.Client
@@ -301,6 +307,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	}
 
 	client.Features = o.Features
+	client.ReadLimiter = common.NewReadLimiter(o.MaxConcurrentReads)
 	client.StopContext = ctx
 
 	var err error
@@ -314,6 +321,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.AnalysisServices, err = analysisServices.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for AnalysisServices: %+v", err)
 	}
+	if client.ApiCenter, err = apiCenter.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for ApiCenter: %+v", err)
+	}
 	if client.ApiManagement, err = apiManagement.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ApiManagement: %+v", err)
 	}
@@ -536,7 +546,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.MSSQL, err = mssql.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for MSSQL: %+v", err)
 	}
-	client.MSSQLManagedInstance = mssqlmanagedinstance.NewClient(o)
+	if client.MSSQLManagedInstance, err = mssqlmanagedinstance.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for MSSQL Managed Instance: %+v", err)
+	}
 	if client.MySQL, err = mysql.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for MySQL: %+v", err)
 	}
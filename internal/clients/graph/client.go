@@ -149,3 +149,58 @@ func UserPrincipalObjectID(ctx context.Context, authorizer auth.Authorizer, envi
 
 	return model.ID, nil
 }
+
+type ServicePrincipalModel struct {
+	ID                     *string `json:"id"`
+	AppId                  *string `json:"appId"`
+	AppOwnerOrganizationId *string `json:"appOwnerOrganizationId"`
+	DisplayName            *string `json:"displayName"`
+}
+
+// ServicePrincipals returns the Service Principals within the tenant matching the specified OData filter, such as
+// `displayName eq 'Azure Cosmos DB'`
+func ServicePrincipals(ctx context.Context, authorizer auth.Authorizer, environment environments.Environment, filter string) (*[]ServicePrincipalModel, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, time.Now().Add(5*time.Minute))
+		defer cancel()
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		OptionsObject: options{
+			query: odata.Query{
+				Filter: filter,
+			},
+		},
+		Path: "/servicePrincipals",
+	}
+
+	client, err := graphClient(authorizer, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building new request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	model := struct {
+		ServicePrincipals []ServicePrincipalModel `json:"value"`
+	}{}
+	if err := resp.Unmarshal(&model); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+
+	return &model.ServicePrincipals, nil
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const (
+	auditLogResultSucceeded = "Succeeded"
+	auditLogResultFailed    = "Failed"
+
+	headerRequestID = "x-ms-request-id"
+)
+
+// AuditLogEntry is a single line within the Audit Log, describing a request made by the Provider
+type AuditLogEntry struct {
+	Timestamp     string `json:"timestamp"`
+	Method        string `json:"method"`
+	ResourceType  string `json:"resource_type,omitempty"`
+	ResourceId    string `json:"resource_id"`
+	Host          string `json:"host"`
+	ApiVersion    string `json:"api_version,omitempty"`
+	DurationMs    int64  `json:"duration_ms"`
+	CorrelationId string `json:"correlation_id,omitempty"`
+	RequestId     string `json:"request_id,omitempty"`
+	StatusCode    int    `json:"status_code,omitempty"`
+	Result        string `json:"result"`
+	Error         string `json:"error,omitempty"`
+}
+
+// AuditLogger writes an AuditLogEntry for each request made by the Provider to a file, as JSON Lines
+type AuditLogger struct {
+	file   *os.File
+	closed bool
+
+	// pending holds the requests made via go-azure-sdk which haven't been recorded yet
+	pending map[*auditLogRequest]struct{}
+	lock    sync.Mutex
+}
+
+var (
+	// auditLoggers holds the Audit Loggers which are open, so that they can be closed when the Provider stops
+	auditLoggers     = make(map[*AuditLogger]struct{})
+	auditLoggersLock sync.Mutex
+)
+
+// NewAuditLogger opens (or creates) the Audit Log at the specified path, new entries are appended to any existing entries
+// so that the Audit Log can span multiple Terraform runs. A nil AuditLogger is returned when no path is specified.
+func NewAuditLogger(path string) (*AuditLogger, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the Audit Log %q: %+v", path, err)
+	}
+
+	logger := &AuditLogger{
+		file:    file,
+		pending: make(map[*auditLogRequest]struct{}),
+	}
+
+	auditLoggersLock.Lock()
+	defer auditLoggersLock.Unlock()
+	auditLoggers[logger] = struct{}{}
+
+	return logger, nil
+}
+
+// Close records any requests which are still awaiting a response as failed, then flushes and closes the Audit Log.
+// Entries for requests which complete after this point are discarded.
+func (l *AuditLogger) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	l.lock.Lock()
+	pending := make([]*auditLogRequest, 0, len(l.pending))
+	for r := range l.pending {
+		pending = append(pending, r)
+	}
+	l.lock.Unlock()
+
+	for _, r := range pending {
+		err := errors.New("the Provider stopped before a response was received")
+		if transportErr := r.transportError(); transportErr != nil {
+			err = fmt.Errorf("%s: %+v", err, transportErr)
+		}
+		l.finish(r, nil, err)
+	}
+
+	auditLoggersLock.Lock()
+	delete(auditLoggers, l)
+	auditLoggersLock.Unlock()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.closed {
+		return nil
+	}
+	l.closed = true
+
+	if err := l.file.Sync(); err != nil {
+		l.file.Close()
+		return fmt.Errorf("flushing the Audit Log %q: %+v", l.file.Name(), err)
+	}
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("closing the Audit Log %q: %+v", l.file.Name(), err)
+	}
+
+	return nil
+}
+
+// CloseAuditLoggers closes each Audit Logger which is still open - and should be called once the Provider has stopped
+func CloseAuditLoggers() {
+	auditLoggersLock.Lock()
+	loggers := make([]*AuditLogger, 0, len(auditLoggers))
+	for l := range auditLoggers {
+		loggers = append(loggers, l)
+	}
+	auditLoggersLock.Unlock()
+
+	for _, l := range loggers {
+		if err := l.Close(); err != nil {
+			log.Printf("[WARN] %+v", err)
+		}
+	}
+}
+
+func (l *AuditLogger) write(entry AuditLogEntry) {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] marshalling the Audit Log entry for %s %s: %+v", entry.Method, entry.ResourceId, err)
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.closed {
+		log.Printf("[WARN] discarding the Audit Log entry for %s %s since the Audit Log has been closed", entry.Method, entry.ResourceId)
+		return
+	}
+
+	// a single write per entry ensures entries aren't interleaved when multiple Provider instances share the same file
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] writing the Audit Log entry for %s %s: %+v", entry.Method, entry.ResourceId, err)
+	}
+}
+
+func (l *AuditLogger) record(request *http.Request, response *http.Response, start, end time.Time, requestErr error) {
+	entry := AuditLogEntry{
+		Timestamp:     start.UTC().Format(time.RFC3339Nano),
+		Method:        request.Method,
+		ResourceType:  auditLogResourceType(request.URL.Path),
+		ResourceId:    request.URL.Path,
+		Host:          request.URL.Host,
+		ApiVersion:    request.URL.Query().Get("api-version"),
+		DurationMs:    end.Sub(start).Milliseconds(),
+		CorrelationId: request.Header.Get(HeaderCorrelationRequestID),
+		Result:        auditLogResultSucceeded,
+	}
+
+	if response != nil {
+		entry.StatusCode = response.StatusCode
+		entry.RequestId = response.Header.Get(headerRequestID)
+
+		// Azure echoes the correlation request ID, or generates one when it wasn't specified in the request
+		if v := response.Header.Get(HeaderCorrelationRequestID); v != "" {
+			entry.CorrelationId = v
+		}
+
+		if response.StatusCode >= http.StatusBadRequest {
+			entry.Result = auditLogResultFailed
+		}
+	}
+
+	if requestErr != nil {
+		entry.Result = auditLogResultFailed
+		entry.Error = requestErr.Error()
+	}
+
+	l.write(entry)
+}
+
+// auditLogResourceType returns the Resource Type for the Resource Manager ID in the path of a request, for example
+// `Microsoft.Network/virtualNetworks/subnets` - or an empty string when this can't be determined
+func auditLogResourceType(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !strings.EqualFold(segments[i], "providers") || i+2 >= len(segments) {
+			continue
+		}
+
+		resourceType := []string{segments[i+1]}
+		for j := i + 2; j < len(segments); j += 2 {
+			resourceType = append(resourceType, segments[j])
+		}
+		return strings.Join(resourceType, "/")
+	}
+
+	if len(segments) >= 3 && strings.EqualFold(segments[0], "subscriptions") && strings.EqualFold(segments[2], "resourceGroups") {
+		return "Microsoft.Resources/resourceGroups"
+	}
+	if len(segments) >= 1 && strings.EqualFold(segments[0], "subscriptions") {
+		return "Microsoft.Resources/subscriptions"
+	}
+
+	return ""
+}
+
+// auditLogRequest tracks a request made via go-azure-sdk from when it's sent until it's recorded in the Audit Log.
+//
+// go-azure-sdk builds a new http.Client for each request (which retries the request internally) and only calls the
+// response middlewares once a response has been received - so requests which fail without a response (for example
+// due to a connection error, or once the retries have been exhausted) are observed via an httptrace.ClientTrace, and
+// recorded once the context of the request is done.
+type auditLogRequest struct {
+	request *http.Request
+	start   time.Time
+	stop    func() bool
+	once    sync.Once
+
+	// lastErr is the last error returned by the transport whilst sending the request, and when this occurred
+	lastErr   error
+	lastErrAt time.Time
+	lock      sync.Mutex
+}
+
+func (r *auditLogRequest) failed(err error) {
+	if err == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.lastErr = err
+	r.lastErrAt = time.Now()
+}
+
+func (r *auditLogRequest) transportError() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.lastErr
+}
+
+func (r *auditLogRequest) end() time.Time {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.lastErr != nil {
+		return r.lastErrAt
+	}
+	return time.Now()
+}
+
+type auditLogRequestKey struct{}
+
+func (l *AuditLogger) begin(request *http.Request) *http.Request {
+	r := &auditLogRequest{
+		request: request,
+		start:   time.Now(),
+	}
+
+	l.lock.Lock()
+	l.pending[r] = struct{}{}
+	l.lock.Unlock()
+
+	ctx := httptrace.WithClientTrace(request.Context(), &httptrace.ClientTrace{
+		DNSDone: func(info httptrace.DNSDoneInfo) {
+			r.failed(info.Err)
+		},
+		ConnectDone: func(_, _ string, err error) {
+			r.failed(err)
+		},
+		TLSHandshakeDone: func(_ tls.ConnectionState, err error) {
+			r.failed(err)
+		},
+		WroteRequest: func(info httptrace.WroteRequestInfo) {
+			r.failed(info.Err)
+		},
+	})
+	ctx = context.WithValue(ctx, auditLogRequestKey{}, r)
+
+	// the response middlewares aren't called when no response was received, in which case the request is recorded
+	// once its context is done - which happens when the operation that made the request completes
+	r.stop = context.AfterFunc(ctx, func() {
+		err := r.transportError()
+		if err == nil {
+			err = errors.New("no response was received")
+		}
+		l.finish(r, nil, err)
+	})
+
+	return request.WithContext(ctx)
+}
+
+func (l *AuditLogger) finish(r *auditLogRequest, response *http.Response, err error) {
+	r.once.Do(func() {
+		if r.stop != nil {
+			r.stop()
+		}
+
+		l.lock.Lock()
+		delete(l.pending, r)
+		l.lock.Unlock()
+
+		end := time.Now()
+		if response == nil {
+			end = r.end()
+		}
+		l.record(r.request, response, r.start, end, err)
+	})
+}
+
+func auditLogRequestMiddleware(logger *AuditLogger) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		return logger.begin(request), nil
+	}
+}
+
+func auditLogResponseMiddleware(logger *AuditLogger) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if r, ok := request.Context().Value(auditLogRequestKey{}).(*auditLogRequest); ok {
+			logger.finish(r, response, nil)
+			return response, nil
+		}
+
+		now := time.Now()
+		logger.record(request, response, now, now, nil)
+		return response, nil
+	}
+}
+
+// auditLogSender wraps the autorest.Sender used by the legacy go-autorest clients, so that requests made via them are
+// also recorded in the Audit Log
+func auditLogSender(logger *AuditLogger, sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		start := time.Now()
+		response, err := sender.Do(request)
+		logger.record(request, response, start, time.Now(), err)
+		return response, err
+	})
+}
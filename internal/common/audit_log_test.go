@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestAuditLogResourceType(t *testing.T) {
+	cases := []struct {
+		Path     string
+		Expected string
+	}{
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Expected: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+			Expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/example/providers/Microsoft.Insights/diagnosticSettings/example",
+			Expected: "Microsoft.Insights/diagnosticSettings",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Expected: "Microsoft.Resources/resourceGroups",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: "Microsoft.Resources/subscriptions",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers",
+			Expected: "Microsoft.Resources/subscriptions",
+		},
+		{
+			Path:     "/container/blob.txt",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := auditLogResourceType(tc.Path); actual != tc.Expected {
+			t.Fatalf("expected the Resource Type for %q to be %q but got %q", tc.Path, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAuditLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger, err := NewAuditLogger(path)
+	if err != nil {
+		t.Fatalf("building the Audit Logger: %+v", err)
+	}
+
+	resourceId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	requestUrl, _ := url.Parse(fmt.Sprintf("https://management.azure.com%s?api-version=2022-09-01", resourceId))
+
+	// requests made via go-azure-sdk
+	request := &http.Request{Method: http.MethodPut, URL: requestUrl, Header: http.Header{}}
+	request.Header.Set(HeaderCorrelationRequestID, "11111111-1111-1111-1111-111111111111")
+	request, _ = auditLogRequestMiddleware(logger)(request)
+	response := &http.Response{StatusCode: http.StatusCreated, Header: http.Header{}}
+	response.Header.Set(headerRequestID, "22222222-2222-2222-2222-222222222222")
+	if _, err := auditLogResponseMiddleware(logger)(request, response); err != nil {
+		t.Fatalf("recording the request: %+v", err)
+	}
+
+	// requests made via go-autorest
+	sender := auditLogSender(logger, autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, fmt.Errorf("connection reset")
+	}))
+	if _, err := sender.Do(&http.Request{Method: http.MethodDelete, URL: requestUrl, Header: http.Header{}}); err == nil {
+		t.Fatalf("expected the error from the wrapped sender to be returned")
+	}
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("closing the Audit Logger: %+v", err)
+	}
+
+	entries := readAuditLog(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 Audit Log entries but got %d: %+v", len(entries), entries)
+	}
+
+	first := entries[0]
+	if first.Method != http.MethodPut || first.ResourceId != resourceId || first.ResourceType != "Microsoft.Resources/resourceGroups" || first.ApiVersion != "2022-09-01" {
+		t.Fatalf("unexpected request details in the first Audit Log entry: %+v", first)
+	}
+	if first.CorrelationId != "11111111-1111-1111-1111-111111111111" || first.RequestId != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("unexpected IDs in the first Audit Log entry: %+v", first)
+	}
+	if first.StatusCode != http.StatusCreated || first.Result != auditLogResultSucceeded {
+		t.Fatalf("unexpected result in the first Audit Log entry: %+v", first)
+	}
+
+	second := entries[1]
+	if second.Method != http.MethodDelete || second.Result != auditLogResultFailed || second.Error != "connection reset" {
+		t.Fatalf("unexpected second Audit Log entry: %+v", second)
+	}
+}
+
+func TestNewAuditLoggerDisabled(t *testing.T) {
+	logger, err := NewAuditLogger("")
+	if err != nil {
+		t.Fatalf("building the Audit Logger: %+v", err)
+	}
+	if logger != nil {
+		t.Fatalf("expected no Audit Logger when no path is specified")
+	}
+}
+
+func TestAuditLoggerRequestWithoutResponse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	logger, err := NewAuditLogger(path)
+	if err != nil {
+		t.Fatalf("building the Audit Logger: %+v", err)
+	}
+
+	// a server which is no longer listening, so that connecting to it fails
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	send := func(ctx context.Context, method string) {
+		request, err := http.NewRequestWithContext(ctx, method, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+		if err != nil {
+			t.Fatalf("building the request: %+v", err)
+		}
+		request, _ = auditLogRequestMiddleware(logger)(request)
+		if _, err := http.DefaultClient.Do(request); err == nil {
+			t.Fatalf("expected the request to fail")
+		}
+	}
+
+	// a request whose context is done once it has failed
+	ctx, cancel := context.WithCancel(context.Background())
+	send(ctx, http.MethodGet)
+	cancel()
+
+	for i := 0; ; i++ {
+		logger.lock.Lock()
+		pending := len(logger.pending)
+		logger.lock.Unlock()
+		if pending == 0 {
+			break
+		}
+		if i == 50 {
+			t.Fatalf("expected the request to be recorded once its context was done")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// a request which is still pending when the Audit Logger is closed
+	send(context.Background(), http.MethodPut)
+
+	if err := logger.Close(); err != nil {
+		t.Fatalf("closing the Audit Logger: %+v", err)
+	}
+	if err := logger.Close(); err != nil {
+		t.Fatalf("closing the Audit Logger a second time: %+v", err)
+	}
+
+	entries := readAuditLog(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 Audit Log entries but got %d: %+v", len(entries), entries)
+	}
+
+	first := entries[0]
+	if first.Method != http.MethodGet || first.Result != auditLogResultFailed || first.StatusCode != 0 || !strings.Contains(first.Error, "refused") {
+		t.Fatalf("unexpected first Audit Log entry: %+v", first)
+	}
+
+	second := entries[1]
+	if second.Method != http.MethodPut || second.Result != auditLogResultFailed || !strings.Contains(second.Error, "the Provider stopped") || !strings.Contains(second.Error, "refused") {
+		t.Fatalf("unexpected second Audit Log entry: %+v", second)
+	}
+}
+
+func readAuditLog(t *testing.T, path string) []AuditLogEntry {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("opening the Audit Log: %+v", err)
+	}
+	defer file.Close()
+
+	entries := make([]AuditLogEntry, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("unmarshalling the Audit Log entry %q: %+v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
@@ -52,6 +52,12 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// MaxConcurrentReads is the number of requests which can be made concurrently when reading the child items of resources
+	MaxConcurrentReads int
+
+	// AuditLogger records each request made by the clients, when the Audit Log is enabled
+	AuditLogger *AuditLogger
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -75,6 +81,11 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+
+	if o.AuditLogger != nil {
+		c.AppendRequestMiddleware(auditLogRequestMiddleware(o.AuditLogger))
+		c.AppendResponseMiddleware(auditLogResponseMiddleware(o.AuditLogger))
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,6 +94,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if o.AuditLogger != nil {
+		c.Sender = auditLogSender(o.AuditLogger, c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
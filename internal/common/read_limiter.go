@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultMaxConcurrentReads is the number of requests which can be made concurrently when reading the child items of
+// resources, when this isn't configured in the Provider block
+const DefaultMaxConcurrentReads = 5
+
+// ReadLimiter bounds the number of requests which are made concurrently when reading the child items of resources
+// (for example the settings of an API Management Service) - the limit is shared across all resources within the Provider
+type ReadLimiter struct {
+	slots chan struct{}
+}
+
+func NewReadLimiter(maxConcurrentReads int) *ReadLimiter {
+	if maxConcurrentReads <= 0 {
+		maxConcurrentReads = DefaultMaxConcurrentReads
+	}
+
+	return &ReadLimiter{
+		slots: make(chan struct{}, maxConcurrentReads),
+	}
+}
+
+// Run calls each of the functions concurrently, within the limit of the ReadLimiter, and waits for them to complete.
+// The first error returned by a function is returned, and the context passed to the remaining functions is cancelled.
+//
+// The functions are called sequentially when the ReadLimiter is nil. Since `*pluginsdk.ResourceData` isn't safe for
+// concurrent use, the functions should only retrieve data - which should be set into the state once Run has returned.
+func (l *ReadLimiter) Run(ctx context.Context, funcs ...func(ctx context.Context) error) error {
+	if l == nil {
+		for _, f := range funcs {
+			if err := f(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	for _, f := range funcs {
+		wg.Add(1)
+		go func(f func(ctx context.Context) error) {
+			defer wg.Done()
+
+			select {
+			case l.slots <- struct{}{}:
+				defer func() { <-l.slots }()
+			case <-ctx.Done():
+				fail(ctx.Err())
+				return
+			}
+
+			if err := f(ctx); err != nil {
+				fail(err)
+			}
+		}(f)
+	}
+
+	wg.Wait()
+
+	return firstErr
+}
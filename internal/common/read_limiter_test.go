@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestReadLimiterRun(t *testing.T) {
+	for _, limiter := range []*ReadLimiter{nil, NewReadLimiter(1), NewReadLimiter(3)} {
+		var mu sync.Mutex
+		called := make(map[int]bool)
+
+		funcs := make([]func(ctx context.Context) error, 0)
+		for i := 0; i < 10; i++ {
+			i := i
+			funcs = append(funcs, func(ctx context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+				called[i] = true
+				return nil
+			})
+		}
+
+		if err := limiter.Run(context.Background(), funcs...); err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if len(called) != len(funcs) {
+			t.Fatalf("expected %d functions to be called but got %d", len(funcs), len(called))
+		}
+	}
+}
+
+func TestReadLimiterRunIsBounded(t *testing.T) {
+	limiter := NewReadLimiter(2)
+
+	var running, maxRunning int32
+	funcs := make([]func(ctx context.Context) error, 0)
+	for i := 0; i < 8; i++ {
+		funcs = append(funcs, func(ctx context.Context) error {
+			current := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+
+			for {
+				previous := atomic.LoadInt32(&maxRunning)
+				if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		})
+	}
+
+	if err := limiter.Run(context.Background(), funcs...); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if maxRunning > 2 {
+		t.Fatalf("expected at most 2 functions to run concurrently but got %d", maxRunning)
+	}
+}
+
+func TestReadLimiterRunReturnsFirstError(t *testing.T) {
+	limiter := NewReadLimiter(2)
+
+	err := limiter.Run(context.Background(),
+		func(ctx context.Context) error {
+			return fmt.Errorf("retrieving the first item")
+		},
+		func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(10 * time.Second):
+				return nil
+			}
+		},
+	)
+	if err == nil || err.Error() != "retrieving the first item" {
+		t.Fatalf("expected the error from the first function but got: %+v", err)
+	}
+}
@@ -55,6 +55,7 @@ func Default() UserFeatures {
 			DeleteOSDiskOnDeletion:           true,
 			GracefulShutdown:                 false,
 			SkipShutdownAndForceDelete:       false,
+			DeallocateBeforeResize:           false,
 		},
 		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
 			ForceDelete:               false,
@@ -75,5 +76,11 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		ResourceReplacement: ResourceReplacementFeatures{
+			PreventSoftDeletedNameConflicts: false,
+		},
+		RetiredServices: RetiredServicesFeatures{
+			RemoveFromStateWhenUnavailable: false,
+		},
 	}
 }
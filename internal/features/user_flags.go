@@ -20,6 +20,8 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	ResourceReplacement      ResourceReplacementFeatures
+	RetiredServices          RetiredServicesFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -31,6 +33,7 @@ type VirtualMachineFeatures struct {
 	DeleteOSDiskOnDeletion           bool
 	GracefulShutdown                 bool
 	SkipShutdownAndForceDelete       bool
+	DeallocateBeforeResize           bool
 }
 
 type VirtualMachineScaleSetFeatures struct {
@@ -100,6 +103,14 @@ type MachineLearningFeatures struct {
 	PurgeSoftDeletedWorkspaceOnDestroy bool
 }
 
+type ResourceReplacementFeatures struct {
+	PreventSoftDeletedNameConflicts bool
+}
+
+type RetiredServicesFeatures struct {
+	RemoveFromStateWhenUnavailable bool
+}
+
 type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
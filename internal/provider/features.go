@@ -230,6 +230,11 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  false,
 					},
+					"deallocate_before_resize": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		},
@@ -372,6 +377,36 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"resource_replacement": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"prevent_soft_deleted_name_conflicts": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"retired_services": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"remove_from_state_when_unavailable": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -529,6 +564,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := virtualMachinesRaw["skip_shutdown_and_force_delete"]; ok {
 				featuresMap.VirtualMachine.SkipShutdownAndForceDelete = v.(bool)
 			}
+			if v, ok := virtualMachinesRaw["deallocate_before_resize"]; ok {
+				featuresMap.VirtualMachine.DeallocateBeforeResize = v.(bool)
+			}
 		}
 	}
 
@@ -624,5 +662,25 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["resource_replacement"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			resourceReplacementRaw := items[0].(map[string]interface{})
+			if v, ok := resourceReplacementRaw["prevent_soft_deleted_name_conflicts"]; ok {
+				featuresMap.ResourceReplacement.PreventSoftDeletedNameConflicts = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["retired_services"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			retiredServicesRaw := items[0].(map[string]interface{})
+			if v, ok := retiredServicesRaw["remove_from_state_when_unavailable"]; ok {
+				featuresMap.RetiredServices.RemoveFromStateWhenUnavailable = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
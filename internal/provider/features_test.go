@@ -62,6 +62,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ForceDelete:               false,
@@ -88,6 +89,12 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				ResourceReplacement: features.ResourceReplacementFeatures{
+					PreventSoftDeletedNameConflicts: false,
+				},
+				RetiredServices: features.RetiredServicesFeatures{
+					RemoveFromStateWhenUnavailable: false,
+				},
 			},
 		},
 		{
@@ -172,6 +179,7 @@ func TestExpandFeatures(t *testing.T) {
 							"delete_os_disk_on_deletion":            true,
 							"graceful_shutdown":                     true,
 							"skip_shutdown_and_force_delete":        true,
+							"deallocate_before_resize":              true,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
@@ -193,6 +201,16 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"resource_replacement": []interface{}{
+						map[string]interface{}{
+							"prevent_soft_deleted_name_conflicts": true,
+						},
+					},
+					"retired_services": []interface{}{
+						map[string]interface{}{
+							"remove_from_state_when_unavailable": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -246,6 +264,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 true,
 					SkipShutdownAndForceDelete:       true,
+					DeallocateBeforeResize:           true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ReimageOnManualUpgrade:    true,
@@ -263,6 +282,12 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				ResourceReplacement: features.ResourceReplacementFeatures{
+					PreventSoftDeletedNameConflicts: true,
+				},
+				RetiredServices: features.RetiredServicesFeatures{
+					RemoveFromStateWhenUnavailable: true,
+				},
 			},
 		},
 		{
@@ -347,6 +372,7 @@ func TestExpandFeatures(t *testing.T) {
 							"delete_os_disk_on_deletion":            false,
 							"graceful_shutdown":                     false,
 							"skip_shutdown_and_force_delete":        false,
+							"deallocate_before_resize":              false,
 						},
 					},
 					"virtual_machine_scale_set": []interface{}{
@@ -368,6 +394,16 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"resource_replacement": []interface{}{
+						map[string]interface{}{
+							"prevent_soft_deleted_name_conflicts": false,
+						},
+					},
+					"retired_services": []interface{}{
+						map[string]interface{}{
+							"remove_from_state_when_unavailable": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -421,6 +457,7 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ForceDelete:               false,
@@ -438,6 +475,12 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				ResourceReplacement: features.ResourceReplacementFeatures{
+					PreventSoftDeletedNameConflicts: false,
+				},
+				RetiredServices: features.RetiredServicesFeatures{
+					RemoveFromStateWhenUnavailable: false,
+				},
 			},
 		},
 	}
@@ -921,6 +964,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 			},
 		},
@@ -945,6 +989,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 			},
 		},
@@ -969,6 +1014,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           true,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 			},
 		},
@@ -992,6 +1038,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 true,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 			},
 		},
@@ -1005,6 +1052,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 							"delete_os_disk_on_deletion":            false,
 							"graceful_shutdown":                     false,
 							"skip_shutdown_and_force_delete":        true,
+							"deallocate_before_resize":              false,
 						},
 					},
 				},
@@ -1015,6 +1063,32 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       true,
+					DeallocateBeforeResize:           false,
+				},
+			},
+		},
+		{
+			Name: "Deallocate Before Resize Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"detach_implicit_data_disk_on_deletion": false,
+							"delete_os_disk_on_deletion":            false,
+							"graceful_shutdown":                     false,
+							"skip_shutdown_and_force_delete":        false,
+							"deallocate_before_resize":              true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachine: features.VirtualMachineFeatures{
+					DetachImplicitDataDiskOnDeletion: false,
+					DeleteOSDiskOnDeletion:           false,
+					GracefulShutdown:                 false,
+					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           true,
 				},
 			},
 		},
@@ -1028,6 +1102,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 							"delete_os_disk_on_deletion":            false,
 							"graceful_shutdown":                     false,
 							"skip_shutdown_and_force_delete":        false,
+							"deallocate_before_resize":              false,
 						},
 					},
 				},
@@ -1038,6 +1113,7 @@ func TestExpandFeaturesVirtualMachine(t *testing.T) {
 					DeleteOSDiskOnDeletion:           false,
 					GracefulShutdown:                 false,
 					SkipShutdownAndForceDelete:       false,
+					DeallocateBeforeResize:           false,
 				},
 			},
 		},
@@ -1678,3 +1754,133 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesResourceReplacement(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_replacement": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceReplacement: features.ResourceReplacementFeatures{
+					PreventSoftDeletedNameConflicts: false,
+				},
+			},
+		},
+		{
+			Name: "Prevent Soft Deleted Name Conflicts Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_replacement": []interface{}{
+						map[string]interface{}{
+							"prevent_soft_deleted_name_conflicts": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceReplacement: features.ResourceReplacementFeatures{
+					PreventSoftDeletedNameConflicts: true,
+				},
+			},
+		},
+		{
+			Name: "Prevent Soft Deleted Name Conflicts Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_replacement": []interface{}{
+						map[string]interface{}{
+							"prevent_soft_deleted_name_conflicts": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ResourceReplacement: features.ResourceReplacementFeatures{
+					PreventSoftDeletedNameConflicts: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ResourceReplacement, testCase.Expected.ResourceReplacement) {
+			t.Fatalf("Expected %+v but got %+v", result.ResourceReplacement, testCase.Expected.ResourceReplacement)
+		}
+	}
+}
+
+func TestExpandFeaturesRetiredServices(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"retired_services": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				RetiredServices: features.RetiredServicesFeatures{
+					RemoveFromStateWhenUnavailable: false,
+				},
+			},
+		},
+		{
+			Name: "Remove From State When Unavailable Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"retired_services": []interface{}{
+						map[string]interface{}{
+							"remove_from_state_when_unavailable": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RetiredServices: features.RetiredServicesFeatures{
+					RemoveFromStateWhenUnavailable: true,
+				},
+			},
+		},
+		{
+			Name: "Remove From State When Unavailable Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"retired_services": []interface{}{
+						map[string]interface{}{
+							"remove_from_state_when_unavailable": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				RetiredServices: features.RetiredServicesFeatures{
+					RemoveFromStateWhenUnavailable: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.RetiredServices, testCase.Expected.RetiredServices) {
+			t.Fatalf("Expected %+v but got %+v", result.RetiredServices, testCase.Expected.RetiredServices)
+		}
+	}
+}
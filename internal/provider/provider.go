@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -317,6 +318,21 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"audit_log_file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_AUDIT_LOG_FILE_PATH", ""),
+				Description: "The path to a file where a JSON Lines audit log of each request made by the Provider should be written.",
+			},
+
+			"max_concurrent_reads": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_READS", common.DefaultMaxConcurrentReads),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of requests which can be made concurrently when reading the child items of resources, such as the settings of an API Management Service.",
+			},
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
 			// Advanced feature flags
@@ -446,10 +462,12 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 
 	clientBuilder := clients.ClientBuilder{
+		AuditLogFilePath:            d.Get("audit_log_file_path").(string),
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
+		MaxConcurrentReads:          d.Get("max_concurrent_reads").(int),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
 		SkipProviderRegistration:    skipProviderRegistration,
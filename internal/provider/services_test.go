@@ -125,8 +125,9 @@ func validateResourceTypeName(resourceType string) error {
 		return fmt.Errorf("the resource type must be all lower-case")
 	}
 
-	// Role Assignments should be named `azurerm_{type}_role_assignment` for consistency
-	if strings.Contains(resourceType, "role_assignment") && !strings.HasSuffix(resourceType, "role_assignment") {
+	// Role Assignments should be named `azurerm_{type}_role_assignment` (or `azurerm_{type}_role_assignments` when
+	// managing a set of them) for consistency
+	if strings.Contains(resourceType, "role_assignment") && !strings.HasSuffix(resourceType, "role_assignment") && !strings.HasSuffix(resourceType, "role_assignments") {
 		return fmt.Errorf("role assignment resources should be named `azurerm_{type}_role_assignment`")
 	}
 
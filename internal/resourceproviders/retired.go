@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	RetiredNamespaceMedia   = "Microsoft.Media"
+	RetiredNamespaceOrbital = "Microsoft.Orbital"
+)
+
+// WasRetired returns whether the API response indicates that the specified Resource Provider namespace (or one of
+// its Resource Types) has been decommissioned, in which case any subsequent requests for resources of this type will fail.
+//
+// Only the error codes returned for an unknown namespace or resource type are matched, and only when the error refers
+// to the specified namespace - an unregistered Resource Provider or an unsupported API version isn't a retirement.
+//
+// Since a retired Resource Provider can return a 404, this should be checked before treating a 404 as the resource
+// having been deleted - otherwise these resources would be removed from the state regardless of the feature flag.
+func WasRetired(resp *http.Response, namespace string) bool {
+	if resp == nil || resp.Body == nil {
+		return false
+	}
+	if resp.StatusCode != http.StatusBadRequest && resp.StatusCode != http.StatusNotFound {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return false
+	}
+
+	var armError struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &armError); err != nil {
+		return false
+	}
+
+	switch armError.Error.Code {
+	case "InvalidResourceNamespace", "InvalidResourceType":
+		return strings.Contains(strings.ToLower(armError.Error.Message), "'"+strings.ToLower(namespace)+"'")
+	}
+
+	return false
+}
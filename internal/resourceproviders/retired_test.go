@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceproviders
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWasRetired(t *testing.T) {
+	testData := []struct {
+		name       string
+		statusCode int
+		body       string
+		namespace  string
+		expected   bool
+	}{
+		{
+			name:      "no response",
+			namespace: RetiredNamespaceMedia,
+			expected:  false,
+		},
+		{
+			name:       "resource not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"error":{"code":"ResourceNotFound","message":"The Resource 'Microsoft.Media/mediaservices/example' under resource group 'example' was not found."}}`,
+			namespace:  RetiredNamespaceMedia,
+			expected:   false,
+		},
+		{
+			name:       "unsupported api version",
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":{"code":"NoRegisteredProviderFound","message":"No registered resource provider found for location 'westeurope' and API version '2099-01-01' for type 'mediaservices'."}}`,
+			namespace:  RetiredNamespaceMedia,
+			expected:   false,
+		},
+		{
+			name:       "unregistered resource provider",
+			statusCode: http.StatusConflict,
+			body:       `{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Media'."}}`,
+			namespace:  RetiredNamespaceMedia,
+			expected:   false,
+		},
+		{
+			name:       "different namespace",
+			statusCode: http.StatusNotFound,
+			body:       `{"error":{"code":"InvalidResourceNamespace","message":"The resource namespace 'Microsoft.Orbital' is invalid."}}`,
+			namespace:  RetiredNamespaceMedia,
+			expected:   false,
+		},
+		{
+			name:       "code only in the message",
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":{"code":"BadRequest","message":"InvalidResourceType: The resource type 'mediaservices' could not be found in the namespace 'Microsoft.Media'."}}`,
+			namespace:  RetiredNamespaceMedia,
+			expected:   false,
+		},
+		{
+			name:       "not json",
+			statusCode: http.StatusNotFound,
+			body:       `InvalidResourceNamespace 'Microsoft.Orbital'`,
+			namespace:  RetiredNamespaceOrbital,
+			expected:   false,
+		},
+		{
+			name:       "retired namespace",
+			statusCode: http.StatusNotFound,
+			body:       `{"error":{"code":"InvalidResourceNamespace","message":"The resource namespace 'Microsoft.Orbital' is invalid."}}`,
+			namespace:  RetiredNamespaceOrbital,
+			expected:   true,
+		},
+		{
+			name:       "retired resource type",
+			statusCode: http.StatusBadRequest,
+			body:       `{"error":{"code":"InvalidResourceType","message":"The resource type 'mediaservices' could not be found in the namespace 'Microsoft.Media' for api version '2021-11-01'."}}`,
+			namespace:  RetiredNamespaceMedia,
+			expected:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		var resp *http.Response
+		if v.statusCode != 0 {
+			resp = &http.Response{
+				StatusCode: v.statusCode,
+				Body:       io.NopCloser(strings.NewReader(v.body)),
+			}
+		}
+
+		if actual := WasRetired(resp, v.namespace); actual != v.expected {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+
+		if resp != nil {
+			// the body must still be readable for anything surfacing the error
+			body, err := io.ReadAll(resp.Body)
+			if err != nil || string(body) != v.body {
+				t.Fatalf("Expected the response body to be preserved but got %q (%v)", string(body), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// Action Resources perform a one-off operation (such as taking or restoring a backup) against an existing
+// resource when they're created - the operation can't be read back from, or reverted in, the Azure API, so
+// these Resources get an ID of their own (a child of the resource the operation was performed against,
+// with a generated name) rather than reusing the ID of that resource.
+
+// NewActionResourceName returns a unique name which can be used as the last segment of the ID of an Action Resource
+func NewActionResourceName() (string, error) {
+	name, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", fmt.Errorf("generating a name: %+v", err)
+	}
+
+	return name, nil
+}
+
+// ActionResourceDelete returns the Delete function for an Action Resource - since the operation can't be reverted
+// this only removes the Resource from the state.
+func ActionResourceDelete() ResourceFunc {
+	return ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			metadata.Logger.Infof("%q performed a one-off operation which can't be reverted - removing from state", metadata.ResourceData.Id())
+			return nil
+		},
+	}
+}
+
+// ActionResourceImporter returns the Importer for an Action Resource - since the arguments for the operation can't
+// be read back from the Azure API there's nothing meaningful to import.
+func ActionResourceImporter(resourceType string) ResourceRunFunc {
+	return func(ctx context.Context, metadata ResourceMetaData) error {
+		return fmt.Errorf("`%s` does not support import - the operation is performed when this resource is created", resourceType)
+	}
+}
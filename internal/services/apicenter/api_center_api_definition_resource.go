@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apidefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ApiCenterApiDefinitionResource{}
+var _ sdk.ResourceWithUpdate = ApiCenterApiDefinitionResource{}
+var _ sdk.ResourceWithCustomizeDiff = ApiCenterApiDefinitionResource{}
+
+type ApiCenterApiDefinitionResource struct{}
+
+type ApiCenterApiDefinitionResourceModel struct {
+	Name                  string                                     `tfschema:"name"`
+	ApiCenterApiVersionId string                                     `tfschema:"api_center_api_version_id"`
+	Title                 string                                     `tfschema:"title"`
+	Description           string                                     `tfschema:"description"`
+	Specification         []ApiCenterApiDefinitionSpecificationModel `tfschema:"specification"`
+}
+
+type ApiCenterApiDefinitionSpecificationModel struct {
+	Name    string `tfschema:"name"`
+	Format  string `tfschema:"format"`
+	Value   string `tfschema:"value"`
+	Version string `tfschema:"version"`
+}
+
+func (r ApiCenterApiDefinitionResource) ResourceType() string {
+	return "azurerm_api_center_api_definition"
+}
+
+func (r ApiCenterApiDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apidefinitions.ValidateDefinitionID
+}
+
+func (r ApiCenterApiDefinitionResource) ModelObject() interface{} {
+	return &ApiCenterApiDefinitionResourceModel{}
+}
+
+func (r ApiCenterApiDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName,
+		},
+
+		"api_center_api_version_id": commonschema.ResourceIDReferenceRequiredForceNew(&apidefinitions.VersionId{}),
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"specification": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					// the specification document can't be read back without an export, so `format` and `value`
+					// are only sent to the API when the specification is imported
+					"format": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(apidefinitions.PossibleValuesForApiSpecImportSourceFormat(), false),
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterApiDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			var config ApiCenterApiDefinitionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			versionId, err := apidefinitions.ParseVersionID(config.ApiCenterApiVersionId)
+			if err != nil {
+				return err
+			}
+
+			id := apidefinitions.NewDefinitionID(versionId.SubscriptionId, versionId.ResourceGroupName, versionId.ServiceName, versionId.WorkspaceName, versionId.ApiName, versionId.VersionName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := apidefinitions.ApiDefinition{
+				Properties: &apidefinitions.ApiDefinitionProperties{
+					Title: config.Title,
+				},
+			}
+
+			if config.Description != "" {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(config.Specification) > 0 {
+				if err := client.ImportSpecificationThenPoll(ctx, id, expandApiCenterApiDefinitionSpecification(config.Specification)); err != nil {
+					return fmt.Errorf("importing the specification for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			id, err := apidefinitions.ParseDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var config ApiCenterApiDefinitionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ApiCenterApiDefinitionResourceModel{
+				Name:                  id.DefinitionName,
+				ApiCenterApiVersionId: apidefinitions.NewVersionID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName, id.ApiName, id.VersionName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.Description = pointer.From(props.Description)
+					state.Specification = flattenApiCenterApiDefinitionSpecification(props.Specification, config.Specification)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			id, err := apidefinitions.ParseDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterApiDefinitionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("title", "description") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+				}
+
+				payload := *existing.Model
+				payload.Properties.Title = config.Title
+				payload.Properties.Description = pointer.To(config.Description)
+
+				if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			// removing the `specification` recreates the API Definition (see CustomizeDiff), so one is always set here
+			if metadata.ResourceData.HasChange("specification") && len(config.Specification) > 0 {
+				if err := client.ImportSpecificationThenPoll(ctx, *id, expandApiCenterApiDefinitionSpecification(config.Specification)); err != nil {
+					return fmt.Errorf("importing the specification for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// an imported specification can't be removed from an API Definition, only replaced by importing another one
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("specification"); len(oldVal.([]interface{})) > 0 && len(newVal.([]interface{})) == 0 {
+				if err := metadata.ResourceDiff.ForceNew("specification"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiDefinitions
+
+			id, err := apidefinitions.ParseDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterApiDefinitionSpecification(input []ApiCenterApiDefinitionSpecificationModel) apidefinitions.ApiSpecImportRequest {
+	spec := input[0]
+
+	result := apidefinitions.ApiSpecImportRequest{
+		Format: pointer.To(apidefinitions.ApiSpecImportSourceFormat(spec.Format)),
+		Specification: &apidefinitions.ApiSpecImportRequestSpecification{
+			Name: pointer.To(spec.Name),
+		},
+		Value: pointer.To(spec.Value),
+	}
+
+	if spec.Version != "" {
+		result.Specification.Version = pointer.To(spec.Version)
+	}
+
+	return result
+}
+
+func flattenApiCenterApiDefinitionSpecification(input *apidefinitions.ApiDefinitionPropertiesSpecification, existing []ApiCenterApiDefinitionSpecificationModel) []ApiCenterApiDefinitionSpecificationModel {
+	if input == nil || pointer.From(input.Name) == "" {
+		return []ApiCenterApiDefinitionSpecificationModel{}
+	}
+
+	result := ApiCenterApiDefinitionSpecificationModel{
+		Name:    pointer.From(input.Name),
+		Version: pointer.From(input.Version),
+	}
+
+	// `format` and `value` aren't returned by the API, so these are retained from the config
+	if len(existing) > 0 {
+		result.Format = existing[0].Format
+		result.Value = existing[0].Value
+	}
+
+	return []ApiCenterApiDefinitionSpecificationModel{result}
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apidefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterApiDefinitionTestResource struct{}
+
+func TestAccApiCenterApiDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterApiDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterApiDefinition_specification(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.specification(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("specification.0.format", "specification.0.value"),
+	})
+}
+
+func TestAccApiCenterApiDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_definition", "test")
+	r := ApiCenterApiDefinitionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.specification(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("specification.0.format", "specification.0.value"),
+		{
+			Config: r.specificationUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("specification.0.format", "specification.0.value"),
+	})
+}
+
+func (r ApiCenterApiDefinitionTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := apidefinitions.ParseDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.ApiDefinitions.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiCenterApiDefinitionTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "test" {
+  name                      = "acctestdef-%d"
+  api_center_api_version_id = azurerm_api_center_api_version.test.id
+  title                     = "OpenAPI"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiDefinitionTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "import" {
+  name                      = azurerm_api_center_api_definition.test.name
+  api_center_api_version_id = azurerm_api_center_api_definition.test.api_center_api_version_id
+  title                     = azurerm_api_center_api_definition.test.title
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterApiDefinitionTestResource) specification(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "test" {
+  name                      = "acctestdef-%d"
+  api_center_api_version_id = azurerm_api_center_api_version.test.id
+  title                     = "OpenAPI"
+  description               = "The OpenAPI definition"
+
+  specification {
+    name    = "openapi"
+    version = "3.0.0"
+    format  = "inline"
+    value = jsonencode({
+      openapi = "3.0.0"
+      info = {
+        title   = "Test API"
+        version = "1.0.0"
+      }
+      paths = {}
+    })
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiDefinitionTestResource) specificationUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "test" {
+  name                      = "acctestdef-%d"
+  api_center_api_version_id = azurerm_api_center_api_version.test.id
+  title                     = "OpenAPI"
+  description               = "The updated OpenAPI definition"
+
+  specification {
+    name    = "openapi"
+    version = "3.0.0"
+    format  = "inline"
+    value = jsonencode({
+      openapi = "3.0.0"
+      info = {
+        title   = "Test API"
+        version = "1.1.0"
+      }
+      paths = {}
+    })
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiDefinitionTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_version" "test" {
+  name              = "acctestver-%d"
+  api_center_api_id = azurerm_api_center_api.test.id
+  title             = "v1"
+  lifecycle_stage   = "design"
+}
+`, ApiCenterApiVersionTestResource{}.template(data), data.RandomInteger)
+}
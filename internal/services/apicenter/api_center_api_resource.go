@@ -0,0 +1,498 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ApiCenterApiResource{}
+var _ sdk.ResourceWithUpdate = ApiCenterApiResource{}
+
+type ApiCenterApiResource struct{}
+
+type ApiCenterApiResourceModel struct {
+	Name                  string                              `tfschema:"name"`
+	ApiCenterWorkspaceId  string                              `tfschema:"api_center_workspace_id"`
+	Title                 string                              `tfschema:"title"`
+	Kind                  string                              `tfschema:"kind"`
+	Contact               []ApiCenterApiContactModel          `tfschema:"contact"`
+	CustomProperties      string                              `tfschema:"custom_properties"`
+	Description           string                              `tfschema:"description"`
+	ExternalDocumentation []ApiCenterApiExternalDocumentModel `tfschema:"external_documentation"`
+	License               []ApiCenterApiLicenseModel          `tfschema:"license"`
+	LifecycleStage        string                              `tfschema:"lifecycle_stage"`
+	Summary               string                              `tfschema:"summary"`
+	TermsOfServiceUrl     string                              `tfschema:"terms_of_service_url"`
+}
+
+type ApiCenterApiContactModel struct {
+	Email string `tfschema:"email"`
+	Name  string `tfschema:"name"`
+	Url   string `tfschema:"url"`
+}
+
+type ApiCenterApiExternalDocumentModel struct {
+	Url         string `tfschema:"url"`
+	Description string `tfschema:"description"`
+	Title       string `tfschema:"title"`
+}
+
+type ApiCenterApiLicenseModel struct {
+	Identifier string `tfschema:"identifier"`
+	Name       string `tfschema:"name"`
+	Url        string `tfschema:"url"`
+}
+
+func (r ApiCenterApiResource) ResourceType() string {
+	return "azurerm_api_center_api"
+}
+
+func (r ApiCenterApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apis.ValidateApiID
+}
+
+func (r ApiCenterApiResource) ModelObject() interface{} {
+	return &ApiCenterApiResourceModel{}
+}
+
+func (r ApiCenterApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName,
+		},
+
+		"api_center_workspace_id": commonschema.ResourceIDReferenceRequiredForceNew(&apis.WorkspaceId{}),
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(apis.PossibleValuesForApiKind(), false),
+		},
+
+		"contact": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"email": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"custom_properties": customPropertiesSchema(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"external_documentation": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"title": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"license": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"identifier": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+
+		"lifecycle_stage": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(apis.PossibleValuesForLifecycleStage(), false),
+		},
+
+		"summary": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"terms_of_service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			var config ApiCenterApiResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := apis.ParseWorkspaceID(config.ApiCenterWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := apis.NewApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandApiCenterApiProperties(config)
+			if err != nil {
+				return err
+			}
+
+			payload := apis.Api{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			id, err := apis.ParseApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterApiResourceModel{
+				Name:                 id.ApiName,
+				ApiCenterWorkspaceId: apis.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.Kind = string(props.Kind)
+					state.Contact = flattenApiCenterApiContacts(props.Contacts)
+					state.Description = pointer.From(props.Description)
+					state.ExternalDocumentation = flattenApiCenterApiExternalDocumentation(props.ExternalDocumentation)
+					state.License = flattenApiCenterApiLicense(props.License)
+					state.LifecycleStage = string(pointer.From(props.LifecycleStage))
+					state.Summary = pointer.From(props.Summary)
+
+					if props.TermsOfService != nil {
+						state.TermsOfServiceUrl = props.TermsOfService.Url
+					}
+
+					customProperties, err := flattenCustomProperties(props.CustomProperties)
+					if err != nil {
+						return err
+					}
+					state.CustomProperties = customProperties
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			id, err := apis.ParseApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterApiResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API only supports replacing the whole resource, so the payload is rebuilt from the config
+			props, err := expandApiCenterApiProperties(config)
+			if err != nil {
+				return err
+			}
+
+			payload := apis.Api{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Apis
+
+			id, err := apis.ParseApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterApiProperties(input ApiCenterApiResourceModel) (*apis.ApiProperties, error) {
+	customProperties, err := expandCustomProperties(input.CustomProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	result := apis.ApiProperties{
+		Contacts:              expandApiCenterApiContacts(input.Contact),
+		CustomProperties:      customProperties,
+		ExternalDocumentation: expandApiCenterApiExternalDocumentation(input.ExternalDocumentation),
+		Kind:                  apis.ApiKind(input.Kind),
+		License:               expandApiCenterApiLicense(input.License),
+		Title:                 input.Title,
+	}
+
+	if input.Description != "" {
+		result.Description = pointer.To(input.Description)
+	}
+
+	if input.LifecycleStage != "" {
+		result.LifecycleStage = pointer.To(apis.LifecycleStage(input.LifecycleStage))
+	}
+
+	if input.Summary != "" {
+		result.Summary = pointer.To(input.Summary)
+	}
+
+	if input.TermsOfServiceUrl != "" {
+		result.TermsOfService = &apis.TermsOfService{
+			Url: input.TermsOfServiceUrl,
+		}
+	}
+
+	return &result, nil
+}
+
+func expandApiCenterApiContacts(input []ApiCenterApiContactModel) *[]apis.Contact {
+	result := make([]apis.Contact, 0)
+	for _, v := range input {
+		contact := apis.Contact{}
+		if v.Email != "" {
+			contact.Email = pointer.To(v.Email)
+		}
+		if v.Name != "" {
+			contact.Name = pointer.To(v.Name)
+		}
+		if v.Url != "" {
+			contact.Url = pointer.To(v.Url)
+		}
+		result = append(result, contact)
+	}
+
+	return &result
+}
+
+func flattenApiCenterApiContacts(input *[]apis.Contact) []ApiCenterApiContactModel {
+	result := make([]ApiCenterApiContactModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ApiCenterApiContactModel{
+			Email: pointer.From(v.Email),
+			Name:  pointer.From(v.Name),
+			Url:   pointer.From(v.Url),
+		})
+	}
+
+	return result
+}
+
+func expandApiCenterApiExternalDocumentation(input []ApiCenterApiExternalDocumentModel) *[]apis.ExternalDocumentation {
+	result := make([]apis.ExternalDocumentation, 0)
+	for _, v := range input {
+		doc := apis.ExternalDocumentation{
+			Url: v.Url,
+		}
+		if v.Description != "" {
+			doc.Description = pointer.To(v.Description)
+		}
+		if v.Title != "" {
+			doc.Title = pointer.To(v.Title)
+		}
+		result = append(result, doc)
+	}
+
+	return &result
+}
+
+func flattenApiCenterApiExternalDocumentation(input *[]apis.ExternalDocumentation) []ApiCenterApiExternalDocumentModel {
+	result := make([]ApiCenterApiExternalDocumentModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ApiCenterApiExternalDocumentModel{
+			Url:         v.Url,
+			Description: pointer.From(v.Description),
+			Title:       pointer.From(v.Title),
+		})
+	}
+
+	return result
+}
+
+func expandApiCenterApiLicense(input []ApiCenterApiLicenseModel) *apis.License {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := apis.License{}
+	if v := input[0].Identifier; v != "" {
+		result.Identifier = pointer.To(v)
+	}
+	if v := input[0].Name; v != "" {
+		result.Name = pointer.To(v)
+	}
+	if v := input[0].Url; v != "" {
+		result.Url = pointer.To(v)
+	}
+
+	return &result
+}
+
+func flattenApiCenterApiLicense(input *apis.License) []ApiCenterApiLicenseModel {
+	if input == nil || (input.Identifier == nil && input.Name == nil && input.Url == nil) {
+		return []ApiCenterApiLicenseModel{}
+	}
+
+	return []ApiCenterApiLicenseModel{
+		{
+			Identifier: pointer.From(input.Identifier),
+			Name:       pointer.From(input.Name),
+			Url:        pointer.From(input.Url),
+		},
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterApiTestResource struct{}
+
+func TestAccApiCenterApi_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterApi_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterApi_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterApi_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api", "test")
+	r := ApiCenterApiTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterApiTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := apis.ParseApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.Apis.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiCenterApiTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "test" {
+  name                    = "acctestapi-%d"
+  api_center_workspace_id = local.api_center_workspace_id
+  title                   = "Test API"
+  kind                    = "rest"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "import" {
+  name                    = azurerm_api_center_api.test.name
+  api_center_workspace_id = azurerm_api_center_api.test.api_center_workspace_id
+  title                   = azurerm_api_center_api.test.title
+  kind                    = azurerm_api_center_api.test.kind
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterApiTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "test" {
+  name                    = "acctestapi-%d"
+  api_center_workspace_id = local.api_center_workspace_id
+  title                   = "Updated Test API"
+  kind                    = "graphql"
+  description             = "An API for acceptance testing"
+  summary                 = "Acceptance testing"
+  lifecycle_stage         = "development"
+  terms_of_service_url    = "https://example.com/terms"
+
+  contact {
+    name  = "API Team"
+    email = "api-team@example.com"
+    url   = "https://example.com/contact"
+  }
+
+  external_documentation {
+    url         = "https://example.com/docs"
+    title       = "Docs"
+    description = "The API documentation"
+  }
+
+  license {
+    name       = "MIT"
+    identifier = "MIT"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  api_center_workspace_id = azurerm_api_center_workspace.test.id
+}
+`, ApiCenterWorkspaceTestResource{}.basic(data))
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apiversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ApiCenterApiVersionResource{}
+var _ sdk.ResourceWithUpdate = ApiCenterApiVersionResource{}
+
+type ApiCenterApiVersionResource struct{}
+
+type ApiCenterApiVersionResourceModel struct {
+	Name           string `tfschema:"name"`
+	ApiCenterApiId string `tfschema:"api_center_api_id"`
+	Title          string `tfschema:"title"`
+	LifecycleStage string `tfschema:"lifecycle_stage"`
+}
+
+func (r ApiCenterApiVersionResource) ResourceType() string {
+	return "azurerm_api_center_api_version"
+}
+
+func (r ApiCenterApiVersionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return apiversions.ValidateVersionID
+}
+
+func (r ApiCenterApiVersionResource) ModelObject() interface{} {
+	return &ApiCenterApiVersionResourceModel{}
+}
+
+func (r ApiCenterApiVersionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName,
+		},
+
+		"api_center_api_id": commonschema.ResourceIDReferenceRequiredForceNew(&apiversions.ApiId{}),
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"lifecycle_stage": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(apiversions.PossibleValuesForLifecycleStage(), false),
+		},
+	}
+}
+
+func (r ApiCenterApiVersionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterApiVersionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiVersions
+
+			var config ApiCenterApiVersionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiId, err := apiversions.ParseApiID(config.ApiCenterApiId)
+			if err != nil {
+				return err
+			}
+
+			id := apiversions.NewVersionID(apiId.SubscriptionId, apiId.ResourceGroupName, apiId.ServiceName, apiId.WorkspaceName, apiId.ApiName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := apiversions.ApiVersion{
+				Properties: &apiversions.ApiVersionProperties{
+					LifecycleStage: apiversions.LifecycleStage(config.LifecycleStage),
+					Title:          config.Title,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiVersionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiVersions
+
+			id, err := apiversions.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterApiVersionResourceModel{
+				Name:           id.VersionName,
+				ApiCenterApiId: apiversions.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName, id.ApiName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.LifecycleStage = string(props.LifecycleStage)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterApiVersionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiVersions
+
+			id, err := apiversions.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterApiVersionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := apiversions.ApiVersion{
+				Properties: &apiversions.ApiVersionProperties{
+					LifecycleStage: apiversions.LifecycleStage(config.LifecycleStage),
+					Title:          config.Title,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterApiVersionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.ApiVersions
+
+			id, err := apiversions.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
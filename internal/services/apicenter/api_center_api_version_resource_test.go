@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apiversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterApiVersionTestResource struct{}
+
+func TestAccApiCenterApiVersion_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_version", "test")
+	r := ApiCenterApiVersionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterApiVersion_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_version", "test")
+	r := ApiCenterApiVersionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterApiVersion_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_api_version", "test")
+	r := ApiCenterApiVersionTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterApiVersionTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := apiversions.ParseVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.ApiVersions.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiCenterApiVersionTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_version" "test" {
+  name              = "acctestver-%d"
+  api_center_api_id = azurerm_api_center_api.test.id
+  title             = "v1"
+  lifecycle_stage   = "design"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiVersionTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_version" "import" {
+  name              = azurerm_api_center_api_version.test.name
+  api_center_api_id = azurerm_api_center_api_version.test.api_center_api_id
+  title             = azurerm_api_center_api_version.test.title
+  lifecycle_stage   = azurerm_api_center_api_version.test.lifecycle_stage
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterApiVersionTestResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_version" "test" {
+  name              = "acctestver-%d"
+  api_center_api_id = azurerm_api_center_api.test.id
+  title             = "v1.0"
+  lifecycle_stage   = "production"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterApiVersionTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api" "test" {
+  name                    = "acctestapi-%d"
+  api_center_workspace_id = local.api_center_workspace_id
+  title                   = "Test API"
+  kind                    = "rest"
+}
+`, ApiCenterApiTestResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/apidefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/environments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ApiCenterDeploymentResource{}
+var _ sdk.ResourceWithUpdate = ApiCenterDeploymentResource{}
+
+type ApiCenterDeploymentResource struct{}
+
+type ApiCenterDeploymentResourceModel struct {
+	Name                     string   `tfschema:"name"`
+	ApiCenterApiId           string   `tfschema:"api_center_api_id"`
+	ApiCenterEnvironmentId   string   `tfschema:"api_center_environment_id"`
+	ApiCenterApiDefinitionId string   `tfschema:"api_center_api_definition_id"`
+	Title                    string   `tfschema:"title"`
+	CustomProperties         string   `tfschema:"custom_properties"`
+	Description              string   `tfschema:"description"`
+	RuntimeUris              []string `tfschema:"runtime_uris"`
+	State                    string   `tfschema:"state"`
+}
+
+func (r ApiCenterDeploymentResource) ResourceType() string {
+	return "azurerm_api_center_deployment"
+}
+
+func (r ApiCenterDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deployments.ValidateDeploymentID
+}
+
+func (r ApiCenterDeploymentResource) ModelObject() interface{} {
+	return &ApiCenterDeploymentResourceModel{}
+}
+
+func (r ApiCenterDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName,
+		},
+
+		"api_center_api_id": commonschema.ResourceIDReferenceRequiredForceNew(&deployments.ApiId{}),
+
+		"api_center_environment_id": commonschema.ResourceIDReferenceRequired(&environments.EnvironmentId{}),
+
+		"api_center_api_definition_id": commonschema.ResourceIDReferenceRequired(&apidefinitions.DefinitionId{}),
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"custom_properties": customPropertiesSchema(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"runtime_uris": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deployments.DeploymentStateActive),
+			ValidateFunc: validation.StringInSlice(deployments.PossibleValuesForDeploymentState(), false),
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			var config ApiCenterDeploymentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			apiId, err := deployments.ParseApiID(config.ApiCenterApiId)
+			if err != nil {
+				return err
+			}
+
+			id := deployments.NewDeploymentID(apiId.SubscriptionId, apiId.ResourceGroupName, apiId.ServiceName, apiId.WorkspaceName, apiId.ApiName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandApiCenterDeploymentProperties(id, config)
+			if err != nil {
+				return err
+			}
+
+			payload := deployments.Deployment{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterDeploymentResourceModel{
+				Name:           id.DeploymentName,
+				ApiCenterApiId: deployments.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName, id.ApiName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					serviceId := services.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+
+					if v := pointer.From(props.EnvironmentId); v != "" {
+						environmentId, err := environments.ParseEnvironmentIDInsensitively(serviceId.ID() + v)
+						if err != nil {
+							return err
+						}
+						state.ApiCenterEnvironmentId = environmentId.ID()
+					}
+
+					if v := pointer.From(props.DefinitionId); v != "" {
+						definitionId, err := apidefinitions.ParseDefinitionIDInsensitively(serviceId.ID() + v)
+						if err != nil {
+							return err
+						}
+						state.ApiCenterApiDefinitionId = definitionId.ID()
+					}
+
+					state.Title = pointer.From(props.Title)
+					state.Description = pointer.From(props.Description)
+					state.State = string(pointer.From(props.State))
+
+					if server := props.Server; server != nil {
+						state.RuntimeUris = pointer.From(server.RuntimeUri)
+					}
+
+					customProperties, err := flattenCustomProperties(props.CustomProperties)
+					if err != nil {
+						return err
+					}
+					state.CustomProperties = customProperties
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterDeploymentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API only supports replacing the whole resource, so the payload is rebuilt from the config
+			props, err := expandApiCenterDeploymentProperties(*id, config)
+			if err != nil {
+				return err
+			}
+
+			payload := deployments.Deployment{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Deployments
+
+			id, err := deployments.ParseDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterDeploymentProperties(id deployments.DeploymentId, input ApiCenterDeploymentResourceModel) (*deployments.DeploymentProperties, error) {
+	serviceId := services.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+
+	environmentId, err := environments.ParseEnvironmentID(input.ApiCenterEnvironmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	definitionId, err := apidefinitions.ParseDefinitionID(input.ApiCenterApiDefinitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// the API expects the Environment and Definition to be referenced by their ID relative to the API Center Service
+	environmentServiceId := services.NewServiceID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ServiceName)
+	if environmentServiceId.ID() != serviceId.ID() {
+		return nil, fmt.Errorf("`api_center_environment_id` must belong to %s", serviceId)
+	}
+
+	definitionServiceId := services.NewServiceID(definitionId.SubscriptionId, definitionId.ResourceGroupName, definitionId.ServiceName)
+	if definitionServiceId.ID() != serviceId.ID() {
+		return nil, fmt.Errorf("`api_center_api_definition_id` must belong to %s", serviceId)
+	}
+
+	customProperties, err := expandCustomProperties(input.CustomProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	result := deployments.DeploymentProperties{
+		CustomProperties: customProperties,
+		DefinitionId:     pointer.To(strings.TrimPrefix(definitionId.ID(), serviceId.ID())),
+		EnvironmentId:    pointer.To(strings.TrimPrefix(environmentId.ID(), serviceId.ID())),
+		Server: &deployments.DeploymentServer{
+			RuntimeUri: pointer.To(input.RuntimeUris),
+		},
+		State: pointer.To(deployments.DeploymentState(input.State)),
+		Title: pointer.To(input.Title),
+	}
+
+	if input.Description != "" {
+		result.Description = pointer.To(input.Description)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterDeploymentTestResource struct{}
+
+func TestAccApiCenterDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterDeployment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_deployment", "test")
+	r := ApiCenterDeploymentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterDeploymentTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := deployments.ParseDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.Deployments.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiCenterDeploymentTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_deployment" "test" {
+  name                         = "acctestdep-%d"
+  api_center_api_id            = azurerm_api_center_api.test.id
+  api_center_environment_id    = azurerm_api_center_environment.test.id
+  api_center_api_definition_id = azurerm_api_center_api_definition.test.id
+  title                        = "Test Deployment"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterDeploymentTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_deployment" "import" {
+  name                         = azurerm_api_center_deployment.test.name
+  api_center_api_id            = azurerm_api_center_deployment.test.api_center_api_id
+  api_center_environment_id    = azurerm_api_center_deployment.test.api_center_environment_id
+  api_center_api_definition_id = azurerm_api_center_deployment.test.api_center_api_definition_id
+  title                        = azurerm_api_center_deployment.test.title
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterDeploymentTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_deployment" "test" {
+  name                         = "acctestdep-%d"
+  api_center_api_id            = azurerm_api_center_api.test.id
+  api_center_environment_id    = azurerm_api_center_environment.test.id
+  api_center_api_definition_id = azurerm_api_center_api_definition.test.id
+  title                        = "Updated Test Deployment"
+  description                  = "A deployment for acceptance testing"
+  runtime_uris                 = ["https://api.example.com"]
+  state                        = "inactive"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterDeploymentTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_api_definition" "test" {
+  name                      = "acctestdef-%d"
+  api_center_api_version_id = azurerm_api_center_api_version.test.id
+  title                     = "OpenAPI"
+}
+
+resource "azurerm_api_center_environment" "test" {
+  name                    = "acctestenv-%d"
+  api_center_workspace_id = local.api_center_workspace_id
+  title                   = "Test Environment"
+  kind                    = "development"
+}
+`, ApiCenterApiDefinitionTestResource{}.template(data), data.RandomInteger, data.RandomInteger)
+}
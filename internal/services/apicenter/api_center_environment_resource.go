@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ApiCenterEnvironmentResource{}
+var _ sdk.ResourceWithUpdate = ApiCenterEnvironmentResource{}
+
+type ApiCenterEnvironmentResource struct{}
+
+type ApiCenterEnvironmentResourceModel struct {
+	Name                 string                                `tfschema:"name"`
+	ApiCenterWorkspaceId string                                `tfschema:"api_center_workspace_id"`
+	Title                string                                `tfschema:"title"`
+	Kind                 string                                `tfschema:"kind"`
+	CustomProperties     string                                `tfschema:"custom_properties"`
+	Description          string                                `tfschema:"description"`
+	Onboarding           []ApiCenterEnvironmentOnboardingModel `tfschema:"onboarding"`
+	Server               []ApiCenterEnvironmentServerModel     `tfschema:"server"`
+}
+
+type ApiCenterEnvironmentOnboardingModel struct {
+	DeveloperPortalUris []string `tfschema:"developer_portal_uris"`
+	Instructions        string   `tfschema:"instructions"`
+}
+
+type ApiCenterEnvironmentServerModel struct {
+	Type                 string   `tfschema:"type"`
+	ManagementPortalUris []string `tfschema:"management_portal_uris"`
+}
+
+func (r ApiCenterEnvironmentResource) ResourceType() string {
+	return "azurerm_api_center_environment"
+}
+
+func (r ApiCenterEnvironmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return environments.ValidateEnvironmentID
+}
+
+func (r ApiCenterEnvironmentResource) ModelObject() interface{} {
+	return &ApiCenterEnvironmentResourceModel{}
+}
+
+func (r ApiCenterEnvironmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName,
+		},
+
+		"api_center_workspace_id": commonschema.ResourceIDReferenceRequiredForceNew(&environments.WorkspaceId{}),
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(environments.PossibleValuesForEnvironmentKind(), false),
+		},
+
+		"custom_properties": customPropertiesSchema(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"onboarding": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"developer_portal_uris": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+
+					"instructions": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"server": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(environments.PossibleValuesForEnvironmentServerType(), false),
+					},
+
+					"management_portal_uris": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterEnvironmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			var config ApiCenterEnvironmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := environments.ParseWorkspaceID(config.ApiCenterWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := environments.NewEnvironmentID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.ServiceName, workspaceId.WorkspaceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandApiCenterEnvironmentProperties(config)
+			if err != nil {
+				return err
+			}
+
+			payload := environments.Environment{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			id, err := environments.ParseEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterEnvironmentResourceModel{
+				Name:                 id.EnvironmentName,
+				ApiCenterWorkspaceId: environments.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Title = props.Title
+					state.Kind = string(props.Kind)
+					state.Description = pointer.From(props.Description)
+					state.Onboarding = flattenApiCenterEnvironmentOnboarding(props.Onboarding)
+					state.Server = flattenApiCenterEnvironmentServer(props.Server)
+
+					customProperties, err := flattenCustomProperties(props.CustomProperties)
+					if err != nil {
+						return err
+					}
+					state.CustomProperties = customProperties
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			id, err := environments.ParseEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterEnvironmentResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API only supports replacing the whole resource, so the payload is rebuilt from the config
+			props, err := expandApiCenterEnvironmentProperties(config)
+			if err != nil {
+				return err
+			}
+
+			payload := environments.Environment{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterEnvironmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.Environments
+
+			id, err := environments.ParseEnvironmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterEnvironmentProperties(input ApiCenterEnvironmentResourceModel) (*environments.EnvironmentProperties, error) {
+	customProperties, err := expandCustomProperties(input.CustomProperties)
+	if err != nil {
+		return nil, err
+	}
+
+	result := environments.EnvironmentProperties{
+		CustomProperties: customProperties,
+		Kind:             environments.EnvironmentKind(input.Kind),
+		Title:            input.Title,
+	}
+
+	if input.Description != "" {
+		result.Description = pointer.To(input.Description)
+	}
+
+	if len(input.Onboarding) > 0 {
+		onboarding := input.Onboarding[0]
+		result.Onboarding = &environments.Onboarding{
+			DeveloperPortalUri: pointer.To(onboarding.DeveloperPortalUris),
+		}
+		if onboarding.Instructions != "" {
+			result.Onboarding.Instructions = pointer.To(onboarding.Instructions)
+		}
+	}
+
+	if len(input.Server) > 0 {
+		server := input.Server[0]
+		result.Server = &environments.EnvironmentServer{
+			ManagementPortalUri: pointer.To(server.ManagementPortalUris),
+			Type:                pointer.To(environments.EnvironmentServerType(server.Type)),
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenApiCenterEnvironmentOnboarding(input *environments.Onboarding) []ApiCenterEnvironmentOnboardingModel {
+	if input == nil || (input.Instructions == nil && len(pointer.From(input.DeveloperPortalUri)) == 0) {
+		return []ApiCenterEnvironmentOnboardingModel{}
+	}
+
+	return []ApiCenterEnvironmentOnboardingModel{
+		{
+			DeveloperPortalUris: pointer.From(input.DeveloperPortalUri),
+			Instructions:        pointer.From(input.Instructions),
+		},
+	}
+}
+
+func flattenApiCenterEnvironmentServer(input *environments.EnvironmentServer) []ApiCenterEnvironmentServerModel {
+	if input == nil || input.Type == nil {
+		return []ApiCenterEnvironmentServerModel{}
+	}
+
+	return []ApiCenterEnvironmentServerModel{
+		{
+			Type:                 string(*input.Type),
+			ManagementPortalUris: pointer.From(input.ManagementPortalUri),
+		},
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterEnvironmentTestResource struct{}
+
+func TestAccApiCenterEnvironment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_environment", "test")
+	r := ApiCenterEnvironmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterEnvironment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_environment", "test")
+	r := ApiCenterEnvironmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterEnvironment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_environment", "test")
+	r := ApiCenterEnvironmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterEnvironment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_environment", "test")
+	r := ApiCenterEnvironmentTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterEnvironmentTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := environments.ParseEnvironmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.Environments.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiCenterEnvironmentTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_environment" "test" {
+  name                    = "acctestenv-%d"
+  api_center_workspace_id = local.api_center_workspace_id
+  title                   = "Test Environment"
+  kind                    = "development"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterEnvironmentTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_environment" "import" {
+  name                    = azurerm_api_center_environment.test.name
+  api_center_workspace_id = azurerm_api_center_environment.test.api_center_workspace_id
+  title                   = azurerm_api_center_environment.test.title
+  kind                    = azurerm_api_center_environment.test.kind
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterEnvironmentTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_environment" "test" {
+  name                    = "acctestenv-%d"
+  api_center_workspace_id = local.api_center_workspace_id
+  title                   = "Updated Test Environment"
+  kind                    = "production"
+  description             = "An environment for acceptance testing"
+
+  onboarding {
+    instructions          = "Request access from the API Team"
+    developer_portal_uris = ["https://developer.example.com"]
+  }
+
+  server {
+    type                   = "Azure API Management"
+    management_portal_uris = ["https://portal.azure.com"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiCenterEnvironmentTestResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  api_center_workspace_id = azurerm_api_center_workspace.test.id
+}
+`, ApiCenterWorkspaceTestResource{}.basic(data))
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/metadataschemas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apicenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = ApiCenterMetadataSchemaResource{}
+var _ sdk.ResourceWithUpdate = ApiCenterMetadataSchemaResource{}
+
+type ApiCenterMetadataSchemaResource struct{}
+
+type ApiCenterMetadataSchemaResourceModel struct {
+	Name               string                                   `tfschema:"name"`
+	ApiCenterServiceId string                                   `tfschema:"api_center_service_id"`
+	Schema             string                                   `tfschema:"schema"`
+	AssignedTo         []ApiCenterMetadataSchemaAssignedToModel `tfschema:"assigned_to"`
+}
+
+type ApiCenterMetadataSchemaAssignedToModel struct {
+	Entity     string `tfschema:"entity"`
+	Deprecated bool   `tfschema:"deprecated"`
+	Required   bool   `tfschema:"required"`
+}
+
+func (r ApiCenterMetadataSchemaResource) ResourceType() string {
+	return "azurerm_api_center_metadata_schema"
+}
+
+func (r ApiCenterMetadataSchemaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return metadataschemas.ValidateMetadataSchemaID
+}
+
+func (r ApiCenterMetadataSchemaResource) ModelObject() interface{} {
+	return &ApiCenterMetadataSchemaResourceModel{}
+}
+
+func (r ApiCenterMetadataSchemaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiCenterName,
+		},
+
+		"api_center_service_id": commonschema.ResourceIDReferenceRequiredForceNew(&metadataschemas.ServiceId{}),
+
+		"schema": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"assigned_to": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"entity": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(metadataschemas.PossibleValuesForMetadataAssignmentEntity(), false),
+					},
+
+					"deprecated": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"required": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ApiCenterMetadataSchemaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiCenterMetadataSchemaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.MetadataSchemas
+
+			var config ApiCenterMetadataSchemaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			serviceId, err := metadataschemas.ParseServiceID(config.ApiCenterServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := metadataschemas.NewMetadataSchemaID(serviceId.SubscriptionId, serviceId.ResourceGroupName, serviceId.ServiceName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := metadataschemas.MetadataSchema{
+				Properties: &metadataschemas.MetadataSchemaProperties{
+					AssignedTo: expandApiCenterMetadataSchemaAssignedTo(config.AssignedTo),
+					Schema:     config.Schema,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterMetadataSchemaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.MetadataSchemas
+
+			id, err := metadataschemas.ParseMetadataSchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApiCenterMetadataSchemaResourceModel{
+				Name:               id.MetadataSchemaName,
+				ApiCenterServiceId: metadataschemas.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Schema = props.Schema
+					state.AssignedTo = flattenApiCenterMetadataSchemaAssignedTo(props.AssignedTo)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApiCenterMetadataSchemaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.MetadataSchemas
+
+			id, err := metadataschemas.ParseMetadataSchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ApiCenterMetadataSchemaResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := metadataschemas.MetadataSchema{
+				Properties: &metadataschemas.MetadataSchemaProperties{
+					AssignedTo: expandApiCenterMetadataSchemaAssignedTo(config.AssignedTo),
+					Schema:     config.Schema,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiCenterMetadataSchemaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiCenter.MetadataSchemas
+
+			id, err := metadataschemas.ParseMetadataSchemaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiCenterMetadataSchemaAssignedTo(input []ApiCenterMetadataSchemaAssignedToModel) *[]metadataschemas.MetadataAssignment {
+	result := make([]metadataschemas.MetadataAssignment, 0)
+	for _, v := range input {
+		result = append(result, metadataschemas.MetadataAssignment{
+			Deprecated: pointer.To(v.Deprecated),
+			Entity:     pointer.To(metadataschemas.MetadataAssignmentEntity(v.Entity)),
+			Required:   pointer.To(v.Required),
+		})
+	}
+
+	return &result
+}
+
+func flattenApiCenterMetadataSchemaAssignedTo(input *[]metadataschemas.MetadataAssignment) []ApiCenterMetadataSchemaAssignedToModel {
+	result := make([]ApiCenterMetadataSchemaAssignedToModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ApiCenterMetadataSchemaAssignedToModel{
+			Entity:     string(pointer.From(v.Entity)),
+			Deprecated: pointer.From(v.Deprecated),
+			Required:   pointer.From(v.Required),
+		})
+	}
+
+	return result
+}
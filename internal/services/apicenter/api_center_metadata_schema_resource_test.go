@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apicenter/2024-03-01/metadataschemas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiCenterMetadataSchemaTestResource struct{}
+
+func TestAccApiCenterMetadataSchema_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_metadata_schema", "test")
+	r := ApiCenterMetadataSchemaTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterMetadataSchema_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_metadata_schema", "test")
+	r := ApiCenterMetadataSchemaTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiCenterMetadataSchema_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_metadata_schema", "test")
+	r := ApiCenterMetadataSchemaTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiCenterMetadataSchema_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_center_metadata_schema", "test")
+	r := ApiCenterMetadataSchemaTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApiCenterMetadataSchemaTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := metadataschemas.ParseMetadataSchemaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiCenter.MetadataSchemas.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiCenterMetadataSchemaTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_metadata_schema" "test" {
+  name                  = "acctestms-%d"
+  api_center_service_id = azurerm_api_center_service.test.id
+  schema = jsonencode({
+    type  = "string"
+    title = "Owner"
+  })
+}
+`, ApiCenterServiceTestResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiCenterMetadataSchemaTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_metadata_schema" "import" {
+  name                  = azurerm_api_center_metadata_schema.test.name
+  api_center_service_id = azurerm_api_center_metadata_schema.test.api_center_service_id
+  schema                = azurerm_api_center_metadata_schema.test.schema
+}
+`, r.basic(data))
+}
+
+func (r ApiCenterMetadataSchemaTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_center_metadata_schema" "test" {
+  name                  = "acctestms-%d"
+  api_center_service_id = azurerm_api_center_service.test.id
+  schema = jsonencode({
+    type  = "string"
+    title = "Owner"
+    enum  = ["team-a", "team-b"]
+  })
+
+  assigned_to {
+    entity   = "api"
+    required = true
+  }
+
+  assigned_to {
+    entity     = "environment"
+    deprecated = true
+  }
+}
+`, ApiCenterServiceTestResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the custom properties of APIs, Deployments and Environments are free-form JSON objects whose shape is defined by
+// the Metadata Schemas of the API Center Service, so these are exposed as a JSON string
+func customPropertiesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:             pluginsdk.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.StringIsJSON,
+		DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+	}
+}
+
+func expandCustomProperties(input string) (*interface{}, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	var result interface{}
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		return nil, fmt.Errorf("unmarshalling `custom_properties`: %+v", err)
+	}
+
+	return &result, nil
+}
+
+func flattenCustomProperties(input *interface{}) (string, error) {
+	if input == nil || *input == nil {
+		return "", nil
+	}
+
+	// an empty object is returned when no custom properties have been set
+	if v, ok := (*input).(map[string]interface{}); ok && len(v) == 0 {
+		return "", nil
+	}
+
+	result, err := json.Marshal(*input)
+	if err != nil {
+		return "", fmt.Errorf("marshalling `custom_properties`: %+v", err)
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apicenter
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+type Registration struct{}
+
+func (r Registration) AssociatedGitHubLabel() string {
+	return "service/api-center"
+}
+
+func (Registration) Name() string {
+	return "ApiCenter"
+}
+
+func (Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ApiCenterApiDefinitionResource{},
+		ApiCenterApiResource{},
+		ApiCenterApiVersionResource{},
+		ApiCenterDeploymentResource{},
+		ApiCenterEnvironmentResource{},
+		ApiCenterMetadataSchemaResource{},
+		ApiCenterServiceResource{},
+		ApiCenterWorkspaceResource{},
+	}
+}
+
+func (Registration) WebsiteCategories() []string {
+	return []string{
+		"API Center",
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ApiCenterName validates the name of an API Center Service and of the resources nested within it, which all share
+// the same naming rules
+func ApiCenterName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string but it wasn't", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9-]{3,90}$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 90 characters and can contain only letters, numbers and hyphens (-)", k))
+	}
+
+	return warnings, errors
+}
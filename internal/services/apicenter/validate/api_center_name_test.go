@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApiCenterName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// 2 chars
+			input:    "ab",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// may contain mixed case alphanumerics and hyphens
+			input:    "Hello-World7",
+			expected: true,
+		},
+		{
+			// may not contain underscores
+			input:    "hello_world",
+			expected: false,
+		},
+		{
+			// may not contain periods
+			input:    "hello.world",
+			expected: false,
+		},
+		{
+			// 90 chars
+			input:    strings.Repeat("a", 90),
+			expected: true,
+		},
+		{
+			// 91 chars
+			input:    strings.Repeat("a", 91),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ApiCenterName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ApiManagementBackupResource backs up an API Management Service into a Storage Account when created, or when one of
+// the triggers changes - the backup blob isn't managed, so removing this resource only removes it from the state.
+type ApiManagementBackupResource struct{}
+
+type ApiManagementBackupRestoreModel struct {
+	ApiManagementId    string            `tfschema:"api_management_id"`
+	StorageAccountName string            `tfschema:"storage_account_name"`
+	ContainerName      string            `tfschema:"container_name"`
+	BackupName         string            `tfschema:"backup_name"`
+	AccessType         string            `tfschema:"access_type"`
+	AccessKey          string            `tfschema:"access_key"`
+	ClientId           string            `tfschema:"client_id"`
+	Triggers           map[string]string `tfschema:"triggers"`
+}
+
+var _ sdk.ResourceWithCustomImporter = ApiManagementBackupResource{}
+
+func (r ApiManagementBackupResource) ModelObject() interface{} {
+	return &ApiManagementBackupRestoreModel{}
+}
+
+func (r ApiManagementBackupResource) ResourceType() string {
+	return "azurerm_api_management_backup"
+}
+
+func (r ApiManagementBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ApiManagementBackupID
+}
+
+func (r ApiManagementBackupResource) Arguments() map[string]*pluginsdk.Schema {
+	return apiManagementBackupRestoreArguments()
+}
+
+func (r ApiManagementBackupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementBackupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ServiceClient
+
+			var backup ApiManagementBackupRestoreModel
+			if err := metadata.Decode(&backup); err != nil {
+				return err
+			}
+
+			serviceId, err := apimanagementservice.ParseServiceID(backup.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			name, err := sdk.NewActionResourceName()
+			if err != nil {
+				return err
+			}
+			id := parse.NewApiManagementBackupID(serviceId.SubscriptionId, serviceId.ResourceGroupName, serviceId.ServiceName, name)
+
+			if _, err := client.Get(ctx, *serviceId); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", serviceId, err)
+			}
+
+			parameters, err := expandApiManagementBackupRestoreParameters(backup)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(serviceId.ID())
+			defer locks.UnlockByID(serviceId.ID())
+
+			if err := client.BackupThenPoll(ctx, *serviceId, *parameters); err != nil {
+				return fmt.Errorf("backing up %s to %q: %+v", serviceId, backup.BackupName, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementBackupResource) Read() sdk.ResourceFunc {
+	return readApiManagementBackupRestore(func(input string) (*apimanagementservice.ServiceId, error) {
+		id, err := parse.ApiManagementBackupID(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return pointer.To(apimanagementservice.NewServiceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName)), nil
+	})
+}
+
+func (r ApiManagementBackupResource) Delete() sdk.ResourceFunc {
+	return sdk.ActionResourceDelete()
+}
+
+func (r ApiManagementBackupResource) CustomImporter() sdk.ResourceRunFunc {
+	return sdk.ActionResourceImporter(r.ResourceType())
+}
+
+func apiManagementBackupRestoreArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: apimanagementservice.ValidateServiceID,
+		},
+
+		"storage_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageAccountName,
+		},
+
+		"container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageContainerName,
+		},
+
+		"backup_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"access_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(apimanagementservice.AccessTypeSystemAssignedManagedIdentity),
+			ValidateFunc: validation.StringInSlice(apimanagementservice.PossibleValuesForAccessType(), false),
+		},
+
+		"access_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"client_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+// readApiManagementBackupRestore returns the Read function for both the backup and restore resources, where
+// parseServiceId returns the ID of the API Management Service from the ID of the resource
+func readApiManagementBackupRestore(parseServiceId func(input string) (*apimanagementservice.ServiceId, error)) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ServiceClient
+
+			id, err := parseServiceId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// a backup or restore is a one-off operation, so there's nothing to read back other than the service still existing
+			var state ApiManagementBackupRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+			state.ApiManagementId = id.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func expandApiManagementBackupRestoreParameters(input ApiManagementBackupRestoreModel) (*apimanagementservice.ApiManagementServiceBackupRestoreParameters, error) {
+	accessType := apimanagementservice.AccessType(input.AccessType)
+
+	switch accessType {
+	case apimanagementservice.AccessTypeAccessKey:
+		if input.AccessKey == "" {
+			return nil, fmt.Errorf("`access_key` must be specified when `access_type` is `%s`", accessType)
+		}
+	case apimanagementservice.AccessTypeUserAssignedManagedIdentity:
+		if input.ClientId == "" {
+			return nil, fmt.Errorf("`client_id` must be specified when `access_type` is `%s`", accessType)
+		}
+	}
+
+	if input.AccessKey != "" && accessType != apimanagementservice.AccessTypeAccessKey {
+		return nil, fmt.Errorf("`access_key` can only be specified when `access_type` is `%s`", apimanagementservice.AccessTypeAccessKey)
+	}
+	if input.ClientId != "" && accessType != apimanagementservice.AccessTypeUserAssignedManagedIdentity {
+		return nil, fmt.Errorf("`client_id` can only be specified when `access_type` is `%s`", apimanagementservice.AccessTypeUserAssignedManagedIdentity)
+	}
+
+	parameters := apimanagementservice.ApiManagementServiceBackupRestoreParameters{
+		AccessType:     pointer.To(accessType),
+		BackupName:     input.BackupName,
+		ContainerName:  input.ContainerName,
+		StorageAccount: input.StorageAccountName,
+	}
+	if input.AccessKey != "" {
+		parameters.AccessKey = pointer.To(input.AccessKey)
+	}
+	if input.ClientId != "" {
+		parameters.ClientId = pointer.To(input.ClientId)
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementBackupResource struct{}
+
+func TestAccApiManagementBackup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backup", "test")
+	r := ApiManagementBackupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccApiManagementBackup_accessKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backup", "test")
+	r := ApiManagementBackupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.accessKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r ApiManagementBackupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApiManagementBackupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiManagementServiceExists(ctx, client, apimanagementservice.NewServiceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName))
+}
+
+// the backup or restore itself can't be retrieved, so check that the API Management Service still exists
+func apiManagementServiceExists(ctx context.Context, client *clients.Client, id apimanagementservice.ServiceId) (*bool, error) {
+	resp, err := client.ApiManagement.ServiceClient.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ApiManagementBackupResource) basic(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backup" "test" {
+  api_management_id    = azurerm_api_management.test.id
+  storage_account_name = azurerm_storage_account.test.name
+  container_name       = azurerm_storage_container.test.name
+  backup_name          = "acctest-backup"
+
+  triggers = {
+    backup = "%s"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), trigger)
+}
+
+func (r ApiManagementBackupResource) accessKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backup" "test" {
+  api_management_id    = azurerm_api_management.test.id
+  storage_account_name = azurerm_storage_account.test.name
+  container_name       = azurerm_storage_container.test.name
+  backup_name          = "acctest-backup"
+  access_type          = "AccessKey"
+  access_key           = azurerm_storage_account.test.primary_access_key
+}
+`, r.template(data))
+}
+
+func (ApiManagementBackupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  sku_name            = "Developer_1"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "backups"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_api_management.test.identity[0].principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -80,6 +81,7 @@ func resourceApiManagementPolicyFragment() *pluginsdk.Resource {
 			"value": {
 				Type:             pluginsdk.TypeString,
 				Required:         true,
+				ValidateFunc:     validate.ApiManagementPolicyFragmentXml,
 				DiffSuppressFunc: XmlWhitespaceDiffSuppress,
 			},
 
@@ -37,6 +37,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/readmode"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -636,6 +637,12 @@ func resourceApiManagementSchema() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"read_mode": readmode.Schema(),
+
+		"etag": readmode.EtagSchema(),
+
+		"child_etags": readmode.ChildEtagsSchema(),
+
 		"tags": commonschema.Tags(),
 	}
 
@@ -1262,13 +1269,163 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	isConsumption := resp.Model != nil && resp.Model.Sku.Name == apimanagementservice.SkuTypeConsumption
+
+	var etag *string
+	if resp.Model != nil {
+		etag = resp.Model.Etag
+	}
+	readmode.Track(d, etag)
+
+	// the child items of the API Management Service are versioned independently of it and so aren't covered by its
+	// etag - when using the `shallow` read mode the etag of each child item is retrieved first, and only the child
+	// items which have changed since the last refresh are retrieved, the others being retained from the state
+	childEtags := readmode.NewChildEtags(d)
 	policyServiceId := policy.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+	signInSettingServiceId := signinsettings.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+	signUpSettingServiceId := signupsettings.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+	delegationSettingServiceId := delegationsettings.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
+	tenantAccessServiceId := tenantaccess.NewAccessID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, "access")
 	policyClient := meta.(*clients.Client).ApiManagement.PolicyClient
-	policy, err := policyClient.Get(ctx, policyServiceId, policy.GetOperationOptions{Format: pointer.To(policy.PolicyExportFormatXml)})
-	if err != nil {
-		if !response.WasNotFound(policy.HttpResponse) {
-			return fmt.Errorf("retrieving Policy for %s: %+v", *id, err)
+
+	if childEtags.Shallow() {
+		etagReads := []func(ctx context.Context) error{
+			func(ctx context.Context) error {
+				result, err := policyClient.GetEntityTag(ctx, policyServiceId)
+				if err != nil && !response.WasNotFound(result.HttpResponse) {
+					return fmt.Errorf("retrieving the etag of the Policy for %s: %+v", *id, err)
+				}
+				childEtags.Record("policy", result.HttpResponse)
+				return nil
+			},
 		}
+		if !isConsumption {
+			etagReads = append(etagReads,
+				func(ctx context.Context) error {
+					result, err := signInClient.GetEntityTag(ctx, signInSettingServiceId)
+					if err != nil {
+						return fmt.Errorf("retrieving the etag of the Sign In Settings for %s: %+v", *id, err)
+					}
+					childEtags.Record("sign_in", result.HttpResponse)
+					return nil
+				},
+				func(ctx context.Context) error {
+					result, err := signUpClient.GetEntityTag(ctx, signUpSettingServiceId)
+					if err != nil {
+						return fmt.Errorf("retrieving the etag of the Sign Up Settings for %s: %+v", *id, err)
+					}
+					childEtags.Record("sign_up", result.HttpResponse)
+					return nil
+				},
+				func(ctx context.Context) error {
+					result, err := delegationClient.GetEntityTag(ctx, delegationSettingServiceId)
+					if err != nil {
+						return fmt.Errorf("retrieving the etag of the Delegation Settings for %s: %+v", *id, err)
+					}
+					childEtags.Record("delegation", result.HttpResponse)
+					return nil
+				},
+				func(ctx context.Context) error {
+					result, err := tenantAccessClient.GetEntityTag(ctx, tenantAccessServiceId)
+					if err != nil {
+						return fmt.Errorf("retrieving the etag of the tenant access properties for %s: %+v", *id, err)
+					}
+					childEtags.Record("tenant_access", result.HttpResponse)
+					return nil
+				},
+			)
+		}
+		if err := meta.(*clients.Client).ReadLimiter.Run(ctx, etagReads...); err != nil {
+			return err
+		}
+	}
+
+	// the child items of the API Management Service are retrieved concurrently, to reduce the time taken to refresh
+	var policyResp policy.GetOperationResponse
+	var signInSettings signinsettings.GetOperationResponse
+	var signUpSettings signupsettings.GetOperationResponse
+	var delegationSettings delegationsettings.GetOperationResponse
+	var delegationValidationKeyContract delegationsettings.ListSecretsOperationResponse
+	var tenantAccessInformationContract tenantaccess.ListSecretsOperationResponse
+
+	readPolicy := childEtags.Changed("policy")
+	readSignIn := !isConsumption && childEtags.Changed("sign_in")
+	readSignUp := !isConsumption && childEtags.Changed("sign_up")
+	readDelegation := !isConsumption && childEtags.Changed("delegation")
+	readTenantAccess := !isConsumption && childEtags.Changed("tenant_access")
+
+	reads := make([]func(ctx context.Context) error, 0)
+	if readPolicy {
+		reads = append(reads, func(ctx context.Context) error {
+			result, err := policyClient.Get(ctx, policyServiceId, policy.GetOperationOptions{Format: pointer.To(policy.PolicyExportFormatXml)})
+			if err != nil && !response.WasNotFound(result.HttpResponse) {
+				return fmt.Errorf("retrieving Policy for %s: %+v", *id, err)
+			}
+			childEtags.Record("policy", result.HttpResponse)
+			policyResp = result
+			return nil
+		})
+	}
+	if readSignIn {
+		reads = append(reads, func(ctx context.Context) error {
+			result, err := signInClient.Get(ctx, signInSettingServiceId)
+			if err != nil {
+				return fmt.Errorf("retrieving Sign In Settings for %s: %+v", *id, err)
+			}
+			childEtags.Record("sign_in", result.HttpResponse)
+			signInSettings = result
+			return nil
+		})
+	}
+	if readSignUp {
+		reads = append(reads, func(ctx context.Context) error {
+			result, err := signUpClient.Get(ctx, signUpSettingServiceId)
+			if err != nil {
+				return fmt.Errorf("retrieving Sign Up Settings for %s: %+v", *id, err)
+			}
+			childEtags.Record("sign_up", result.HttpResponse)
+			signUpSettings = result
+			return nil
+		})
+	}
+	// regenerating the Delegation Validation Key and the tenant access keys updates the etag of the settings they belong to
+	if readDelegation {
+		reads = append(reads,
+			func(ctx context.Context) error {
+				result, err := delegationClient.Get(ctx, delegationSettingServiceId)
+				if err != nil {
+					return fmt.Errorf("retrieving Delegation Settings for %s: %+v", *id, err)
+				}
+				childEtags.Record("delegation", result.HttpResponse)
+				delegationSettings = result
+				return nil
+			},
+			func(ctx context.Context) error {
+				result, err := delegationClient.ListSecrets(ctx, delegationSettingServiceId)
+				if err != nil {
+					return fmt.Errorf("retrieving Delegation Validation Key for %s: %+v", *id, err)
+				}
+				delegationValidationKeyContract = result
+				return nil
+			},
+		)
+	}
+	if readTenantAccess {
+		reads = append(reads, func(ctx context.Context) error {
+			result, err := tenantAccessClient.ListSecrets(ctx, tenantAccessServiceId)
+			if err != nil {
+				return fmt.Errorf("retrieving tenant access properties for %s: %+v", *id, err)
+			}
+			childEtags.Record("tenant_access", result.HttpResponse)
+			tenantAccessInformationContract = result
+			return nil
+		})
+	}
+	if err := meta.(*clients.Client).ReadLimiter.Run(ctx, reads...); err != nil {
+		return err
+	}
+	if err := childEtags.Set(d); err != nil {
+		return fmt.Errorf("setting `child_etags`: %+v", err)
 	}
 
 	d.Set("name", id.ServiceName)
@@ -1343,61 +1500,42 @@ func resourceApiManagementServiceRead(d *pluginsdk.ResourceData, meta interface{
 			return fmt.Errorf("setting `sku_name`: %+v", err)
 		}
 
-		if !features.FourPointOhBeta() {
-			if err := d.Set("policy", flattenApiManagementPolicies(d, policy.Model)); err != nil {
+		if !features.FourPointOhBeta() && readPolicy {
+			if err := d.Set("policy", flattenApiManagementPolicies(d, policyResp.Model)); err != nil {
 				return fmt.Errorf("setting `policy`: %+v", err)
 			}
 		}
 
 		d.Set("zones", zones.FlattenUntyped(model.Zones))
 
-		if model.Sku.Name != apimanagementservice.SkuTypeConsumption {
-			signInSettingServiceId := signinsettings.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
-			signInSettings, err := signInClient.Get(ctx, signInSettingServiceId)
-			if err != nil {
-				return fmt.Errorf("retrieving Sign In Settings for %s: %+v", *id, err)
-			}
-			if err := d.Set("sign_in", flattenApiManagementSignInSettings(*signInSettings.Model)); err != nil {
-				return fmt.Errorf("setting `sign_in`: %+v", err)
-			}
-
-			signUpSettingServiceId := signupsettings.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
-			signUpSettings, err := signUpClient.Get(ctx, signUpSettingServiceId)
-			if err != nil {
-				return fmt.Errorf("retrieving Sign Up Settings for %s: %+v", *id, err)
-			}
-
-			if err := d.Set("sign_up", flattenApiManagementSignUpSettings(*signUpSettings.Model)); err != nil {
-				return fmt.Errorf("setting `sign_up`: %+v", err)
-			}
-
-			delegationSettingServiceId := delegationsettings.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName)
-			delegationSettings, err := delegationClient.Get(ctx, delegationSettingServiceId)
-			if err != nil {
-				return fmt.Errorf("retrieving Delegation Settings for %s: %+v", *id, err)
+		if isConsumption {
+			d.Set("sign_in", []interface{}{})
+			d.Set("sign_up", []interface{}{})
+			d.Set("delegation", []interface{}{})
+		} else {
+			if readSignIn {
+				if err := d.Set("sign_in", flattenApiManagementSignInSettings(*signInSettings.Model)); err != nil {
+					return fmt.Errorf("setting `sign_in`: %+v", err)
+				}
 			}
 
-			delegationValidationKeyContract, err := delegationClient.ListSecrets(ctx, delegationSettingServiceId)
-			if err != nil {
-				return fmt.Errorf("retrieving Delegation Validation Key for %s: %+v", *id, err)
+			if readSignUp {
+				if err := d.Set("sign_up", flattenApiManagementSignUpSettings(*signUpSettings.Model)); err != nil {
+					return fmt.Errorf("setting `sign_up`: %+v", err)
+				}
 			}
 
-			if err := d.Set("delegation", flattenApiManagementDelegationSettings(*delegationSettings.Model, *delegationValidationKeyContract.Model)); err != nil {
-				return fmt.Errorf("setting `delegation`: %+v", err)
+			if readDelegation {
+				if err := d.Set("delegation", flattenApiManagementDelegationSettings(*delegationSettings.Model, *delegationValidationKeyContract.Model)); err != nil {
+					return fmt.Errorf("setting `delegation`: %+v", err)
+				}
 			}
 
-			tenantAccessServiceId := tenantaccess.NewAccessID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, "access")
-			tenantAccessInformationContract, err := tenantAccessClient.ListSecrets(ctx, tenantAccessServiceId)
-			if err != nil {
-				return fmt.Errorf("retrieving tenant access properties for %s: %+v", *id, err)
-			}
-			if err := d.Set("tenant_access", flattenApiManagementTenantAccessSettings(*tenantAccessInformationContract.Model)); err != nil {
-				return fmt.Errorf("setting `tenant_access`: %+v", err)
+			if readTenantAccess {
+				if err := d.Set("tenant_access", flattenApiManagementTenantAccessSettings(*tenantAccessInformationContract.Model)); err != nil {
+					return fmt.Errorf("setting `tenant_access`: %+v", err)
+				}
 			}
-		} else {
-			d.Set("sign_in", []interface{}{})
-			d.Set("sign_up", []interface{}{})
-			d.Set("delegation", []interface{}{})
 		}
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
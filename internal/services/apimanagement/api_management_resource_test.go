@@ -39,6 +39,33 @@ func TestAccApiManagement_basic(t *testing.T) {
 	})
 }
 
+func TestAccApiManagement_readModeShallow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management", "test")
+	r := ApiManagementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.readModeShallow(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("read_mode").HasValue("shallow"),
+				check.That(data.ResourceName).Key("etag").IsSet(),
+				check.That(data.ResourceName).Key("child_etags.%").HasValue("5"),
+				check.That(data.ResourceName).Key("sign_in.#").HasValue("1"),
+				check.That(data.ResourceName).Key("tenant_access.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("read_mode"),
+	})
+}
+
 func TestAccApiManagement_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management", "test")
 	r := ApiManagementResource{}
@@ -977,6 +1004,30 @@ resource "azurerm_api_management" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ApiManagementResource) readModeShallow(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+  read_mode           = "shallow"
+
+  sku_name = "Developer_1"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ApiManagementResource) additionalLocationGateway(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ApiManagementRestoreResource restores an API Management Service from a backup in a Storage Account when created, or
+// when one of the triggers changes - the restore can't be reverted, so removing this resource only removes it from the state.
+type ApiManagementRestoreResource struct{}
+
+var _ sdk.ResourceWithCustomImporter = ApiManagementRestoreResource{}
+
+func (r ApiManagementRestoreResource) ModelObject() interface{} {
+	return &ApiManagementBackupRestoreModel{}
+}
+
+func (r ApiManagementRestoreResource) ResourceType() string {
+	return "azurerm_api_management_restore"
+}
+
+func (r ApiManagementRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ApiManagementRestoreID
+}
+
+func (r ApiManagementRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return apiManagementBackupRestoreArguments()
+}
+
+func (r ApiManagementRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.ServiceClient
+
+			var restore ApiManagementBackupRestoreModel
+			if err := metadata.Decode(&restore); err != nil {
+				return err
+			}
+
+			serviceId, err := apimanagementservice.ParseServiceID(restore.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			name, err := sdk.NewActionResourceName()
+			if err != nil {
+				return err
+			}
+			id := parse.NewApiManagementRestoreID(serviceId.SubscriptionId, serviceId.ResourceGroupName, serviceId.ServiceName, name)
+
+			if _, err := client.Get(ctx, *serviceId); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", serviceId, err)
+			}
+
+			parameters, err := expandApiManagementBackupRestoreParameters(restore)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(serviceId.ID())
+			defer locks.UnlockByID(serviceId.ID())
+
+			if err := client.RestoreThenPoll(ctx, *serviceId, *parameters); err != nil {
+				return fmt.Errorf("restoring %s from the backup %q: %+v", serviceId, restore.BackupName, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementRestoreResource) Read() sdk.ResourceFunc {
+	return readApiManagementBackupRestore(func(input string) (*apimanagementservice.ServiceId, error) {
+		id, err := parse.ApiManagementRestoreID(input)
+		if err != nil {
+			return nil, err
+		}
+
+		return pointer.To(apimanagementservice.NewServiceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName)), nil
+	})
+}
+
+func (r ApiManagementRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ActionResourceDelete()
+}
+
+func (r ApiManagementRestoreResource) CustomImporter() sdk.ResourceRunFunc {
+	return sdk.ActionResourceImporter(r.ResourceType())
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ApiManagementRestoreResource struct{}
+
+func TestAccApiManagementRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_restore", "test")
+	r := ApiManagementRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r ApiManagementRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApiManagementRestoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiManagementServiceExists(ctx, client, apimanagementservice.NewServiceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName))
+}
+
+func (r ApiManagementRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_restore" "test" {
+  api_management_id    = azurerm_api_management_backup.test.api_management_id
+  storage_account_name = azurerm_api_management_backup.test.storage_account_name
+  container_name       = azurerm_api_management_backup.test.container_name
+  backup_name          = azurerm_api_management_backup.test.backup_name
+}
+`, ApiManagementBackupResource{}.basic(data, "first"))
+}
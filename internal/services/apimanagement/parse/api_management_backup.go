@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApiManagementBackupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	BackupName     string
+}
+
+func NewApiManagementBackupID(subscriptionId, resourceGroup, serviceName, backupName string) ApiManagementBackupId {
+	return ApiManagementBackupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		BackupName:     backupName,
+	}
+}
+
+func (id ApiManagementBackupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Backup Name %q", id.BackupName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Api Management Backup", segmentsStr)
+}
+
+func (id ApiManagementBackupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/backups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.BackupName)
+}
+
+// ApiManagementBackupID parses a ApiManagementBackup ID into an ApiManagementBackupId struct
+func ApiManagementBackupID(input string) (*ApiManagementBackupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ApiManagementBackup ID: %+v", input, err)
+	}
+
+	resourceId := ApiManagementBackupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackupName, err = id.PopSegment("backups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ApiManagementRestoreId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	RestoreName    string
+}
+
+func NewApiManagementRestoreID(subscriptionId, resourceGroup, serviceName, restoreName string) ApiManagementRestoreId {
+	return ApiManagementRestoreId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		RestoreName:    restoreName,
+	}
+}
+
+func (id ApiManagementRestoreId) String() string {
+	segments := []string{
+		fmt.Sprintf("Restore Name %q", id.RestoreName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Api Management Restore", segmentsStr)
+}
+
+func (id ApiManagementRestoreId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/restores/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.RestoreName)
+}
+
+// ApiManagementRestoreID parses a ApiManagementRestore ID into an ApiManagementRestoreId struct
+func ApiManagementRestoreID(input string) (*ApiManagementRestoreId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ApiManagementRestore ID: %+v", input, err)
+	}
+
+	resourceId := ApiManagementRestoreId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.RestoreName, err = id.PopSegment("restores"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// policySections are the only elements which are allowed directly beneath the `<policies>` root element
+var policySections = map[string]struct{}{
+	"inbound":  {},
+	"backend":  {},
+	"outbound": {},
+	"on-error": {},
+}
+
+// ApiManagementPolicyXml validates that the value is a well-formed API Management policy document, that is
+// a `<policies>` root element containing the `inbound`, `backend`, `outbound` and `on-error` sections, where
+// any policy expressions are terminated and any `include-fragment` has a `fragment-id` which is a
+// valid Policy Fragment name - whether the Policy Fragment exists is only checked by the API during apply.
+func ApiManagementPolicyXml(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	return warnings, validateApiManagementPolicyDocument(value, k, "policies")
+}
+
+// ApiManagementPolicyFragmentXml validates that the value is a well-formed API Management Policy Fragment,
+// that is a `<fragment>` root element where any policy expressions are terminated.
+func ApiManagementPolicyFragmentXml(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	return warnings, validateApiManagementPolicyDocument(value, k, "fragment")
+}
+
+func validateApiManagementPolicyDocument(input string, k string, rootElement string) (errors []error) {
+	if strings.TrimSpace(input) == "" {
+		return append(errors, fmt.Errorf("%q must not be empty", k))
+	}
+
+	// policy expressions are C# and (when using `rawxml`) aren't valid XML - so these are checked and then
+	// swapped out for a placeholder, allowing the remainder of the document to be parsed as XML
+	sanitized, err := replaceApiManagementPolicyExpressions(input)
+	if err != nil {
+		return append(errors, fmt.Errorf("%q contains an invalid policy expression: %+v", k, err))
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(sanitized))
+	decoder.Entity = xml.HTMLEntity
+
+	depth := 0
+	foundRoot := false
+	seenSections := make(map[string]struct{})
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return append(errors, fmt.Errorf("%q is not valid XML: %+v", k, err))
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+			name := element.Name.Local
+			depth++
+
+			if depth == 1 {
+				if foundRoot {
+					errors = append(errors, fmt.Errorf("%q must contain a single `<%s>` root element", k, rootElement))
+					continue
+				}
+				foundRoot = true
+				if name != rootElement {
+					return append(errors, fmt.Errorf("%q must have a root element of `<%s>` but got `<%s>`", k, rootElement, name))
+				}
+				continue
+			}
+
+			if depth == 2 && rootElement == "policies" {
+				if _, ok := policySections[name]; !ok {
+					errors = append(errors, fmt.Errorf("%q contains the element `<%s>` directly within `<policies>` - only `<inbound>`, `<backend>`, `<outbound>` and `<on-error>` are supported", k, name))
+				}
+				if _, ok := seenSections[name]; ok {
+					errors = append(errors, fmt.Errorf("%q contains the section `<%s>` more than once", k, name))
+				}
+				seenSections[name] = struct{}{}
+			}
+
+			if name == "include-fragment" {
+				errors = append(errors, validateApiManagementIncludeFragment(element, k)...)
+			}
+
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if !foundRoot {
+		errors = append(errors, fmt.Errorf("%q must contain a `<%s>` root element", k, rootElement))
+	}
+
+	return errors
+}
+
+func validateApiManagementIncludeFragment(element xml.StartElement, k string) (errors []error) {
+	for _, attr := range element.Attr {
+		if attr.Name.Local != "fragment-id" {
+			continue
+		}
+
+		if _, errs := ApiManagementChildName(attr.Value, "fragment-id"); len(errs) > 0 {
+			errors = append(errors, fmt.Errorf("%q contains an `<include-fragment>` referencing the invalid Policy Fragment name %q", k, attr.Value))
+		}
+		return errors
+	}
+
+	return append(errors, fmt.Errorf("%q contains an `<include-fragment>` element without a `fragment-id` attribute", k))
+}
+
+// replaceApiManagementPolicyExpressions replaces each single-statement (`@(...)`) and multi-statement (`@{...}`)
+// policy expression within the input with a placeholder, returning an error if an expression isn't terminated,
+// is empty, or (for a multi-statement expression) doesn't return a value.
+func replaceApiManagementPolicyExpressions(input string) (string, error) {
+	var output strings.Builder
+
+	for i := 0; i < len(input); i++ {
+		if input[i] != '@' || i+1 >= len(input) || (input[i+1] != '(' && input[i+1] != '{') {
+			output.WriteByte(input[i])
+			continue
+		}
+
+		open := input[i+1]
+		end, err := findApiManagementPolicyExpressionEnd(input, i+1)
+		if err != nil {
+			return "", err
+		}
+
+		body := strings.TrimSpace(input[i+2 : end])
+		if body == "" {
+			return "", fmt.Errorf("the expression at position %d is empty", i)
+		}
+		if open == '{' && !strings.Contains(body, "return") {
+			return "", fmt.Errorf("the multi-statement expression at position %d must contain a `return` statement", i)
+		}
+
+		output.WriteString("expression")
+		i = end
+	}
+
+	return output.String(), nil
+}
+
+// findApiManagementPolicyExpressionEnd returns the position of the bracket closing the one at `start`, skipping any
+// brackets found within C# string or character literals (which may be XML-escaped using `&quot;`).
+func findApiManagementPolicyExpressionEnd(input string, start int) (int, error) {
+	open := input[start]
+	closing := byte(')')
+	if open == '{' {
+		closing = '}'
+	}
+
+	depth := 0
+	var quote string
+	for i := start; i < len(input); i++ {
+		if quote != "" {
+			if input[i] == '\\' {
+				i++
+				continue
+			}
+			if strings.HasPrefix(input[i:], quote) {
+				i += len(quote) - 1
+				quote = ""
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(input[i:], "&quot;"):
+			quote = "&quot;"
+			i += len(quote) - 1
+		case input[i] == '"' || input[i] == '\'':
+			quote = string(input[i])
+		case input[i] == open:
+			depth++
+		case input[i] == closing:
+			depth--
+			if depth == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("the expression starting at position %d is not terminated, expected a closing `%c`", start-1, closing)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestApiManagementPolicyXml(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Value    string
+		ErrCount int
+	}{
+		{
+			Name:     "empty",
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Name:     "not xml",
+			Value:    "hello world",
+			ErrCount: 1,
+		},
+		{
+			Name: "basic",
+			Value: `<policies>
+  <inbound>
+    <base />
+    <find-and-replace from="xyz" to="abc" />
+  </inbound>
+</policies>`,
+			ErrCount: 0,
+		},
+		{
+			Name: "all sections",
+			Value: `<policies>
+  <inbound><base /></inbound>
+  <backend><base /></backend>
+  <outbound><base /></outbound>
+  <on-error><base /></on-error>
+</policies>`,
+			ErrCount: 0,
+		},
+		{
+			Name: "raw single-statement expression",
+			Value: `<policies>
+  <inbound>
+    <set-variable name="abc" value="@(context.Request.Headers.GetValueOrDefault("X-Header-Name", ""))" />
+  </inbound>
+</policies>`,
+			ErrCount: 0,
+		},
+		{
+			Name: "escaped multi-statement expression",
+			Value: `<policies>
+  <inbound>
+    <set-header name="Authorization" exists-action="override">
+      <value>@{
+        var responseObj = ((IResponse)context.Variables[&quot;getCertResponse&quot;]).Body.As&lt;JObject&gt;();
+        var jwt = (string)responseObj[&quot;payload&quot;];
+        return $&quot;Bearer {jwt}&quot;;}</value>
+    </set-header>
+  </inbound>
+</policies>`,
+			ErrCount: 0,
+		},
+		{
+			Name: "raw multi-statement expression with generics",
+			Value: `<policies>
+  <inbound>
+    <set-body>@{ var body = context.Request.Body.As<JObject>(); return body.ToString(); }</set-body>
+  </inbound>
+</policies>`,
+			ErrCount: 0,
+		},
+		{
+			Name: "unterminated expression",
+			Value: `<policies>
+  <inbound>
+    <set-variable name="abc" value="@(context.Request.Headers.GetValueOrDefault("X-Header-Name", "")" />
+  </inbound>
+</policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "empty expression",
+			Value:    `<policies><inbound><set-variable name="abc" value="@()" /></inbound></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "multi-statement expression without return",
+			Value:    `<policies><inbound><set-body>@{ var x = 1; }</set-body></inbound></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "unclosed element",
+			Value:    `<policies><inbound><base /></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "wrong root element",
+			Value:    `<fragment><base /></fragment>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "unknown section",
+			Value:    `<policies><inbound /><middle /></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "duplicate section",
+			Value:    `<policies><inbound /><inbound /></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "include fragment",
+			Value:    `<policies><inbound><include-fragment fragment-id="my-fragment" /></inbound></policies>`,
+			ErrCount: 0,
+		},
+		{
+			Name:     "include fragment invalid name",
+			Value:    `<policies><inbound><include-fragment fragment-id="my fragment!" /></inbound></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "include fragment missing id",
+			Value:    `<policies><inbound><include-fragment /></inbound></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name: "comments",
+			Value: `<!-- a comment -->
+<policies>
+  <inbound>
+    <!-- another comment -->
+    <base />
+  </inbound>
+</policies>`,
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := ApiManagementPolicyXml(tc.Value, "xml_content")
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("expected %d errors but got %d: %+v", tc.ErrCount, len(errors), errors)
+			}
+		})
+	}
+}
+
+func TestApiManagementPolicyFragmentXml(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Value    string
+		ErrCount int
+	}{
+		{
+			Name:     "empty",
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Name: "xml",
+			Value: `<fragment>
+  <json-to-xml apply="always" consider-accept-header="false" />
+</fragment>`,
+			ErrCount: 0,
+		},
+		{
+			Name: "rawxml",
+			Value: `<fragment>
+  <set-variable name="var" value="@("user id:" + context.User?.Id)" />
+</fragment>`,
+			ErrCount: 0,
+		},
+		{
+			Name:     "policies root element",
+			Value:    `<policies><inbound /></policies>`,
+			ErrCount: 1,
+		},
+		{
+			Name:     "multiple root elements",
+			Value:    `<fragment /><fragment />`,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, errors := ApiManagementPolicyFragmentXml(tc.Value, "value")
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("expected %d errors but got %d: %+v", tc.ErrCount, len(errors), errors)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ type ApplicationInsightsWorkbookModel struct {
 	SourceId           string            `tfschema:"source_id"`
 	StorageContainerId string            `tfschema:"storage_container_id"`
 	Tags               map[string]string `tfschema:"tags"`
+	ContentHash        string            `tfschema:"content_hash"`
 }
 
 type ApplicationInsightsWorkbookResource struct{}
@@ -122,7 +123,12 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 }
 
 func (r ApplicationInsightsWorkbookResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
@@ -179,7 +185,8 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+
+			return r.setContentHash(ctx, metadata, id)
 		},
 	}
 }
@@ -237,7 +244,7 @@ func (r ApplicationInsightsWorkbookResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
-			return nil
+			return r.setContentHash(ctx, metadata, *id)
 		},
 	}
 }
@@ -291,7 +298,8 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 
 				state.DisplayName = properties.DisplayName
 
-				state.DataJson = properties.SerializedData
+				state.DataJson = workbookContentFromState(metadata.ResourceData.Get("data_json").(string), metadata.ResourceData.Get("content_hash").(string), properties.SerializedData)
+				state.ContentHash = workbookContentHash(properties.SerializedData)
 
 				if properties.SourceId != nil {
 					state.SourceId = *properties.SourceId
@@ -332,3 +340,20 @@ func (r ApplicationInsightsWorkbookResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// setContentHash records the hash of the content of the Workbook as it's returned by the API once it's been applied,
+// so that the content from the configuration is retained in the state until the Workbook is changed outside of Terraform
+func (r ApplicationInsightsWorkbookResource) setContentHash(ctx context.Context, metadata sdk.ResourceMetaData, id workbooks.WorkbookId) error {
+	client := metadata.Client.AppInsights.WorkbookClient
+
+	resp, err := client.WorkbooksGet(ctx, id, workbooks.WorkbooksGetOperationOptions{CanFetchContent: utils.Bool(true)})
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		return metadata.ResourceData.Set("content_hash", workbookContentHash(model.Properties.SerializedData))
+	}
+
+	return nil
+}
@@ -28,6 +28,7 @@ func TestAccApplicationInsightsWorkbook_basic(t *testing.T) {
 			Config: r.basic(data, data.RandomInteger),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
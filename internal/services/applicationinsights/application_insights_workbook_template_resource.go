@@ -29,6 +29,7 @@ type ApplicationInsightsWorkbookTemplateModel struct {
 	Priority          int64                          `tfschema:"priority"`
 	Tags              map[string]string              `tfschema:"tags"`
 	TemplateData      string                         `tfschema:"template_data"`
+	ContentHash       string                         `tfschema:"content_hash"`
 }
 
 type WorkbookTemplateGalleryModel struct {
@@ -139,7 +140,12 @@ func (r ApplicationInsightsWorkbookTemplateResource) Arguments() map[string]*plu
 }
 
 func (r ApplicationInsightsWorkbookTemplateResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"content_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r ApplicationInsightsWorkbookTemplateResource) Create() sdk.ResourceFunc {
@@ -202,7 +208,8 @@ func (r ApplicationInsightsWorkbookTemplateResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+
+			return r.setContentHash(ctx, metadata, id)
 		},
 	}
 }
@@ -275,7 +282,7 @@ func (r ApplicationInsightsWorkbookTemplateResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
-			return nil
+			return r.setContentHash(ctx, metadata, *id)
 		},
 	}
 }
@@ -328,7 +335,8 @@ func (r ApplicationInsightsWorkbookTemplateResource) Read() sdk.ResourceFunc {
 						return err
 					}
 
-					state.TemplateData = string(templateDataValue)
+					state.TemplateData = workbookContentFromState(metadata.ResourceData.Get("template_data").(string), metadata.ResourceData.Get("content_hash").(string), string(templateDataValue))
+					state.ContentHash = workbookContentHash(string(templateDataValue))
 				}
 
 				if properties.Localized != nil {
@@ -370,6 +378,28 @@ func (r ApplicationInsightsWorkbookTemplateResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+// setContentHash records the hash of the template data as it's returned by the API once it's been applied,
+// so that the template data from the configuration is retained in the state until it's changed outside of Terraform
+func (r ApplicationInsightsWorkbookTemplateResource) setContentHash(ctx context.Context, metadata sdk.ResourceMetaData, id workbooktemplates.WorkbookTemplateId) error {
+	client := metadata.Client.AppInsights.WorkbookTemplateClient
+
+	resp, err := client.WorkbookTemplatesGet(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.TemplateData != nil {
+		templateDataValue, err := json.Marshal(model.Properties.TemplateData)
+		if err != nil {
+			return err
+		}
+
+		return metadata.ResourceData.Set("content_hash", workbookContentHash(string(templateDataValue)))
+	}
+
+	return nil
+}
+
 func expandWorkbookTemplateGalleryModel(inputList []WorkbookTemplateGalleryModel) *[]workbooktemplates.WorkbookTemplateGallery {
 	var outputList []workbooktemplates.WorkbookTemplateGallery
 	for _, input := range inputList {
@@ -27,6 +27,7 @@ func TestAccApplicationInsightsWorkbookTemplate_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_hash").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
@@ -4,6 +4,9 @@
 package applicationinsights
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -34,3 +37,31 @@ func flattenApplicationInsightsAPIKeyLinkedProperties(props *[]string) *[]string
 	}
 	return &result
 }
+
+// workbookContentHash returns the SHA-256 hash of the normalised JSON content of a Workbook or Workbook Template,
+// which is used to detect whether the content was changed outside of Terraform without comparing the content itself,
+// since the API returns the content re-serialised in a different format to the one which was sent.
+func workbookContentHash(input string) string {
+	content := input
+
+	var v interface{}
+	if err := json.Unmarshal([]byte(input), &v); err == nil {
+		if normalised, err := json.Marshal(v); err == nil {
+			content = string(normalised)
+		}
+	}
+
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+// workbookContentFromState returns the content which should be set into the state, the content from the
+// configuration is retained as long as the content in Azure is unchanged since it was last applied, so that
+// changes in how the API serialises the content don't show up as a diff - otherwise the content from Azure is returned.
+func workbookContentFromState(existingContent, existingHash, remoteContent string) string {
+	if existingContent != "" && existingHash != "" && existingHash == workbookContentHash(remoteContent) {
+		return existingContent
+	}
+
+	return remoteContent
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import "testing"
+
+func TestWorkbookContentFromState(t *testing.T) {
+	configured := `{"version": "Notebook/1.0", "items": []}`
+	remote := `{"items":[],"version":"Notebook/1.0","isLocked":false}`
+	changed := `{"items":[{"type":1}],"version":"Notebook/1.0","isLocked":false}`
+
+	testData := []struct {
+		name            string
+		existingContent string
+		existingHash    string
+		remoteContent   string
+		expected        string
+	}{
+		{
+			name:          "imported",
+			remoteContent: remote,
+			expected:      remote,
+		},
+		{
+			name:            "no hash recorded",
+			existingContent: configured,
+			remoteContent:   remote,
+			expected:        remote,
+		},
+		{
+			name:            "unchanged since applied",
+			existingContent: configured,
+			existingHash:    workbookContentHash(remote),
+			remoteContent:   remote,
+			expected:        configured,
+		},
+		{
+			name:            "unchanged since applied with different formatting",
+			existingContent: configured,
+			existingHash:    workbookContentHash(remote),
+			remoteContent:   `{"isLocked": false, "version": "Notebook/1.0", "items": []}`,
+			expected:        configured,
+		},
+		{
+			name:            "changed outside of terraform",
+			existingContent: configured,
+			existingHash:    workbookContentHash(remote),
+			remoteContent:   changed,
+			expected:        changed,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := workbookContentFromState(v.existingContent, v.existingHash, v.remoteContent)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
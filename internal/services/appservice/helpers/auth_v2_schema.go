@@ -8,8 +8,10 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/tombuildsstuff/kermit/sdk/web/2022-09-01/web"
@@ -565,9 +567,10 @@ func flattenAuthV2LoginSettings(input *webapps.Login) []AuthV2Login {
 }
 
 type AppleAuthV2Settings struct {
-	ClientId                string   `tfschema:"client_id"`
-	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
-	LoginScopes             []string `tfschema:"login_scopes"`
+	ClientId                     string   `tfschema:"client_id"`
+	ClientSecretSettingName      string   `tfschema:"client_secret_setting_name"`
+	ClientSecretKeyVaultSecretId string   `tfschema:"client_secret_key_vault_secret_id"`
+	LoginScopes                  []string `tfschema:"login_scopes"`
 }
 
 func AppleAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -602,6 +605,13 @@ func AppleAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description:  "The app setting name that contains the `client_secret` value used for Apple Login.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the `client_secret` value used for Apple Login. A Key Vault Reference to this Secret is added to the App Setting named in `client_secret_setting_name`.",
+				},
+
 				"login_scopes": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -632,6 +642,12 @@ func AppleAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The app setting name that contains the `client_secret` value used for Apple Login.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the `client_secret` value used for Apple Login.",
+				},
+
 				"login_scopes": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -687,6 +703,7 @@ type AadAuthV2Settings struct {
 	TenantAuthURI                     string            `tfschema:"tenant_auth_endpoint"` // Maps to OpenIDIssuer, takes the form `https://login.microsoftonline.com/v2.0/{tenant-guid}/`
 	ClientId                          string            `tfschema:"client_id"`
 	ClientSecretSettingName           string            `tfschema:"client_secret_setting_name"`
+	ClientSecretKeyVaultSecretId      string            `tfschema:"client_secret_key_vault_secret_id"`
 	ClientSecretCertificateThumbprint string            `tfschema:"client_secret_certificate_thumbprint"`
 	LoginParameters                   map[string]string `tfschema:"login_parameters"`
 	DisableWWWAuth                    bool              `tfschema:"www_authentication_disabled"`
@@ -740,6 +757,16 @@ func AadAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description: "The App Setting name that contains the client secret of the Client.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					RequiredWith: []string{
+						"auth_settings_v2.0.active_directory_v2.0.client_secret_setting_name",
+					},
+					Description: "The ID of the Key Vault Secret containing the client secret of the Client. A Key Vault Reference to this Secret is added to the App Setting named in `client_secret_setting_name`.",
+				},
+
 				"client_secret_certificate_thumbprint": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
@@ -852,6 +879,12 @@ func AadAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The App Setting name that contains the client secret of the Client.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the client secret of the Client.",
+				},
+
 				"client_secret_certificate_thumbprint": {
 					Type:        pluginsdk.TypeString,
 					Computed:    true,
@@ -1140,17 +1173,18 @@ func flattenStaticWebAppAuthV2Settings(input *webapps.AzureStaticWebApps) []Stat
 }
 
 type CustomOIDCAuthV2Settings struct {
-	Name                        string   `tfschema:"name"`
-	ClientId                    string   `tfschema:"client_id"`
-	ClientCredentialMethod      string   `tfschema:"client_credential_method"`
-	ClientSecretSettingName     string   `tfschema:"client_secret_setting_name"`
-	AuthorizationEndpoint       string   `tfschema:"authorisation_endpoint"`
-	TokenEndpoint               string   `tfschema:"token_endpoint"`
-	IssuerEndpoint              string   `tfschema:"issuer_endpoint"`
-	CertificationURI            string   `tfschema:"certification_uri"`
-	OpenIDConfigurationEndpoint string   `tfschema:"openid_configuration_endpoint"`
-	NameClaimType               string   `tfschema:"name_claim_type"`
-	Scopes                      []string `tfschema:"scopes"`
+	Name                         string   `tfschema:"name"`
+	ClientId                     string   `tfschema:"client_id"`
+	ClientCredentialMethod       string   `tfschema:"client_credential_method"`
+	ClientSecretSettingName      string   `tfschema:"client_secret_setting_name"`
+	ClientSecretKeyVaultSecretId string   `tfschema:"client_secret_key_vault_secret_id"`
+	AuthorizationEndpoint        string   `tfschema:"authorisation_endpoint"`
+	TokenEndpoint                string   `tfschema:"token_endpoint"`
+	IssuerEndpoint               string   `tfschema:"issuer_endpoint"`
+	CertificationURI             string   `tfschema:"certification_uri"`
+	OpenIDConfigurationEndpoint  string   `tfschema:"openid_configuration_endpoint"`
+	NameClaimType                string   `tfschema:"name_claim_type"`
+	Scopes                       []string `tfschema:"scopes"`
 }
 
 func CustomOIDCAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -1219,6 +1253,13 @@ func CustomOIDCAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description: "The App Setting name that contains the secret for this Custom OIDC Client.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the secret for this Custom OIDC Client. A Key Vault Reference to this Secret is added to the App Setting named in `client_secret_setting_name`.",
+				},
+
 				"authorisation_endpoint": {
 					Type:        pluginsdk.TypeString,
 					Computed:    true,
@@ -1298,6 +1339,12 @@ func CustomOIDCAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The App Setting name that contains the secret for this Custom OIDC Client.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the secret for this Custom OIDC Client.",
+				},
+
 				"authorisation_endpoint": {
 					Type:        pluginsdk.TypeString,
 					Computed:    true,
@@ -1341,7 +1388,7 @@ func expandCustomOIDCAuthV2Settings(input []CustomOIDCAuthV2Settings) map[string
 				ClientId: pointer.To(v.ClientId),
 				ClientCredential: &webapps.OpenIdConnectClientCredential{
 					Method:                  pointer.To(webapps.ClientCredentialMethodClientSecretPost),
-					ClientSecretSettingName: pointer.To(fmt.Sprintf("%s_PROVIDER_AUTHENTICATION_SECRET", strings.ToUpper(v.Name))),
+					ClientSecretSettingName: pointer.To(customOIDCAuthV2SecretSettingName(v.Name)),
 				},
 				OpenIdConnectConfiguration: &webapps.OpenIdConnectConfig{
 					WellKnownOpenIdConfiguration: pointer.To(v.OpenIDConfigurationEndpoint),
@@ -1362,6 +1409,11 @@ func expandCustomOIDCAuthV2Settings(input []CustomOIDCAuthV2Settings) map[string
 	return result
 }
 
+// customOIDCAuthV2SecretSettingName returns the name of the App Setting containing the secret for the named Custom OIDC Provider
+func customOIDCAuthV2SecretSettingName(name string) string {
+	return fmt.Sprintf("%s_PROVIDER_AUTHENTICATION_SECRET", strings.ToUpper(name))
+}
+
 func flattenCustomOIDCAuthV2Settings(input *map[string]webapps.CustomOpenIdConnectProvider) []CustomOIDCAuthV2Settings {
 	if input == nil || len(*input) == 0 {
 		return []CustomOIDCAuthV2Settings{}
@@ -1403,10 +1455,11 @@ func flattenCustomOIDCAuthV2Settings(input *map[string]webapps.CustomOpenIdConne
 }
 
 type FacebookAuthV2Settings struct {
-	AppId                string   `tfschema:"app_id"`
-	AppSecretSettingName string   `tfschema:"app_secret_setting_name"`
-	LoginScopes          []string `tfschema:"login_scopes"`
-	GraphAPIVersion      string   `tfschema:"graph_api_version"`
+	AppId                     string   `tfschema:"app_id"`
+	AppSecretSettingName      string   `tfschema:"app_secret_setting_name"`
+	AppSecretKeyVaultSecretId string   `tfschema:"app_secret_key_vault_secret_id"`
+	LoginScopes               []string `tfschema:"login_scopes"`
+	GraphAPIVersion           string   `tfschema:"graph_api_version"`
 }
 
 func FacebookAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -1441,6 +1494,13 @@ func FacebookAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description:  "The app setting name that contains the `app_secret` value used for Facebook Login.",
 				},
 
+				"app_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the `app_secret` value used for Facebook Login. A Key Vault Reference to this Secret is added to the App Setting named in `app_secret_setting_name`.",
+				},
+
 				"graph_api_version": {
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
@@ -1480,6 +1540,12 @@ func FacebookAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The app setting name that contains the `app_secret` value used for Facebook Login.",
 				},
 
+				"app_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the `app_secret` value used for Facebook Login.",
+				},
+
 				"graph_api_version": {
 					Type:        pluginsdk.TypeString,
 					Computed:    true,
@@ -1544,9 +1610,10 @@ func flattenFacebookAuthV2Settings(input *webapps.Facebook) []FacebookAuthV2Sett
 }
 
 type GithubAuthV2Settings struct {
-	ClientId                string   `tfschema:"client_id"`
-	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
-	LoginScopes             []string `tfschema:"login_scopes"`
+	ClientId                     string   `tfschema:"client_id"`
+	ClientSecretSettingName      string   `tfschema:"client_secret_setting_name"`
+	ClientSecretKeyVaultSecretId string   `tfschema:"client_secret_key_vault_secret_id"`
+	LoginScopes                  []string `tfschema:"login_scopes"`
 }
 
 func GithubAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -1579,6 +1646,13 @@ func GithubAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description: "The app setting name that contains the `client_secret` value used for GitHub Login.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the `client_secret` value used for GitHub Login. A Key Vault Reference to this Secret is added to the App Setting named in `client_secret_setting_name`.",
+				},
+
 				"login_scopes": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
@@ -1610,6 +1684,12 @@ func GithubAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The app setting name that contains the `client_secret` value used for GitHub Login.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the `client_secret` value used for GitHub Login.",
+				},
+
 				"login_scopes": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -1664,10 +1744,11 @@ func flattenGitHubAuthV2Settings(input *webapps.GitHub) []GithubAuthV2Settings {
 }
 
 type GoogleAuthV2Settings struct {
-	ClientId                string   `tfschema:"client_id"`
-	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
-	AllowedAudiences        []string `tfschema:"allowed_audiences"`
-	LoginScopes             []string `tfschema:"login_scopes"`
+	ClientId                     string   `tfschema:"client_id"`
+	ClientSecretSettingName      string   `tfschema:"client_secret_setting_name"`
+	ClientSecretKeyVaultSecretId string   `tfschema:"client_secret_key_vault_secret_id"`
+	AllowedAudiences             []string `tfschema:"allowed_audiences"`
+	LoginScopes                  []string `tfschema:"login_scopes"`
 }
 
 func GoogleAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -1702,6 +1783,13 @@ func GoogleAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description:  "The app setting name that contains the `client_secret` value used for Google Login.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the `client_secret` value used for Google Login. A Key Vault Reference to this Secret is added to the App Setting named in `client_secret_setting_name`.",
+				},
+
 				"allowed_audiences": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
@@ -1744,6 +1832,12 @@ func GoogleAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The app setting name that contains the `client_secret` value used for Google Login.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the `client_secret` value used for Google Login.",
+				},
+
 				"allowed_audiences": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -1812,10 +1906,11 @@ func flattenGoogleAuthV2Settings(input *webapps.Google) []GoogleAuthV2Settings {
 }
 
 type MicrosoftAuthV2Settings struct {
-	ClientId                string   `tfschema:"client_id"`
-	ClientSecretSettingName string   `tfschema:"client_secret_setting_name"`
-	AllowedAudiences        []string `tfschema:"allowed_audiences"`
-	LoginScopes             []string `tfschema:"login_scopes"`
+	ClientId                     string   `tfschema:"client_id"`
+	ClientSecretSettingName      string   `tfschema:"client_secret_setting_name"`
+	ClientSecretKeyVaultSecretId string   `tfschema:"client_secret_key_vault_secret_id"`
+	AllowedAudiences             []string `tfschema:"allowed_audiences"`
+	LoginScopes                  []string `tfschema:"login_scopes"`
 }
 
 func MicrosoftAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -1850,6 +1945,13 @@ func MicrosoftAuthV2SettingsSchema() *pluginsdk.Schema {
 					Description:  "The app setting name containing the OAuth 2.0 client secret that was created for the app used for authentication.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the OAuth 2.0 client secret that was created for the app used for authentication. A Key Vault Reference to this Secret is added to the App Setting named in `client_secret_setting_name`.",
+				},
+
 				"allowed_audiences": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
@@ -1891,6 +1993,12 @@ func MicrosoftAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Description: "The app setting name containing the OAuth 2.0 client secret that was created for the app used for authentication.",
 				},
 
+				"client_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the OAuth 2.0 client secret that was created for the app used for authentication.",
+				},
+
 				"allowed_audiences": {
 					Type:     pluginsdk.TypeList,
 					Computed: true,
@@ -1958,8 +2066,9 @@ func flattenMicrosoftAuthV2Settings(input *webapps.LegacyMicrosoftAccount) []Mic
 }
 
 type TwitterAuthV2Settings struct {
-	ConsumerKey               string `tfschema:"consumer_key"`
-	ConsumerSecretSettingName string `tfschema:"consumer_secret_setting_name"`
+	ConsumerKey                    string `tfschema:"consumer_key"`
+	ConsumerSecretSettingName      string `tfschema:"consumer_secret_setting_name"`
+	ConsumerSecretKeyVaultSecretId string `tfschema:"consumer_secret_key_vault_secret_id"`
 }
 
 func TwitterAuthV2SettingsSchema() *pluginsdk.Schema {
@@ -1991,6 +2100,13 @@ func TwitterAuthV2SettingsSchema() *pluginsdk.Schema {
 					Required:    true,
 					Description: "The app setting name that contains the OAuth 1.0a consumer secret of the Twitter application used for sign-in.",
 				},
+
+				"consumer_secret_key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					Description:  "The ID of the Key Vault Secret containing the OAuth 1.0a consumer secret of the Twitter application used for sign-in. A Key Vault Reference to this Secret is added to the App Setting named in `consumer_secret_setting_name`.",
+				},
 			},
 		},
 	}
@@ -2013,6 +2129,12 @@ func TwitterAuthV2SettingsSchemaComputed() *pluginsdk.Schema {
 					Computed:    true,
 					Description: "The app setting name that contains the OAuth 1.0a consumer secret of the Twitter application used for sign-in.",
 				},
+
+				"consumer_secret_key_vault_secret_id": {
+					Type:        pluginsdk.TypeString,
+					Computed:    true,
+					Description: "The ID of the Key Vault Secret containing the OAuth 1.0a consumer secret of the Twitter application used for sign-in.",
+				},
 			},
 		},
 	}
@@ -2125,28 +2247,43 @@ func FlattenAuthV2Settings(input webapps.SiteAuthSettingsV2) []AuthV2Settings {
 
 	settings := *input.Properties
 
-	result := AuthV2Settings{}
+	// the API omits values which match the service defaults, so these are flattened to the schema defaults to avoid a diff
+	result := AuthV2Settings{
+		RuntimeVersion:         "~1",
+		UnauthenticatedAction:  string(webapps.UnauthenticatedClientActionV2RedirectToLoginPage),
+		RequireHTTPS:           true,
+		HttpRoutesAPIPrefix:    "/.auth",
+		ForwardProxyConvention: string(webapps.ForwardProxyConventionNoProxy),
+	}
 
 	if platform := settings.Platform; platform != nil {
 		result.AuthEnabled = pointer.From(platform.Enabled)
-		result.RuntimeVersion = pointer.From(platform.RuntimeVersion)
+		if platform.RuntimeVersion != nil && *platform.RuntimeVersion != "" {
+			result.RuntimeVersion = *platform.RuntimeVersion
+		}
 		result.ConfigFilePath = pointer.From(platform.ConfigFilePath)
 	}
 
 	if global := settings.GlobalValidation; global != nil {
 		result.RequireAuth = pointer.From(global.RequireAuthentication)
-		result.UnauthenticatedAction = string(pointer.From(global.UnauthenticatedClientAction))
+		if global.UnauthenticatedClientAction != nil {
+			result.UnauthenticatedAction = string(*global.UnauthenticatedClientAction)
+		}
 		result.DefaultAuthProvider = pointer.From(global.RedirectToProvider)
 		result.ExcludedPaths = pointer.From(global.ExcludedPaths)
 	}
 
 	if http := settings.HTTPSettings; http != nil {
-		result.RequireHTTPS = pointer.From(http.RequireHTTPS)
-		if http.Routes != nil {
-			result.HttpRoutesAPIPrefix = pointer.From(http.Routes.ApiPrefix)
+		if http.RequireHTTPS != nil {
+			result.RequireHTTPS = *http.RequireHTTPS
+		}
+		if http.Routes != nil && http.Routes.ApiPrefix != nil && *http.Routes.ApiPrefix != "" {
+			result.HttpRoutesAPIPrefix = *http.Routes.ApiPrefix
 		}
 		if fp := http.ForwardProxy; fp != nil {
-			result.ForwardProxyConvention = string(pointer.From(fp.Convention))
+			if fp.Convention != nil {
+				result.ForwardProxyConvention = string(*fp.Convention)
+			}
 			result.ForwardProxyCustomHostHeaderName = pointer.From(fp.CustomHostHeaderName)
 			result.ForwardProxyCustomSchemeHeaderName = pointer.From(fp.CustomProtoHeaderName)
 		}
@@ -2170,3 +2307,163 @@ func FlattenAuthV2Settings(input webapps.SiteAuthSettingsV2) []AuthV2Settings {
 
 	return []AuthV2Settings{result}
 }
+
+// authV2SecretReference links the App Setting containing the secret of an Identity Provider to the Key Vault Secret it's sourced from
+type authV2SecretReference struct {
+	settingName      string
+	keyVaultSecretId *string
+}
+
+func (s *AuthV2Settings) secretReferences() []authV2SecretReference {
+	result := make([]authV2SecretReference, 0)
+	for i := range s.AppleAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.AppleAuth[i].ClientSecretSettingName,
+			keyVaultSecretId: &s.AppleAuth[i].ClientSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.AzureActiveDirectoryAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.AzureActiveDirectoryAuth[i].ClientSecretSettingName,
+			keyVaultSecretId: &s.AzureActiveDirectoryAuth[i].ClientSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.CustomOIDCAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      customOIDCAuthV2SecretSettingName(s.CustomOIDCAuth[i].Name),
+			keyVaultSecretId: &s.CustomOIDCAuth[i].ClientSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.FacebookAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.FacebookAuth[i].AppSecretSettingName,
+			keyVaultSecretId: &s.FacebookAuth[i].AppSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.GithubAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.GithubAuth[i].ClientSecretSettingName,
+			keyVaultSecretId: &s.GithubAuth[i].ClientSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.GoogleAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.GoogleAuth[i].ClientSecretSettingName,
+			keyVaultSecretId: &s.GoogleAuth[i].ClientSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.MicrosoftAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.MicrosoftAuth[i].ClientSecretSettingName,
+			keyVaultSecretId: &s.MicrosoftAuth[i].ClientSecretKeyVaultSecretId,
+		})
+	}
+	for i := range s.TwitterAuth {
+		result = append(result, authV2SecretReference{
+			settingName:      s.TwitterAuth[i].ConsumerSecretSettingName,
+			keyVaultSecretId: &s.TwitterAuth[i].ConsumerSecretKeyVaultSecretId,
+		})
+	}
+
+	return result
+}
+
+func (s *AuthV2Settings) usesKeyVaultSecrets() bool {
+	for _, v := range s.secretReferences() {
+		if *v.keyVaultSecretId != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandAuthV2KeyVaultSecretAppSettings adds a Key Vault Reference to the App Settings for each Identity Provider secret
+// which is sourced from a Key Vault Secret
+func ExpandAuthV2KeyVaultSecretAppSettings(input []AuthV2Settings, appSettings map[string]string) map[string]string {
+	if len(input) != 1 || !input[0].usesKeyVaultSecrets() {
+		return appSettings
+	}
+
+	result := make(map[string]string)
+	for k, v := range appSettings {
+		result[k] = v
+	}
+	for _, v := range input[0].secretReferences() {
+		if *v.keyVaultSecretId != "" && v.settingName != "" {
+			result[v.settingName] = fmt.Sprintf(StorageStringFmtKV, *v.keyVaultSecretId)
+		}
+	}
+
+	return result
+}
+
+// FlattenAuthV2KeyVaultSecretAppSettings sets the Key Vault Secret ID for each Identity Provider secret whose App Setting
+// contains a Key Vault Reference and removes that App Setting - unless it's managed in the `app_settings` block, which is
+// determined by the `configuredAppSettings` (e.g. the current value of `app_settings` in the state)
+func FlattenAuthV2KeyVaultSecretAppSettings(input []AuthV2Settings, appSettings map[string]string, configuredAppSettings map[string]interface{}) map[string]string {
+	if len(input) != 1 {
+		return appSettings
+	}
+
+	for _, v := range input[0].secretReferences() {
+		if _, ok := configuredAppSettings[v.settingName]; ok || v.settingName == "" {
+			continue
+		}
+		if secretId, ok := parseAuthV2KeyVaultReference(appSettings[v.settingName]); ok {
+			*v.keyVaultSecretId = secretId
+			delete(appSettings, v.settingName)
+		}
+	}
+
+	return appSettings
+}
+
+// FlattenAuthV2KeyVaultSecretReferences sets the Key Vault Secret ID for each Identity Provider secret whose App Setting
+// contains a Key Vault Reference, leaving the App Settings as-is
+func FlattenAuthV2KeyVaultSecretReferences(input []AuthV2Settings, appSettings map[string]string) {
+	if len(input) != 1 {
+		return
+	}
+
+	for _, v := range input[0].secretReferences() {
+		if secretId, ok := parseAuthV2KeyVaultReference(appSettings[v.settingName]); ok {
+			*v.keyVaultSecretId = secretId
+		}
+	}
+}
+
+func parseAuthV2KeyVaultReference(input string) (string, bool) {
+	prefix := strings.TrimSuffix(StorageStringFmtKV, "%s)")
+	if !strings.HasPrefix(input, prefix) || !strings.HasSuffix(input, ")") {
+		return "", false
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(input, prefix), ")"), true
+}
+
+// ValidateAuthV2KeyVaultSecretIdentity ensures that the App has an Identity which can resolve the Key Vault References
+// used for the Identity Provider secrets - which is the `key_vault_reference_identity_id` when set, or the System Assigned Identity
+func ValidateAuthV2KeyVaultSecretIdentity(input []AuthV2Settings, identities []identity.ModelSystemAssignedUserAssigned, keyVaultReferenceIdentityId string) error {
+	if len(input) != 1 || !input[0].usesKeyVaultSecrets() {
+		return nil
+	}
+
+	if len(identities) == 0 || identities[0].Type == identity.TypeNone {
+		return fmt.Errorf("an `identity` must be specified to resolve the Key Vault Secrets used in `auth_settings_v2`")
+	}
+
+	if keyVaultReferenceIdentityId == "" || strings.EqualFold(keyVaultReferenceIdentityId, string(identity.TypeSystemAssigned)) {
+		if identities[0].Type != identity.TypeSystemAssigned && identities[0].Type != identity.TypeSystemAssignedUserAssigned {
+			return fmt.Errorf("`key_vault_reference_identity_id` must be set to one of the `identity_ids` to resolve the Key Vault Secrets used in `auth_settings_v2` when the `identity` is not System Assigned")
+		}
+		return nil
+	}
+
+	for _, v := range identities[0].IdentityIds {
+		if strings.EqualFold(v, keyVaultReferenceIdentityId) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`key_vault_reference_identity_id` must be one of the `identity_ids` to resolve the Key Vault Secrets used in `auth_settings_v2`")
+}
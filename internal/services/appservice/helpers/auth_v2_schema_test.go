@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+const testAuthV2SecretId = "https://example.vault.azure.net/secrets/client-secret"
+
+func TestExpandAuthV2KeyVaultSecretAppSettings(t *testing.T) {
+	input := []helpers.AuthV2Settings{
+		{
+			AzureActiveDirectoryAuth: []helpers.AadAuthV2Settings{
+				{
+					ClientSecretSettingName:      "MICROSOFT_PROVIDER_AUTHENTICATION_SECRET",
+					ClientSecretKeyVaultSecretId: testAuthV2SecretId,
+				},
+			},
+			CustomOIDCAuth: []helpers.CustomOIDCAuthV2Settings{
+				{
+					Name:                         "example",
+					ClientSecretKeyVaultSecretId: testAuthV2SecretId,
+				},
+			},
+			GithubAuth: []helpers.GithubAuthV2Settings{
+				{
+					ClientSecretSettingName: "GITHUB_PROVIDER_AUTHENTICATION_SECRET",
+				},
+			},
+		},
+	}
+	appSettings := map[string]string{
+		"foo": "bar",
+	}
+
+	expected := map[string]string{
+		"foo": "bar",
+		"MICROSOFT_PROVIDER_AUTHENTICATION_SECRET": "@Microsoft.KeyVault(SecretUri=" + testAuthV2SecretId + ")",
+		"EXAMPLE_PROVIDER_AUTHENTICATION_SECRET":   "@Microsoft.KeyVault(SecretUri=" + testAuthV2SecretId + ")",
+	}
+
+	actual := helpers.ExpandAuthV2KeyVaultSecretAppSettings(input, appSettings)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+	if len(appSettings) != 1 {
+		t.Fatalf("expected the input App Settings to be unmodified but got %+v", appSettings)
+	}
+}
+
+func TestFlattenAuthV2KeyVaultSecretAppSettings(t *testing.T) {
+	reference := "@Microsoft.KeyVault(SecretUri=" + testAuthV2SecretId + ")"
+	cases := []struct {
+		name                string
+		configured          map[string]interface{}
+		expectedSecretId    string
+		expectedAppSettings map[string]string
+	}{
+		{
+			name:             "managed through the Identity Provider",
+			configured:       map[string]interface{}{},
+			expectedSecretId: testAuthV2SecretId,
+			expectedAppSettings: map[string]string{
+				"foo": "bar",
+			},
+		},
+		{
+			name: "managed through app_settings",
+			configured: map[string]interface{}{
+				"GOOGLE_PROVIDER_AUTHENTICATION_SECRET": reference,
+			},
+			expectedSecretId: "",
+			expectedAppSettings: map[string]string{
+				"foo":                                   "bar",
+				"GOOGLE_PROVIDER_AUTHENTICATION_SECRET": reference,
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %s", v.name)
+
+		authV2 := []helpers.AuthV2Settings{
+			{
+				GoogleAuth: []helpers.GoogleAuthV2Settings{
+					{
+						ClientSecretSettingName: "GOOGLE_PROVIDER_AUTHENTICATION_SECRET",
+					},
+				},
+			},
+		}
+		appSettings := map[string]string{
+			"foo":                                   "bar",
+			"GOOGLE_PROVIDER_AUTHENTICATION_SECRET": reference,
+		}
+
+		actual := helpers.FlattenAuthV2KeyVaultSecretAppSettings(authV2, appSettings, v.configured)
+		if !reflect.DeepEqual(actual, v.expectedAppSettings) {
+			t.Fatalf("expected App Settings %+v but got %+v", v.expectedAppSettings, actual)
+		}
+		if secretId := authV2[0].GoogleAuth[0].ClientSecretKeyVaultSecretId; secretId != v.expectedSecretId {
+			t.Fatalf("expected Key Vault Secret ID %q but got %q", v.expectedSecretId, secretId)
+		}
+	}
+}
+
+func TestValidateAuthV2KeyVaultSecretIdentity(t *testing.T) {
+	userAssignedIdentityId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+	authV2 := []helpers.AuthV2Settings{
+		{
+			TwitterAuth: []helpers.TwitterAuthV2Settings{
+				{
+					ConsumerSecretSettingName:      "TWITTER_PROVIDER_AUTHENTICATION_SECRET",
+					ConsumerSecretKeyVaultSecretId: testAuthV2SecretId,
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name                        string
+		authV2                      []helpers.AuthV2Settings
+		identities                  []identity.ModelSystemAssignedUserAssigned
+		keyVaultReferenceIdentityId string
+		valid                       bool
+	}{
+		{
+			name:   "no Key Vault Secrets",
+			authV2: []helpers.AuthV2Settings{{}},
+			valid:  true,
+		},
+		{
+			name:   "no identity",
+			authV2: authV2,
+			valid:  false,
+		},
+		{
+			name:   "system assigned identity",
+			authV2: authV2,
+			identities: []identity.ModelSystemAssignedUserAssigned{
+				{
+					Type: identity.TypeSystemAssigned,
+				},
+			},
+			valid: true,
+		},
+		{
+			name:   "user assigned identity without key_vault_reference_identity_id",
+			authV2: authV2,
+			identities: []identity.ModelSystemAssignedUserAssigned{
+				{
+					Type:        identity.TypeUserAssigned,
+					IdentityIds: []string{userAssignedIdentityId},
+				},
+			},
+			valid: false,
+		},
+		{
+			name:   "user assigned identity with key_vault_reference_identity_id",
+			authV2: authV2,
+			identities: []identity.ModelSystemAssignedUserAssigned{
+				{
+					Type:        identity.TypeUserAssigned,
+					IdentityIds: []string{userAssignedIdentityId},
+				},
+			},
+			keyVaultReferenceIdentityId: userAssignedIdentityId,
+			valid:                       true,
+		},
+		{
+			name:   "key_vault_reference_identity_id not assigned",
+			authV2: authV2,
+			identities: []identity.ModelSystemAssignedUserAssigned{
+				{
+					Type: identity.TypeSystemAssigned,
+				},
+			},
+			keyVaultReferenceIdentityId: userAssignedIdentityId,
+			valid:                       false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %s", v.name)
+
+		err := helpers.ValidateAuthV2KeyVaultSecretIdentity(v.authV2, v.identities, v.keyVaultReferenceIdentityId)
+		if valid := err == nil; valid != v.valid {
+			t.Fatalf("expected valid to be %t but got %t: %+v", v.valid, valid, err)
+		}
+	}
+}
+
+func TestFlattenAuthV2SettingsDefaults(t *testing.T) {
+	actual := helpers.FlattenAuthV2Settings(webapps.SiteAuthSettingsV2{
+		Properties: &webapps.SiteAuthSettingsV2Properties{
+			Platform: &webapps.AuthPlatform{},
+		},
+	})
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 item but got %d", len(actual))
+	}
+
+	if actual[0].RuntimeVersion != "~1" {
+		t.Fatalf("expected `runtime_version` to be `~1` but got %q", actual[0].RuntimeVersion)
+	}
+	if actual[0].UnauthenticatedAction != string(webapps.UnauthenticatedClientActionV2RedirectToLoginPage) {
+		t.Fatalf("expected `unauthenticated_action` to be `RedirectToLoginPage` but got %q", actual[0].UnauthenticatedAction)
+	}
+	if !actual[0].RequireHTTPS {
+		t.Fatalf("expected `require_https` to be `true`")
+	}
+	if actual[0].HttpRoutesAPIPrefix != "/.auth" {
+		t.Fatalf("expected `http_route_api_prefix` to be `/.auth` but got %q", actual[0].HttpRoutesAPIPrefix)
+	}
+	if actual[0].ForwardProxyConvention != string(webapps.ForwardProxyConventionNoProxy) {
+		t.Fatalf("expected `forward_proxy_convention` to be `NoProxy` but got %q", actual[0].ForwardProxyConvention)
+	}
+}
@@ -421,6 +421,8 @@ func (d LinuxFunctionAppDataSource) Read() sdk.ResourceFunc {
 
 				state.unpackLinuxFunctionAppSettings(appSettingsResp.Model, metadata)
 
+				helpers.FlattenAuthV2KeyVaultSecretReferences(state.AuthV2Settings, state.AppSettings)
+
 				state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
 
 				metadata.SetID(id)
@@ -375,6 +375,10 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(functionApp.AuthV2Settings, functionApp.Identity, functionApp.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			resourcesClient := metadata.Client.AppService.ResourceProvidersClient
 			aseClient := metadata.Client.AppService.AppServiceEnvironmentClient
@@ -502,7 +506,7 @@ func (r LinuxFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			siteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(functionApp.SiteConfig[0].ApplicationStack)
-			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionApp.AppSettings)
+			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(functionApp.AuthV2Settings, functionApp.AppSettings))
 
 			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(functionApp.Identity)
 			if err != nil {
@@ -802,6 +806,8 @@ func (r LinuxFunctionAppResource) Read() sdk.ResourceFunc {
 
 					state.unpackLinuxFunctionAppSettings(*appSettingsResp.Model, metadata)
 
+					state.AppSettings = helpers.FlattenAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings, metadata.ResourceData.Get("app_settings").(map[string]interface{}))
+
 					state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
 
 					state.HttpsOnly = pointer.From(props.HTTPSOnly)
@@ -871,6 +877,10 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(state.AuthV2Settings, state.Identity, state.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			existing, err := client.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("reading Linux %s: %v", id, err)
@@ -1031,7 +1041,7 @@ func (r LinuxFunctionAppResource) Update() sdk.ResourceFunc {
 				model.Properties.SiteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(state.SiteConfig[0].ApplicationStack)
 			}
 
-			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
+			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings))
 
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				pna := helpers.PublicNetworkAccessEnabled
@@ -351,6 +351,10 @@ func (r LinuxFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(functionAppSlot.AuthV2Settings, functionAppSlot.Identity, functionAppSlot.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			resourceProvidersClient := metadata.Client.AppService.ResourceProvidersClient
 			functionAppId, err := commonids.ParseFunctionAppID(functionAppSlot.FunctionAppID)
@@ -496,7 +500,7 @@ func (r LinuxFunctionAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			siteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(functionAppSlot.SiteConfig[0].ApplicationStack)
-			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionAppSlot.AppSettings)
+			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(functionAppSlot.AuthV2Settings, functionAppSlot.AppSettings))
 
 			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(functionAppSlot.Identity)
 			if err != nil {
@@ -774,6 +778,8 @@ func (r LinuxFunctionAppSlotResource) Read() sdk.ResourceFunc {
 
 					state.unpackLinuxFunctionAppSettings(*appSettingsResp.Model, metadata)
 
+					state.AppSettings = helpers.FlattenAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings, metadata.ResourceData.Get("app_settings").(map[string]interface{}))
+
 					state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
 
 					state.HttpsOnly = pointer.From(props.HTTPSOnly)
@@ -840,6 +846,10 @@ func (r LinuxFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(state.AuthV2Settings, state.Identity, state.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			existing, err := client.GetSlot(ctx, *id)
 			if err != nil || existing.Model == nil {
 				return fmt.Errorf("reading Linux %s: %v", id, err)
@@ -976,7 +986,7 @@ func (r LinuxFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				model.Properties.SiteConfig.LinuxFxVersion = helpers.EncodeFunctionAppLinuxFxVersion(state.SiteConfig[0].ApplicationStack)
 			}
 
-			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
+			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings))
 
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				pan := helpers.PublicNetworkAccessEnabled
@@ -405,6 +405,9 @@ func (r LinuxWebAppDataSource) Read() sdk.ResourceFunc {
 
 				// Filter out all settings we've consumed above
 				webApp.AppSettings = helpers.FilterManagedAppSettings(webApp.AppSettings)
+
+				helpers.FlattenAuthV2KeyVaultSecretReferences(webApp.AuthV2Settings, webApp.AppSettings)
+
 				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
 				if err != nil {
 					return fmt.Errorf("flattening `identity`: %+v", err)
@@ -282,6 +282,10 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(webAppSlot.AuthV2Settings, webAppSlot.Identity, webAppSlot.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			appId, err := commonids.ParseWebAppID(webAppSlot.AppServiceId)
 			if err != nil {
@@ -330,7 +334,7 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			sc := webAppSlot.SiteConfig[0]
-			siteConfig, err := sc.ExpandForCreate(webAppSlot.AppSettings)
+			siteConfig, err := sc.ExpandForCreate(helpers.ExpandAuthV2KeyVaultSecretAppSettings(webAppSlot.AuthV2Settings, webAppSlot.AppSettings))
 			if err != nil {
 				return err
 			}
@@ -655,6 +659,8 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 					state.AppSettings = helpers.FilterManagedAppSettings(state.AppSettings)
 				}
 
+				state.AppSettings = helpers.FlattenAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings, metadata.ResourceData.Get("app_settings").(map[string]interface{}))
+
 				// Zip Deploys are not retrievable, so attempt to get from config. This doesn't matter for imports as an unexpected value here could break the deployment.
 				if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 					state.ZipDeployFile = deployFile
@@ -717,6 +723,10 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(state.AuthV2Settings, state.Identity, state.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			existing, err := client.GetSlot(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("reading Linux %s: %v", id, err)
@@ -800,9 +810,9 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				model.Tags = pointer.To(state.Tags)
 			}
 
-			if metadata.ResourceData.HasChanges("site_config", "app_settings") {
+			if metadata.ResourceData.HasChanges("site_config", "app_settings", "auth_settings_v2") {
 				sc := state.SiteConfig[0]
-				siteConfig, err := sc.ExpandForUpdate(metadata, model.Properties.SiteConfig, state.AppSettings)
+				siteConfig, err := sc.ExpandForUpdate(metadata, model.Properties.SiteConfig, helpers.ExpandAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings))
 				if err != nil {
 					return fmt.Errorf("expanding Site Config for Linux %s: %+v", id, err)
 				}
@@ -841,7 +851,7 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 			updateLogs := false
 
 			// sending App Settings updates can clobber logs configuration so must be updated before we send any Log updates
-			if metadata.ResourceData.HasChanges("app_settings", "site_config") {
+			if metadata.ResourceData.HasChanges("app_settings", "site_config", "auth_settings_v2") {
 				appSettingsUpdate := helpers.ExpandAppSettingsForUpdate(model.Properties.SiteConfig.AppSettings)
 				appSettingsProps := *appSettingsUpdate.Properties
 				if state.SiteConfig[0].HealthCheckEvictionTime != 0 {
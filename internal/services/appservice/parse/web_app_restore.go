@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WebAppRestoreId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	RestoreName    string
+}
+
+func NewWebAppRestoreID(subscriptionId, resourceGroup, siteName, restoreName string) WebAppRestoreId {
+	return WebAppRestoreId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		RestoreName:    restoreName,
+	}
+}
+
+func (id WebAppRestoreId) String() string {
+	segments := []string{
+		fmt.Sprintf("Restore Name %q", id.RestoreName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Web App Restore", segmentsStr)
+}
+
+func (id WebAppRestoreId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Web/sites/%s/restores/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.RestoreName)
+}
+
+// WebAppRestoreID parses a WebAppRestore ID into an WebAppRestoreId struct
+func WebAppRestoreID(input string) (*WebAppRestoreId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an WebAppRestore ID: %+v", input, err)
+	}
+
+	resourceId := WebAppRestoreId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.RestoreName, err = id.PopSegment("restores"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
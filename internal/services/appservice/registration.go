@@ -54,7 +54,9 @@ func (r Registration) Resources() []sdk.Resource {
 		StaticWebAppCustomDomainResource{},
 		StaticWebAppFunctionAppRegistrationResource{},
 		WebAppActiveSlotResource{},
+		WebAppBackupConfigurationResource{},
 		WebAppHybridConnectionResource{},
+		WebAppRestoreResource{},
 		WindowsFunctionAppResource{},
 		WindowsFunctionAppSlotResource{},
 		WindowsWebAppResource{},
@@ -25,19 +25,20 @@ type ServicePlanDataSource struct{}
 var _ sdk.DataSource = ServicePlanDataSource{}
 
 type ServicePlanDataSourceModel struct {
-	Name                      string            `tfschema:"name"`
-	ResourceGroup             string            `tfschema:"resource_group_name"`
-	Location                  string            `tfschema:"location"`
-	Kind                      string            `tfschema:"kind"`
-	OSType                    OSType            `tfschema:"os_type"`
-	Sku                       string            `tfschema:"sku_name"`
-	AppServiceEnvironmentId   string            `tfschema:"app_service_environment_id"`
-	PerSiteScaling            bool              `tfschema:"per_site_scaling_enabled"`
-	Reserved                  bool              `tfschema:"reserved"`
-	WorkerCount               int64             `tfschema:"worker_count"`
-	MaximumElasticWorkerCount int64             `tfschema:"maximum_elastic_worker_count"`
-	ZoneBalancing             bool              `tfschema:"zone_balancing_enabled"`
-	Tags                      map[string]string `tfschema:"tags"`
+	Name                        string            `tfschema:"name"`
+	ResourceGroup               string            `tfschema:"resource_group_name"`
+	Location                    string            `tfschema:"location"`
+	Kind                        string            `tfschema:"kind"`
+	OSType                      OSType            `tfschema:"os_type"`
+	Sku                         string            `tfschema:"sku_name"`
+	AppServiceEnvironmentId     string            `tfschema:"app_service_environment_id"`
+	PerSiteScaling              bool              `tfschema:"per_site_scaling_enabled"`
+	Reserved                    bool              `tfschema:"reserved"`
+	WorkerCount                 int64             `tfschema:"worker_count"`
+	MaximumElasticWorkerCount   int64             `tfschema:"maximum_elastic_worker_count"`
+	PremiumPlanAutoScaleEnabled bool              `tfschema:"premium_plan_auto_scale_enabled"`
+	ZoneBalancing               bool              `tfschema:"zone_balancing_enabled"`
+	Tags                        map[string]string `tfschema:"tags"`
 }
 
 func (r ServicePlanDataSource) ModelObject() interface{} {
@@ -94,6 +95,11 @@ func (r ServicePlanDataSource) Attributes() map[string]*pluginsdk.Schema {
 			Computed: true,
 		},
 
+		"premium_plan_auto_scale_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+
 		"kind": {
 			Type:     pluginsdk.TypeString,
 			Computed: true,
@@ -168,6 +174,10 @@ func (r ServicePlanDataSource) Read() sdk.ResourceFunc {
 					servicePlan.ZoneBalancing = utils.NormaliseNilableBool(props.ZoneRedundant)
 
 					servicePlan.MaximumElasticWorkerCount = pointer.From(props.MaximumElasticWorkerCount)
+
+					if isServicePlanPremiumV2OrV3(servicePlan.Sku) {
+						servicePlan.PremiumPlanAutoScaleEnabled = pointer.From(props.ElasticScaleEnabled)
+					}
 				}
 				servicePlan.Tags = pointer.From(model.Tags)
 			}
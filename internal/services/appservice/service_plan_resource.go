@@ -41,19 +41,20 @@ const (
 )
 
 type ServicePlanModel struct {
-	Name                      string            `tfschema:"name"`
-	ResourceGroup             string            `tfschema:"resource_group_name"`
-	Location                  string            `tfschema:"location"`
-	Kind                      string            `tfschema:"kind"`
-	OSType                    OSType            `tfschema:"os_type"`
-	Sku                       string            `tfschema:"sku_name"`
-	AppServiceEnvironmentId   string            `tfschema:"app_service_environment_id"`
-	PerSiteScaling            bool              `tfschema:"per_site_scaling_enabled"`
-	Reserved                  bool              `tfschema:"reserved"`
-	WorkerCount               int64             `tfschema:"worker_count"`
-	MaximumElasticWorkerCount int64             `tfschema:"maximum_elastic_worker_count"`
-	ZoneBalancing             bool              `tfschema:"zone_balancing_enabled"`
-	Tags                      map[string]string `tfschema:"tags"`
+	Name                        string            `tfschema:"name"`
+	ResourceGroup               string            `tfschema:"resource_group_name"`
+	Location                    string            `tfschema:"location"`
+	Kind                        string            `tfschema:"kind"`
+	OSType                      OSType            `tfschema:"os_type"`
+	Sku                         string            `tfschema:"sku_name"`
+	AppServiceEnvironmentId     string            `tfschema:"app_service_environment_id"`
+	PerSiteScaling              bool              `tfschema:"per_site_scaling_enabled"`
+	Reserved                    bool              `tfschema:"reserved"`
+	WorkerCount                 int64             `tfschema:"worker_count"`
+	MaximumElasticWorkerCount   int64             `tfschema:"maximum_elastic_worker_count"`
+	PremiumPlanAutoScaleEnabled bool              `tfschema:"premium_plan_auto_scale_enabled"`
+	ZoneBalancing               bool              `tfschema:"zone_balancing_enabled"`
+	Tags                        map[string]string `tfschema:"tags"`
 }
 
 func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
@@ -114,6 +115,12 @@ func (r ServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.IntAtLeast(0),
 		},
 
+		"premium_plan_auto_scale_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"zone_balancing_enabled": {
 			Type:     pluginsdk.TypeBool,
 			ForceNew: true,
@@ -191,9 +198,16 @@ func (r ServicePlanResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if servicePlan.PremiumPlanAutoScaleEnabled {
+				if !isServicePlanPremiumV2OrV3(servicePlan.Sku) {
+					return fmt.Errorf("`premium_plan_auto_scale_enabled` can only be specified with Premium V2 or Premium V3 Skus")
+				}
+				appServicePlan.Properties.ElasticScaleEnabled = pointer.To(true)
+			}
+
 			if servicePlan.MaximumElasticWorkerCount > 0 {
-				if !isServicePlanSupportScaleOut(servicePlan.Sku) {
-					return fmt.Errorf("`maximum_elastic_worker_count` can only be specified with Elastic Premium Skus")
+				if !isServicePlanSupportScaleOut(servicePlan.Sku) && !servicePlan.PremiumPlanAutoScaleEnabled {
+					return fmt.Errorf("`maximum_elastic_worker_count` can only be specified with Elastic Premium Skus or when `premium_plan_auto_scale_enabled` is `true`")
 				}
 				appServicePlan.Properties.MaximumElasticWorkerCount = pointer.To(servicePlan.MaximumElasticWorkerCount)
 			}
@@ -271,6 +285,11 @@ func (r ServicePlanResource) Read() sdk.ResourceFunc {
 					state.ZoneBalancing = utils.NormaliseNilableBool(props.ZoneRedundant)
 
 					state.MaximumElasticWorkerCount = pointer.From(props.MaximumElasticWorkerCount)
+
+					// Elastic Premium plans report `elasticScaleEnabled` as `true`, however it's only configurable for Premium V2/V3 plans
+					if isServicePlanPremiumV2OrV3(state.Sku) {
+						state.PremiumPlanAutoScaleEnabled = pointer.From(props.ElasticScaleEnabled)
+					}
 				}
 				state.Tags = pointer.From(model.Tags)
 			}
@@ -344,9 +363,16 @@ func (r ServicePlanResource) Update() sdk.ResourceFunc {
 				model.Sku.Capacity = pointer.To(state.WorkerCount)
 			}
 
+			if metadata.ResourceData.HasChange("premium_plan_auto_scale_enabled") {
+				if state.PremiumPlanAutoScaleEnabled && !isServicePlanPremiumV2OrV3(state.Sku) {
+					return fmt.Errorf("`premium_plan_auto_scale_enabled` can only be specified with Premium V2 or Premium V3 Skus")
+				}
+				model.Properties.ElasticScaleEnabled = pointer.To(state.PremiumPlanAutoScaleEnabled)
+			}
+
 			if metadata.ResourceData.HasChange("maximum_elastic_worker_count") {
-				if metadata.ResourceData.HasChange("maximum_elastic_worker_count") && !isServicePlanSupportScaleOut(state.Sku) {
-					return fmt.Errorf("`maximum_elastic_worker_count` can only be specified with Elastic Premium Skus")
+				if !isServicePlanSupportScaleOut(state.Sku) && !state.PremiumPlanAutoScaleEnabled {
+					return fmt.Errorf("`maximum_elastic_worker_count` can only be specified with Elastic Premium Skus or when `premium_plan_auto_scale_enabled` is `true`")
 				}
 				model.Properties.MaximumElasticWorkerCount = pointer.To(state.MaximumElasticWorkerCount)
 			}
@@ -368,6 +394,11 @@ func isServicePlanSupportScaleOut(plan string) bool {
 	return support
 }
 
+// isServicePlanPremiumV2OrV3 returns whether the sku is a (non-container) Premium V2 or Premium V3 sku, which support automatic scaling
+func isServicePlanPremiumV2OrV3(plan string) bool {
+	return strings.HasPrefix(plan, "P") && !strings.HasPrefix(plan, "PC") && (strings.HasSuffix(plan, "v2") || strings.HasSuffix(plan, "v3"))
+}
+
 func (r ServicePlanResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
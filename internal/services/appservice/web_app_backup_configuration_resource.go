@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WebAppBackupConfigurationResource struct{}
+
+type WebAppBackupConfigurationModel struct {
+	WebAppId                          string                   `tfschema:"web_app_id"`
+	Name                              string                   `tfschema:"name"`
+	StorageAccountUrl                 string                   `tfschema:"storage_account_url"`
+	StorageAccountUrlRotationTriggers map[string]string        `tfschema:"storage_account_url_rotation_triggers"`
+	Enabled                           bool                     `tfschema:"enabled"`
+	Schedule                          []helpers.BackupSchedule `tfschema:"schedule"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppBackupConfigurationResource{}
+
+func (r WebAppBackupConfigurationResource) ModelObject() interface{} {
+	return &WebAppBackupConfigurationModel{}
+}
+
+func (r WebAppBackupConfigurationResource) ResourceType() string {
+	return "azurerm_web_app_backup_configuration"
+}
+
+func (r WebAppBackupConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateWebAppID
+}
+
+func (r WebAppBackupConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateWebAppID,
+			Description:  "The ID of the Web App for this Backup Configuration.",
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name which should be used for this Backup.",
+		},
+
+		"storage_account_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+			// the SAS URL is commonly generated during each plan (e.g. from the `azurerm_storage_account_blob_container_sas`
+			// Data Source) - when rotation triggers are specified a new SAS URL is only sent once one of the triggers changes
+			DiffSuppressFunc: func(_, old, new string, d *pluginsdk.ResourceData) bool {
+				if old == "" || new == "" {
+					return false
+				}
+				triggers := d.Get("storage_account_url_rotation_triggers").(map[string]interface{})
+				return len(triggers) > 0 && !d.HasChange("storage_account_url_rotation_triggers")
+			},
+			Description: "The SAS URL to the container.",
+		},
+
+		"storage_account_url_rotation_triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A mapping of arbitrary keys and values which, when changed, send the current `storage_account_url` to the Web App.",
+		},
+
+		"enabled": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should this backup job be enabled?",
+		},
+
+		"schedule": helpers.BackupSchema().Elem.(*pluginsdk.Resource).Schema["schedule"],
+	}
+}
+
+func (r WebAppBackupConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppBackupConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var config WebAppBackupConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			id, err := commonids.ParseWebAppID(config.WebAppId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetBackupConfiguration(ctx, *id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of an existing Backup Configuration for %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			backupConfig, err := expandWebAppBackupConfiguration(config)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.UpdateBackupConfiguration(ctx, *id, *backupConfig); err != nil {
+				return fmt.Errorf("creating Backup Configuration for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppBackupConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := commonids.ParseWebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetBackupConfiguration(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("reading Backup Configuration for %s: %+v", id, err)
+			}
+
+			state := WebAppBackupConfigurationModel{
+				WebAppId: id.ID(),
+				// the rotation triggers aren't returned by the API
+				StorageAccountUrlRotationTriggers: expandStringMap(metadata.ResourceData.Get("storage_account_url_rotation_triggers").(map[string]interface{})),
+			}
+
+			if backup := helpers.FlattenBackupConfig(existing.Model); len(backup) > 0 {
+				state.Name = backup[0].Name
+				state.StorageAccountUrl = backup[0].StorageAccountUrl
+				state.Enabled = backup[0].Enabled
+				state.Schedule = backup[0].Schedule
+			}
+
+			// the SAS token of the Storage Account URL may be redacted in the response
+			if state.StorageAccountUrl == "" {
+				state.StorageAccountUrl = metadata.ResourceData.Get("storage_account_url").(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppBackupConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := commonids.ParseWebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config WebAppBackupConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			backupConfig, err := expandWebAppBackupConfiguration(config)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.UpdateBackupConfiguration(ctx, *id, *backupConfig); err != nil {
+				return fmt.Errorf("updating Backup Configuration for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppBackupConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := commonids.ParseWebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteBackupConfiguration(ctx, *id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting Backup Configuration for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWebAppBackupConfiguration(input WebAppBackupConfigurationModel) (*webapps.BackupRequest, error) {
+	backup, err := helpers.ExpandBackupConfig([]helpers.Backup{
+		{
+			Name:              input.Name,
+			StorageAccountUrl: input.StorageAccountUrl,
+			Enabled:           input.Enabled,
+			Schedule:          input.Schedule,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("expanding `schedule`: %+v", err)
+	}
+
+	return backup, nil
+}
+
+func expandStringMap(input map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppBackupConfigurationResource struct{}
+
+func TestAccWebAppBackupConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_backup_configuration", "test")
+	r := WebAppBackupConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account_url", "storage_account_url_rotation_triggers"),
+	})
+}
+
+func TestAccWebAppBackupConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_backup_configuration", "test")
+	r := WebAppBackupConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account_url", "storage_account_url_rotation_triggers"),
+		{
+			Config: r.rotated(data, "2025-01-01", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.frequency_interval").HasValue("1"),
+			),
+		},
+		data.ImportStep("storage_account_url", "storage_account_url_rotation_triggers"),
+		{
+			// a new SAS URL without a change to the rotation triggers shouldn't be sent to the Web App
+			Config:   r.rotated(data, "2025-01-02", "first"),
+			PlanOnly: true,
+		},
+		{
+			Config: r.rotated(data, "2025-01-02", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account_url", "storage_account_url_rotation_triggers"),
+	})
+}
+
+func TestAccWebAppBackupConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_backup_configuration", "test")
+	r := WebAppBackupConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WebAppBackupConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseWebAppID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.GetBackupConfiguration(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Backup Configuration for %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WebAppBackupConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_backup_configuration" "test" {
+  web_app_id          = azurerm_linux_web_app.test.id
+  name                = "acctest"
+  storage_account_url = "https://${azurerm_storage_account.test.name}.blob.core.windows.net/${azurerm_storage_container.test.name}${data.azurerm_storage_account_sas.test.sas}&sr=b"
+
+  schedule {
+    frequency_interval = 7
+    frequency_unit     = "Day"
+  }
+}
+`, r.template(data, "2024-12-01"))
+}
+
+func (r WebAppBackupConfigurationResource) rotated(data acceptance.TestData, sasStart, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_backup_configuration" "test" {
+  web_app_id          = azurerm_linux_web_app.test.id
+  name                = "acctest"
+  storage_account_url = "https://${azurerm_storage_account.test.name}.blob.core.windows.net/${azurerm_storage_container.test.name}${data.azurerm_storage_account_sas.test.sas}&sr=b"
+  enabled             = false
+
+  storage_account_url_rotation_triggers = {
+    rotation = "%s"
+  }
+
+  schedule {
+    frequency_interval       = 1
+    frequency_unit           = "Hour"
+    keep_at_least_one_backup = true
+    retention_period_days    = 7
+  }
+}
+`, r.template(data, sasStart), trigger)
+}
+
+func (r WebAppBackupConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_backup_configuration" "import" {
+  web_app_id          = azurerm_web_app_backup_configuration.test.web_app_id
+  name                = azurerm_web_app_backup_configuration.test.name
+  storage_account_url = azurerm_web_app_backup_configuration.test.storage_account_url
+
+  schedule {
+    frequency_interval = 7
+    frequency_unit     = "Day"
+  }
+}
+`, r.basic(data))
+}
+
+func (WebAppBackupConfigurationResource) template(data acceptance.TestData, sasStart string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  lifecycle {
+    ignore_changes = [backup]
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  https_only        = true
+
+  resource_types {
+    service   = false
+    container = false
+    object    = true
+  }
+
+  services {
+    blob  = true
+    queue = false
+    table = false
+    file  = false
+  }
+
+  start  = "%[4]s"
+  expiry = "2027-12-31"
+
+  permissions {
+    read    = false
+    write   = true
+    delete  = false
+    list    = false
+    add     = false
+    create  = false
+    update  = false
+    process = false
+    tag     = false
+    filter  = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, sasStart)
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// WebAppRestoreResource restores the content of a Web App from a backup blob when created, or when one of the triggers
+// changes - the restored content can't be "un-restored", so removing this resource only removes it from the state.
+type WebAppRestoreResource struct{}
+
+type WebAppRestoreModel struct {
+	WebAppId                   string            `tfschema:"web_app_id"`
+	StorageAccountUrl          string            `tfschema:"storage_account_url"`
+	BlobName                   string            `tfschema:"blob_name"`
+	Overwrite                  bool              `tfschema:"overwrite"`
+	IgnoreConflictingHostNames bool              `tfschema:"ignore_conflicting_host_names"`
+	IgnoreDatabases            bool              `tfschema:"ignore_databases"`
+	Triggers                   map[string]string `tfschema:"triggers"`
+}
+
+var _ sdk.ResourceWithCustomImporter = WebAppRestoreResource{}
+
+func (r WebAppRestoreResource) ModelObject() interface{} {
+	return &WebAppRestoreModel{}
+}
+
+func (r WebAppRestoreResource) ResourceType() string {
+	return "azurerm_web_app_restore"
+}
+
+func (r WebAppRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppRestoreID
+}
+
+func (r WebAppRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateWebAppID,
+			Description:  "The ID of the Web App to restore the backup into.",
+		},
+
+		"storage_account_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+			Description:  "The SAS URL to the container containing the backup.",
+		},
+
+		"blob_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The name of the blob containing the backup.",
+		},
+
+		"overwrite": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     true,
+			Description: "Should the content of the Web App be overwritten by the backup? Defaults to `true`.",
+		},
+
+		"ignore_conflicting_host_names": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Should custom host names in the backup which are already assigned to another Web App be ignored? Defaults to `false`.",
+		},
+
+		"ignore_databases": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Should the databases in the backup be ignored? Defaults to `false`.",
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A mapping of arbitrary keys and values which, when changed, restore the backup again.",
+		},
+	}
+}
+
+func (r WebAppRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WebAppRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var restore WebAppRestoreModel
+			if err := metadata.Decode(&restore); err != nil {
+				return err
+			}
+
+			webAppId, err := commonids.ParseWebAppID(restore.WebAppId)
+			if err != nil {
+				return err
+			}
+
+			name, err := sdk.NewActionResourceName()
+			if err != nil {
+				return err
+			}
+			id := parse.NewWebAppRestoreID(webAppId.SubscriptionId, webAppId.ResourceGroupName, webAppId.SiteName, name)
+
+			existing, err := client.Get(ctx, *webAppId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", webAppId, err)
+			}
+
+			locks.ByID(webAppId.ID())
+			defer locks.UnlockByID(webAppId.ID())
+
+			request := webapps.RestoreRequest{
+				Properties: &webapps.RestoreRequestProperties{
+					StorageAccountUrl:          restore.StorageAccountUrl,
+					BlobName:                   pointer.To(restore.BlobName),
+					Overwrite:                  restore.Overwrite,
+					IgnoreConflictingHostNames: pointer.To(restore.IgnoreConflictingHostNames),
+					IgnoreDatabases:            pointer.To(restore.IgnoreDatabases),
+					OperationType:              pointer.To(webapps.BackupRestoreOperationTypeDefault),
+				},
+			}
+			if model := existing.Model; model != nil && model.Properties != nil {
+				// the backup is restored into the existing App Service Plan rather than the one the backup was taken from
+				request.Properties.AppServicePlan = model.Properties.ServerFarmId
+			}
+
+			if err := client.RestoreFromBackupBlobThenPoll(ctx, *webAppId, request); err != nil {
+				return fmt.Errorf("restoring %s from the backup %q: %+v", webAppId, restore.BlobName, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppRestoreID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			webAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+			existing, err := client.Get(ctx, webAppId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", webAppId, err)
+			}
+
+			// a restore is a one-off operation, so there's nothing to read back other than the Web App still existing
+			var state WebAppRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+			state.WebAppId = webAppId.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ActionResourceDelete()
+}
+
+func (r WebAppRestoreResource) CustomImporter() sdk.ResourceRunFunc {
+	return sdk.ActionResourceImporter(r.ResourceType())
+}
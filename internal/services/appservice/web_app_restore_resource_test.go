@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppRestoreResource struct{}
+
+func TestAccWebAppRestore_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_WEB_APP_BACKUP_CONTAINER_SAS_URL") == "" || os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME") == "" {
+		t.Skip("Skipping as ARM_TEST_WEB_APP_BACKUP_CONTAINER_SAS_URL and/or ARM_TEST_WEB_APP_BACKUP_BLOB_NAME are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_web_app_restore", "test")
+	r := WebAppRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r WebAppRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppRestoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the restore itself can't be retrieved, so check that the Web App it was restored into still exists
+	webAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+	resp, err := client.AppService.WebAppsClient.Get(ctx, webAppId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", webAppId, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WebAppRestoreResource) basic(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_web_app_restore" "test" {
+  web_app_id                    = azurerm_linux_web_app.test.id
+  storage_account_url           = "%[3]s"
+  blob_name                     = "%[4]s"
+  ignore_conflicting_host_names = true
+
+  triggers = {
+    restore = "%[5]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, os.Getenv("ARM_TEST_WEB_APP_BACKUP_CONTAINER_SAS_URL"), os.Getenv("ARM_TEST_WEB_APP_BACKUP_BLOB_NAME"), trigger)
+}
@@ -420,6 +420,8 @@ func (d WindowsFunctionAppDataSource) Read() sdk.ResourceFunc {
 
 				functionApp.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
 
+				helpers.FlattenAuthV2KeyVaultSecretReferences(functionApp.AuthV2Settings, functionApp.AppSettings)
+
 				functionApp.Backup = helpers.FlattenBackupConfig(backup.Model)
 
 				functionApp.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
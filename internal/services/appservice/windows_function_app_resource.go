@@ -503,13 +503,21 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 				}
 			}
 
-			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionApp.AppSettings)
+			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(functionApp.AuthV2Settings, functionApp.AppSettings))
 
 			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
 			if err != nil {
 				return fmt.Errorf("expanding `identity`: %+v", err)
 			}
 
+			identities, err := identity.FlattenSystemAndUserAssignedMapToModel(expandedIdentity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(functionApp.AuthV2Settings, pointer.From(identities), functionApp.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			siteEnvelope := webapps.Site{
 				Location: location.Normalize(functionApp.Location),
 				Tags:     pointer.To(functionApp.Tags),
@@ -809,6 +817,8 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 
 				state.AuthV2Settings = helpers.FlattenAuthV2Settings(authV2)
 
+				state.AppSettings = helpers.FlattenAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings, metadata.ResourceData.Get("app_settings").(map[string]interface{}))
+
 				state.Backup = helpers.FlattenBackupConfig(backup.Model)
 
 				state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
@@ -882,6 +892,20 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if metadata.ResourceData.HasChanges("auth_settings_v2", "identity", "key_vault_reference_identity_id") {
+				expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				identities, err := identity.FlattenSystemAndUserAssignedMapToModel(expandedIdentity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(state.AuthV2Settings, pointer.From(identities), state.KeyVaultReferenceIdentityID); err != nil {
+					return err
+				}
+			}
+
 			existing, err := client.Get(ctx, *id)
 			if err != nil || existing.Model == nil {
 				return fmt.Errorf("reading Windows %s: %v", id, err)
@@ -1047,7 +1071,7 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				model.Properties.VnetRouteAllEnabled = model.Properties.SiteConfig.VnetRouteAllEnabled
 			}
 
-			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
+			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings))
 
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				pna := helpers.PublicNetworkAccessEnabled
@@ -358,6 +358,10 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(functionAppSlot.AuthV2Settings, functionAppSlot.Identity, functionAppSlot.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			resourceProvidersClient := metadata.Client.AppService.ResourceProvidersClient
 			functionAppId, err := commonids.ParseFunctionAppID(functionAppSlot.FunctionAppID)
@@ -511,7 +515,7 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 				}
 			}
 
-			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, functionAppSlot.AppSettings)
+			siteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(functionAppSlot.AuthV2Settings, functionAppSlot.AppSettings))
 
 			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(functionAppSlot.Identity)
 			if err != nil {
@@ -801,6 +805,8 @@ func (r WindowsFunctionAppSlotResource) Read() sdk.ResourceFunc {
 
 				state.unpackWindowsFunctionAppSettings(appSettingsResp.Model, metadata)
 
+				state.AppSettings = helpers.FlattenAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings, metadata.ResourceData.Get("app_settings").(map[string]interface{}))
+
 				state.SiteConfig[0].AppServiceLogs = helpers.FlattenFunctionAppAppServiceLogs(logs.Model)
 
 				if err := metadata.Encode(&state); err != nil {
@@ -859,6 +865,10 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(state.AuthV2Settings, state.Identity, state.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			existing, err := client.GetSlot(ctx, *id)
 			if err != nil || existing.Model == nil {
 				return fmt.Errorf("reading Windows %s: %v", id, err)
@@ -993,7 +1003,7 @@ func (r WindowsFunctionAppSlotResource) Update() sdk.ResourceFunc {
 				model.Properties.VnetRouteAllEnabled = model.Properties.SiteConfig.VnetRouteAllEnabled
 			}
 
-			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, state.AppSettings)
+			model.Properties.SiteConfig.AppSettings = helpers.MergeUserAppSettings(siteConfig.AppSettings, helpers.ExpandAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings))
 
 			if metadata.ResourceData.HasChange("public_network_access_enabled") {
 				pna := helpers.PublicNetworkAccessEnabled
@@ -392,6 +392,8 @@ func (d WindowsWebAppDataSource) Read() sdk.ResourceFunc {
 
 				webApp.AppSettings = siteConfig.ParseNodeVersion(webApp.AppSettings)
 
+				helpers.FlattenAuthV2KeyVaultSecretReferences(webApp.AuthV2Settings, webApp.AppSettings)
+
 				siteConfig.SetHealthCheckEvictionTime(webApp.AppSettings)
 				if helpers.FxStringHasPrefix(siteConfig.WindowsFxVersion, helpers.FxStringPrefixDocker) {
 					siteConfig.DecodeDockerAppStack(webApp.AppSettings)
@@ -271,6 +271,10 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(webApp.AuthV2Settings, webApp.Identity, webApp.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			client := metadata.Client.AppService.WebAppsClient
 			resourceProvidersClient := metadata.Client.AppService.ResourceProvidersClient
 			servicePlanClient := metadata.Client.AppService.ServicePlanClient
@@ -349,7 +353,7 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("the Site Name %q failed the availability check: %+v", id.SiteName, *checkName.Model.Message)
 			}
 
-			siteConfig, err := sc.ExpandForCreate(webApp.AppSettings)
+			siteConfig, err := sc.ExpandForCreate(helpers.ExpandAuthV2KeyVaultSecretAppSettings(webApp.AuthV2Settings, webApp.AppSettings))
 			if err != nil {
 				return err
 			}
@@ -715,6 +719,8 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 						state.AppSettings = helpers.FilterManagedAppSettings(state.AppSettings)
 					}
 
+					state.AppSettings = helpers.FlattenAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings, metadata.ResourceData.Get("app_settings").(map[string]interface{}))
+
 					// Zip Deploys are not retrievable, so attempt to get from config. This doesn't matter for imports as an unexpected value here could break the deployment.
 					if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 						state.ZipDeployFile = deployFile
@@ -784,6 +790,10 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
+			if err := helpers.ValidateAuthV2KeyVaultSecretIdentity(state.AuthV2Settings, state.Identity, state.KeyVaultReferenceIdentityID); err != nil {
+				return err
+			}
+
 			existing, err := client.Get(ctx, *id)
 			if err != nil || existing.Model == nil {
 				return fmt.Errorf("reading Windows %s: %v", *id, err)
@@ -882,8 +892,8 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				currentStack = sc.ApplicationStack[0].CurrentStack
 			}
 
-			if metadata.ResourceData.HasChanges("site_config", "app_settings") || servicePlanChange {
-				model.Properties.SiteConfig, err = sc.ExpandForUpdate(metadata, model.Properties.SiteConfig, state.AppSettings)
+			if metadata.ResourceData.HasChanges("site_config", "app_settings", "auth_settings_v2") || servicePlanChange {
+				model.Properties.SiteConfig, err = sc.ExpandForUpdate(metadata, model.Properties.SiteConfig, helpers.ExpandAuthV2KeyVaultSecretAppSettings(state.AuthV2Settings, state.AppSettings))
 				if err != nil {
 					return err
 				}
@@ -915,7 +925,7 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 			updateLogs := false
 
 			// sending App Settings updates can clobber logs configuration so must be updated before we send any Log updates
-			if metadata.ResourceData.HasChanges("app_settings", "site_config") {
+			if metadata.ResourceData.HasChanges("app_settings", "site_config", "auth_settings_v2") {
 				appSettingsUpdate := helpers.ExpandAppSettingsForUpdate(model.Properties.SiteConfig.AppSettings)
 				appSettingsProps := *appSettingsUpdate.Properties
 				if state.SiteConfig[0].HealthCheckEvictionTime != 0 {
@@ -5,6 +5,7 @@ package appservice_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -206,6 +207,34 @@ func TestAccWindowsWebApp_authV2UpgradeFromV1(t *testing.T) {
 	})
 }
 
+func TestAccWindowsWebApp_authV2KeyVaultSecrets(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_web_app", "test")
+	r := WindowsWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.authV2KeyVaultSecrets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_settings.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("site_credential.0.password"),
+	})
+}
+
+func TestAccWindowsWebApp_authV2KeyVaultSecretsNoIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_web_app", "test")
+	r := WindowsWebAppResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.authV2KeyVaultSecretsNoIdentity(data),
+			ExpectError: regexp.MustCompile("an `identity` must be specified"),
+		},
+	})
+}
+
 func (r WindowsWebAppResource) authV2AzureActiveDirectory(data acceptance.TestData) string {
 	secretSettingName := "MICROSOFT_PROVIDER_AUTHENTICATION_SECRET"
 	secretSettingValue := "902D17F6-FD6B-4E44-BABB-58E788DCD907"
@@ -862,3 +891,128 @@ resource "azurerm_windows_web_app" "test" {
 }
 `, r.templateWithStorageAccount(data), data.RandomInteger, secretSettingName, secretSettingValue)
 }
+
+func (r WindowsWebAppResource) authV2KeyVaultSecrets(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestUAI-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = data.azurerm_client_config.current.object_id
+    secret_permissions = ["Delete", "Get", "Purge", "Set"]
+  }
+
+  access_policy {
+    tenant_id          = data.azurerm_client_config.current.tenant_id
+    object_id          = azurerm_user_assigned_identity.test.principal_id
+    secret_permissions = ["Get"]
+  }
+}
+
+resource "azurerm_key_vault_secret" "aad" {
+  name         = "aad-client-secret"
+  value        = "902D17F6-FD6B-4E44-BABB-58E788DCD907"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "github" {
+  name         = "github-client-secret"
+  value        = "gh-902D17F6-FD6B-4E44-BABB-58E788DCD907"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWWA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  key_vault_reference_identity_id = azurerm_user_assigned_identity.test.id
+
+  app_settings = {
+    "foo" = "bar"
+  }
+
+  auth_settings_v2 {
+    auth_enabled           = true
+    unauthenticated_action = "Return401"
+    excluded_paths         = ["/health"]
+
+    active_directory_v2 {
+      client_id                         = data.azurerm_client_config.current.client_id
+      client_secret_setting_name        = "MICROSOFT_PROVIDER_AUTHENTICATION_SECRET"
+      client_secret_key_vault_secret_id = azurerm_key_vault_secret.aad.versionless_id
+      tenant_auth_endpoint              = "https://sts.windows.net/%[4]s/v2.0"
+      www_authentication_disabled       = true
+    }
+
+    github_v2 {
+      client_id                         = "123456"
+      client_secret_setting_name        = "GITHUB_PROVIDER_AUTHENTICATION_SECRET"
+      client_secret_key_vault_secret_id = azurerm_key_vault_secret.github.id
+    }
+
+    login {}
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger, data.RandomString, data.Client().TenantID)
+}
+
+func (r WindowsWebAppResource) authV2KeyVaultSecretsNoIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_windows_web_app" "test" {
+  name                = "acctestWWA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+
+  auth_settings_v2 {
+    auth_enabled = true
+
+    github_v2 {
+      client_id                         = "123456"
+      client_secret_setting_name        = "GITHUB_PROVIDER_AUTHENTICATION_SECRET"
+      client_secret_key_vault_secret_id = "https://acctestkv%[3]s.vault.azure.net/secrets/github-client-secret"
+    }
+
+    login {}
+  }
+}
+`, r.baseTemplate(data), data.RandomInteger, data.RandomString)
+}
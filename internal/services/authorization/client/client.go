@@ -4,6 +4,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck // nolint: staticcheck
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicyassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-05-01-preview/roledefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -34,6 +36,8 @@ type Client struct {
 	RoleManagementPolicyAssignmentsClient  *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient
 	ScopedRoleAssignmentsClient            *roleassignments.RoleAssignmentsClient
 	ScopedRoleDefinitionsClient            *roledefinitions.RoleDefinitionsClient
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -113,5 +117,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		RoleManagementPolicyAssignmentsClient:  roleManagementPolicyAssignmentClient,
 		ScopedRoleAssignmentsClient:            scopedRoleAssignmentsClient,
 		ScopedRoleDefinitionsClient:            scopedRoleDefinitionsClient,
+		o:                                      o,
 	}, nil
 }
+
+// ServicePrincipals looks up the Service Principals matching the specified OData filter using Microsoft Graph
+func (c *Client) ServicePrincipals(ctx context.Context, filter string) (*[]graph.ServicePrincipalModel, error) {
+	authorizer, err := c.o.Authorizers.AuthorizerFunc(c.o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, fmt.Errorf("building Authorizer for Microsoft Graph: %+v", err)
+	}
+
+	return graph.ServicePrincipals(ctx, authorizer, c.o.Environment, filter)
+}
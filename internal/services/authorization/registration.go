@@ -41,7 +41,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_role_assignment": resourceArmRoleAssignment(),
+		"azurerm_role_assignment":  resourceArmRoleAssignment(),
+		"azurerm_role_assignments": resourceArmRoleAssignments(),
 	}
 }
 
@@ -49,6 +50,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		RoleDefinitionDataSource{},
 		RoleManagementPolicyDataSource{},
+		ServicePrincipalDataSource{},
 	}
 }
 
@@ -0,0 +1,639 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	billingValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// roleAssignmentsMaxConcurrency limits the number of Role Assignments which are created, read or deleted at once,
+// which keeps large sets of Role Assignments from being throttled by the Authorization API
+const roleAssignmentsMaxConcurrency = 10
+
+func resourceArmRoleAssignments() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		// Role Assignments which couldn't be created are returned as warnings rather than an error, since an error
+		// would taint (and so replace) the Role Assignments which were created successfully
+		CreateContext: resourceArmRoleAssignmentsCreate,
+		Read:          resourceArmRoleAssignmentsRead,
+		Update:        resourceArmRoleAssignmentsUpdate,
+		Delete:        resourceArmRoleAssignmentsDelete,
+
+		// since this resource manages a set of otherwise unrelated Role Assignments, the ID used for import is a
+		// semicolon separated list of the Role Assignment IDs which should be managed by this resource
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			for _, v := range strings.Split(id, ";") {
+				if _, err := parseRoleAssignmentId(v); err != nil {
+					return err
+				}
+			}
+			return nil
+		}, importRoleAssignments),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"role_assignment": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      resourceRoleAssignmentsItemHash,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"scope": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.Any(
+								validation.StringMatch(regexp.MustCompile("/providers/Microsoft.Subscription.*"), "Subscription scope is invalid"),
+
+								billingValidate.EnrollmentID,
+								commonids.ValidateManagementGroupID,
+								commonids.ValidateSubscriptionID,
+								commonids.ValidateResourceGroupID,
+								azure.ValidateResourceID,
+							),
+						},
+
+						"role_definition_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"principal_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"principal_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"User",
+								"Group",
+								"ServicePrincipal",
+							}, false),
+						},
+
+						"description": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"condition": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"condition_version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"1.0",
+								"2.0",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"role_assignment_ids": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmRoleAssignmentsCreate(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	items, err := expandRoleAssignmentsItems(d.Get("role_assignment").(*pluginsdk.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("generating UUID for Role Assignments: %+v", err)
+	}
+
+	var mutex sync.Mutex
+	failures := make(diag.Diagnostics, 0)
+	created, createErr := forEachRoleAssignmentsItem(items, func(item roleAssignmentsItem) error {
+		err := createRoleAssignmentsItem(ctx, client, item, true, d.Timeout(pluginsdk.TimeoutCreate))
+		if err != nil {
+			mutex.Lock()
+			defer mutex.Unlock()
+			failures = append(failures, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("creating %s", item),
+				Detail:   fmt.Sprintf("%+v\n\nThis Role Assignment has been removed from the state and will be created again during the next apply.", err),
+			})
+		}
+		return err
+	})
+
+	if len(created) == 0 {
+		// nothing was created, so there's nothing to track (or replace) and the failures are returned as an error
+		return diag.Errorf("creating %d Role Assignments: %+v", len(items), createErr)
+	}
+
+	// the Role Assignments which failed are omitted from the state, so that only these are planned again
+	d.SetId(id)
+	if err := d.Set("role_assignment", flattenRoleAssignmentsItems(created)); err != nil {
+		return diag.Errorf("setting `role_assignment`: %+v", err)
+	}
+
+	if err := resourceArmRoleAssignmentsRead(d, meta); err != nil {
+		return append(failures, diag.FromErr(err)...)
+	}
+
+	return failures
+}
+
+func resourceArmRoleAssignmentsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	items, err := expandRoleAssignmentsItems(d.Get("role_assignment").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	var mutex sync.Mutex
+	existing := make([]roleAssignmentsItem, 0)
+	roleAssignmentIds := make([]string, 0)
+	if _, err := forEachRoleAssignmentsItem(items, func(item roleAssignmentsItem) error {
+		resp, err := client.Get(ctx, item.scope, item.roleAssignmentName(), "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] %s was not found - removing from state", item)
+				return nil
+			}
+			return err
+		}
+
+		if props := resp.RoleAssignmentPropertiesWithScope; props != nil {
+			// the Role Definition ID is returned scoped to the Subscription, the format which was specified is retained
+			// unless it refers to a different Role Definition
+			if roleDefinitionId := pointer.From(props.RoleDefinitionID); !strings.EqualFold(roleDefinitionGuid(roleDefinitionId), roleDefinitionGuid(item.roleDefinitionId)) {
+				name := item.roleAssignmentName()
+				item.roleDefinitionId = roleDefinitionId
+				// the name is tracked so that the Role Assignment can still be found (and replaced) on the next apply
+				if !strings.EqualFold(name, item.roleAssignmentName()) {
+					item.name = name
+				}
+			}
+			item.principalType = string(props.PrincipalType)
+			item.description = pointer.From(props.Description)
+			item.condition = pointer.From(props.Condition)
+			item.conditionVersion = pointer.From(props.ConditionVersion)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		existing = append(existing, item)
+		roleAssignmentIds = append(roleAssignmentIds, pointer.From(resp.ID))
+		return nil
+	}); err != nil {
+		return fmt.Errorf("retrieving Role Assignments: %+v", err)
+	}
+
+	if err := d.Set("role_assignment", flattenRoleAssignmentsItems(existing)); err != nil {
+		return fmt.Errorf("setting `role_assignment`: %+v", err)
+	}
+	if err := d.Set("role_assignment_ids", roleAssignmentIds); err != nil {
+		return fmt.Errorf("setting `role_assignment_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmRoleAssignmentsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	oldRaw, newRaw := d.GetChange("role_assignment")
+	oldItems, err := expandRoleAssignmentsItems(oldRaw.(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	newItems, err := expandRoleAssignmentsItems(newRaw.(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	// Role Assignments are keyed by the properties which can't be updated, since a change to e.g. the `description`
+	// updates the existing Role Assignment in-place rather than replacing it
+	current := make(map[string]roleAssignmentsItem)
+	for _, item := range oldItems {
+		current[item.key()] = item
+	}
+	desired := make(map[string]struct{})
+	changedItems := make([]roleAssignmentsItem, 0)
+	for _, item := range newItems {
+		desired[item.key()] = struct{}{}
+
+		existing, ok := current[item.key()]
+		if item.principalType == "" {
+			// the `principal_type` is computed when not specified
+			item.principalType = existing.principalType
+		}
+		if !ok || existing != item {
+			changedItems = append(changedItems, item)
+		}
+	}
+
+	toDelete := make([]roleAssignmentsItem, 0)
+	for key, item := range current {
+		if _, ok := desired[key]; !ok {
+			toDelete = append(toDelete, item)
+		}
+	}
+
+	var errs *multierror.Error
+
+	// deletions happen first, so that a Role Assignment which is being renamed doesn't conflict with itself
+	deleted, deleteErr := forEachRoleAssignmentsItem(toDelete, func(item roleAssignmentsItem) error {
+		return deleteRoleAssignmentsItem(ctx, client, item)
+	})
+	for _, item := range deleted {
+		delete(current, item.key())
+	}
+	if deleteErr != nil {
+		errs = multierror.Append(errs, fmt.Errorf("deleting %d of %d Role Assignments: %+v", len(toDelete)-len(deleted), len(toDelete), deleteErr))
+	}
+
+	updated, updateErr := forEachRoleAssignmentsItem(changedItems, func(item roleAssignmentsItem) error {
+		_, exists := current[item.key()]
+		return createRoleAssignmentsItem(ctx, client, item, !exists, d.Timeout(pluginsdk.TimeoutUpdate))
+	})
+	for _, item := range updated {
+		current[item.key()] = item
+	}
+	if updateErr != nil {
+		errs = multierror.Append(errs, fmt.Errorf("creating/updating %d of %d Role Assignments: %+v", len(changedItems)-len(updated), len(changedItems), updateErr))
+	}
+
+	items := make([]roleAssignmentsItem, 0)
+	for _, item := range current {
+		items = append(items, item)
+	}
+	if err := d.Set("role_assignment", flattenRoleAssignmentsItems(items)); err != nil {
+		return fmt.Errorf("setting `role_assignment`: %+v", err)
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
+	}
+
+	return resourceArmRoleAssignmentsRead(d, meta)
+}
+
+func resourceArmRoleAssignmentsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	items, err := expandRoleAssignmentsItems(d.Get("role_assignment").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	deleted, deleteErr := forEachRoleAssignmentsItem(items, func(item roleAssignmentsItem) error {
+		return deleteRoleAssignmentsItem(ctx, client, item)
+	})
+	if deleteErr != nil {
+		deletedNames := make(map[string]struct{})
+		for _, item := range deleted {
+			deletedNames[item.roleAssignmentName()] = struct{}{}
+		}
+		remaining := make([]roleAssignmentsItem, 0)
+		for _, item := range items {
+			if _, ok := deletedNames[item.roleAssignmentName()]; !ok {
+				remaining = append(remaining, item)
+			}
+		}
+		if err := d.Set("role_assignment", flattenRoleAssignmentsItems(remaining)); err != nil {
+			return fmt.Errorf("setting `role_assignment`: %+v", err)
+		}
+
+		return fmt.Errorf("deleting %d of %d Role Assignments: %+v", len(remaining), len(items), deleteErr)
+	}
+
+	return nil
+}
+
+func importRoleAssignments(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Authorization.RoleAssignmentsClient
+
+	items := make([]roleAssignmentsItem, 0)
+	for _, v := range strings.Split(d.Id(), ";") {
+		id, err := parseRoleAssignmentId(v)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Get(ctx, id.scope, id.name, id.tenantId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Role Assignment %q: %+v", v, err)
+		}
+
+		props := resp.RoleAssignmentPropertiesWithScope
+		if props == nil {
+			return nil, fmt.Errorf("retrieving Role Assignment %q: `properties` was nil", v)
+		}
+
+		item := roleAssignmentsItem{
+			scope:            normalizeScopeValue(pointer.From(props.Scope)),
+			roleDefinitionId: pointer.From(props.RoleDefinitionID),
+			principalId:      pointer.From(props.PrincipalID),
+			principalType:    string(props.PrincipalType),
+			description:      pointer.From(props.Description),
+			condition:        pointer.From(props.Condition),
+			conditionVersion: pointer.From(props.ConditionVersion),
+		}
+		// the name only needs to be tracked when it can't be derived from the Role Assignment itself
+		if name := pointer.From(resp.Name); !strings.EqualFold(name, item.roleAssignmentName()) {
+			item.name = name
+		}
+
+		items = append(items, item)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("generating UUID for Role Assignments: %+v", err)
+	}
+
+	d.SetId(id)
+	if err := d.Set("role_assignment", flattenRoleAssignmentsItems(items)); err != nil {
+		return nil, fmt.Errorf("setting `role_assignment`: %+v", err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+type roleAssignmentsItem struct {
+	scope            string
+	roleDefinitionId string
+	principalId      string
+	name             string
+	principalType    string
+	description      string
+	condition        string
+	conditionVersion string
+}
+
+// roleAssignmentName returns the name of the Role Assignment, which when not specified is derived from the scope,
+// Role Definition and Principal - meaning that retries, subsequent applies and imports resolve to the same Role Assignment
+func (i roleAssignmentsItem) roleAssignmentName() string {
+	if i.name != "" {
+		return i.name
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(fmt.Sprintf("%s|%s|%s", i.scope, roleDefinitionGuid(i.roleDefinitionId), i.principalId))))
+	name, _ := uuid.FormatUUID(hash[:16])
+	return name
+}
+
+// key identifies the Role Assignment by the properties which can't be updated in-place
+func (i roleAssignmentsItem) key() string {
+	return strings.ToLower(fmt.Sprintf("%s|%s|%s|%s", i.scope, roleDefinitionGuid(i.roleDefinitionId), i.principalId, i.roleAssignmentName()))
+}
+
+// roleDefinitionGuid returns the name of the Role Definition, since the same Role Definition can be referenced both
+// with and without the Subscription it's scoped to
+func roleDefinitionGuid(input string) string {
+	return input[strings.LastIndex(input, "/")+1:]
+}
+
+// resourceRoleAssignmentsItemHash hashes a `role_assignment` by the properties which can't be updated in-place, so that
+// changes to the others (or values which are computed, such as the `principal_type`) are diffed within the same item
+func resourceRoleAssignmentsItemHash(v interface{}) int {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	item := roleAssignmentsItem{}
+	item.scope, _ = m["scope"].(string)
+	item.roleDefinitionId, _ = m["role_definition_id"].(string)
+	item.principalId, _ = m["principal_id"].(string)
+	item.name, _ = m["name"].(string)
+
+	return pluginsdk.HashString(item.key())
+}
+
+func (i roleAssignmentsItem) String() string {
+	return fmt.Sprintf("Role Assignment for Principal %q with Role Definition %q (Scope %q)", i.principalId, i.roleDefinitionId, i.scope)
+}
+
+func expandRoleAssignmentsItems(input []interface{}) ([]roleAssignmentsItem, error) {
+	items := make([]roleAssignmentsItem, 0)
+	names := make(map[string]struct{})
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		item := roleAssignmentsItem{
+			scope:            v["scope"].(string),
+			roleDefinitionId: v["role_definition_id"].(string),
+			principalId:      v["principal_id"].(string),
+			name:             v["name"].(string),
+			principalType:    v["principal_type"].(string),
+			description:      v["description"].(string),
+			condition:        v["condition"].(string),
+			conditionVersion: v["condition_version"].(string),
+		}
+
+		if (item.condition == "") != (item.conditionVersion == "") {
+			return nil, fmt.Errorf("`condition` and `condition_version` should be both set or unset for the %s", item)
+		}
+
+		name := item.roleAssignmentName()
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("the %s is specified more than once", item)
+		}
+		names[name] = struct{}{}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func flattenRoleAssignmentsItems(input []roleAssignmentsItem) []interface{} {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		output = append(output, map[string]interface{}{
+			"scope":              item.scope,
+			"role_definition_id": item.roleDefinitionId,
+			"principal_id":       item.principalId,
+			"name":               item.name,
+			"principal_type":     item.principalType,
+			"description":        item.description,
+			"condition":          item.condition,
+			"condition_version":  item.conditionVersion,
+		})
+	}
+	return output
+}
+
+// forEachRoleAssignmentsItem runs the function for each of the items concurrently, returning the items for which it
+// succeeded alongside the errors for those which failed
+func forEachRoleAssignmentsItem(items []roleAssignmentsItem, f func(item roleAssignmentsItem) error) ([]roleAssignmentsItem, error) {
+	var mutex sync.Mutex
+	var errs *multierror.Error
+	succeeded := make([]roleAssignmentsItem, 0)
+
+	wg := &sync.WaitGroup{}
+	semaphore := make(chan struct{}, roleAssignmentsMaxConcurrency)
+	for _, item := range items {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(item roleAssignmentsItem) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			err := f(item)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %+v", item, err))
+				return
+			}
+			succeeded = append(succeeded, item)
+		}(item)
+	}
+	wg.Wait()
+
+	return succeeded, errs.ErrorOrNil()
+}
+
+func createRoleAssignmentsItem(ctx context.Context, client *authorization.RoleAssignmentsClient, item roleAssignmentsItem, checkForExisting bool, timeout time.Duration) error {
+	name := item.roleAssignmentName()
+
+	if checkForExisting {
+		existing, err := client.Get(ctx, item.scope, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Role Assignment %q: %+v", name, err)
+			}
+		}
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_role_assignments", *existing.ID)
+		}
+	}
+
+	properties := authorization.RoleAssignmentCreateParameters{
+		RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+			RoleDefinitionID: pointer.To(item.roleDefinitionId),
+			PrincipalID:      pointer.To(item.principalId),
+			Description:      pointer.To(item.description),
+		},
+	}
+	if item.principalType != "" {
+		properties.RoleAssignmentProperties.PrincipalType = authorization.PrincipalType(item.principalType)
+	}
+	if item.condition != "" {
+		properties.RoleAssignmentProperties.Condition = pointer.To(item.condition)
+		properties.RoleAssignmentProperties.ConditionVersion = pointer.To(item.conditionVersion)
+	}
+
+	return pluginsdk.Retry(timeout, func() *pluginsdk.RetryError {
+		resp, err := client.Create(ctx, item.scope, name, properties)
+		if err != nil {
+			switch {
+			case utils.ResponseErrorIsRetryable(err):
+				return pluginsdk.RetryableError(err)
+			case utils.ResponseWasStatusCode(resp.Response, 400) && strings.Contains(err.Error(), "PrincipalNotFound"):
+				// When waiting for service principal to become available
+				return pluginsdk.RetryableError(err)
+			default:
+				return pluginsdk.NonRetryableError(err)
+			}
+		}
+
+		if resp.ID == nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("creation of Role Assignment %q did not return an id value", name))
+		}
+
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending: []string{
+				"pending",
+			},
+			Target: []string{
+				"ready",
+			},
+			Refresh:                   roleAssignmentCreateStateRefreshFunc(ctx, client, *resp.ID, ""),
+			MinTimeout:                5 * time.Second,
+			ContinuousTargetOccurence: 5,
+			Timeout:                   timeout,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("failed waiting for Role Assignment %q to finish replicating: %+v", name, err))
+		}
+
+		return nil
+	})
+}
+
+func deleteRoleAssignmentsItem(ctx context.Context, client *authorization.RoleAssignmentsClient, item roleAssignmentsItem) error {
+	resp, err := client.Delete(ctx, item.scope, item.roleAssignmentName(), "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp.Response) {
+			return err
+		}
+	}
+
+	return nil
+}
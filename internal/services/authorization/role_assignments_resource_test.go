@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RoleAssignmentsResource struct{}
+
+func TestAccRoleAssignments_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("2"),
+				check.That(data.ResourceName).Key("role_assignment_ids.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccRoleAssignments_importThenPlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	importStep := r.importStep(data)
+	importStep.ImportStatePersist = true
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		importStep,
+		{
+			// the imported Role Assignments match the configuration, so they're neither updated nor replaced
+			Config:   r.updated(data),
+			PlanOnly: true,
+		},
+		{
+			// a change made outside of Terraform is detected
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClient(r.updateDescriptions),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccRoleAssignments_invalidPrincipal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the Role Assignment for the Principal which doesn't exist fails, the other is created and tracked
+			// (rather than tainted) - so only the failed Role Assignment is planned again
+			Config: r.invalidPrincipal(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("1"),
+				check.That(data.ResourceName).Key("role_assignment_ids.#").HasValue("1"),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.basic(data),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccRoleAssignments_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRoleAssignments_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignments", "test")
+	r := RoleAssignmentsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.#").HasValue("3"),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_assignment_ids.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+// importStep imports the Role Assignments using a list of their IDs, since the ID of this resource is generated
+func (r RoleAssignmentsResource) importStep(data acceptance.TestData) acceptance.TestStep {
+	return acceptance.TestStep{
+		ResourceName: data.ResourceName,
+		ImportState:  true,
+		ImportStateIdFunc: func(state *acceptance.State) (string, error) {
+			rs, ok := state.RootModule().Resources[data.ResourceName]
+			if !ok {
+				return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+			}
+			return strings.Join(r.roleAssignmentIds(rs.Primary), ";"), nil
+		},
+		ImportStateCheck: func(states []*acceptance.InstanceState) error {
+			if len(states) != 1 {
+				return fmt.Errorf("expected 1 imported resource but got %d", len(states))
+			}
+			if v := states[0].Attributes["role_assignment.#"]; v == "" || v == "0" {
+				return fmt.Errorf("expected the imported resource to contain Role Assignments but got %q", v)
+			}
+			return nil
+		},
+	}
+}
+
+func (RoleAssignmentsResource) roleAssignmentIds(state *acceptance.InstanceState) []string {
+	ids := make([]string, 0)
+	for k, v := range state.Attributes {
+		if strings.HasPrefix(k, "role_assignment_ids.") && k != "role_assignment_ids.#" {
+			ids = append(ids, v)
+		}
+	}
+	return ids
+}
+
+func (r RoleAssignmentsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	ids := r.roleAssignmentIds(state)
+	if len(ids) == 0 {
+		return utils.Bool(false), nil
+	}
+
+	for _, id := range ids {
+		resp, err := client.Authorization.RoleAssignmentsClient.GetByID(ctx, id, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Role Assignment %q: %+v", id, err)
+		}
+	}
+	return utils.Bool(true), nil
+}
+
+func (r RoleAssignmentsResource) updateDescriptions(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+	for _, id := range r.roleAssignmentIds(state) {
+		resp, err := client.Authorization.RoleAssignmentsClient.GetByID(ctx, id, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Role Assignment %q: %+v", id, err)
+		}
+		props := resp.RoleAssignmentPropertiesWithScope
+		if props == nil {
+			return fmt.Errorf("retrieving Role Assignment %q: `properties` was nil", id)
+		}
+
+		parameters := authorization.RoleAssignmentCreateParameters{
+			RoleAssignmentProperties: &authorization.RoleAssignmentProperties{
+				RoleDefinitionID: props.RoleDefinitionID,
+				PrincipalID:      props.PrincipalID,
+				PrincipalType:    props.PrincipalType,
+				Description:      utils.String("Changed outside of Terraform"),
+				Condition:        props.Condition,
+				ConditionVersion: props.ConditionVersion,
+			},
+		}
+		if _, err := client.Authorization.RoleAssignmentsClient.CreateByID(ctx, id, parameters); err != nil {
+			return fmt.Errorf("updating Role Assignment %q: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func (RoleAssignmentsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_client_config" "test" {}
+
+data "azurerm_role_definition" "reader" {
+  name = "Reader"
+}
+
+data "azurerm_role_definition" "monitoring_reader" {
+  name = "Monitoring Reader"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ras-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r RoleAssignmentsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "test" {
+  role_assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+
+  role_assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.monitoring_reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+}
+`, r.template(data))
+}
+
+func (r RoleAssignmentsResource) invalidPrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "test" {
+  role_assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+
+  role_assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.monitoring_reader.id}"
+    principal_id       = "00000000-0000-0000-0000-000000000000"
+    principal_type     = "ServicePrincipal"
+  }
+
+  timeouts {
+    create = "5m"
+  }
+}
+`, r.template(data))
+}
+
+func (r RoleAssignmentsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "import" {
+  dynamic "role_assignment" {
+    for_each = azurerm_role_assignments.test.role_assignment
+    content {
+      scope              = role_assignment.value.scope
+      role_definition_id = role_assignment.value.role_definition_id
+      principal_id       = role_assignment.value.principal_id
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r RoleAssignmentsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignments" "test" {
+  role_assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+    principal_type     = "ServicePrincipal"
+    description        = "Updated by Terraform"
+  }
+
+  role_assignment {
+    scope              = data.azurerm_subscription.primary.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.monitoring_reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+  }
+
+  role_assignment {
+    scope              = azurerm_resource_group.test.id
+    role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.monitoring_reader.id}"
+    principal_id       = data.azurerm_client_config.test.object_id
+    condition_version  = "2.0"
+    condition          = "((!(ActionMatches{'Microsoft.Insights/metrics/read'})))"
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ServicePrincipalDataSource looks up a Service Principal via Microsoft Graph, so that the Object ID of well-known
+// first-party Service Principals (e.g. `Azure Cosmos DB`) can be used in Role Assignments without the AzureAD Provider
+type ServicePrincipalDataSource struct{}
+
+var _ sdk.DataSource = ServicePrincipalDataSource{}
+
+type ServicePrincipalDataSourceModel struct {
+	ClientId            string `tfschema:"client_id"`
+	DisplayName         string `tfschema:"display_name"`
+	ApplicationTenantId string `tfschema:"application_tenant_id"`
+	ObjectId            string `tfschema:"object_id"`
+}
+
+func (a ServicePrincipalDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"client_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ExactlyOneOf: []string{
+				"client_id",
+				"display_name",
+			},
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ExactlyOneOf: []string{
+				"client_id",
+				"display_name",
+			},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (a ServicePrincipalDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"application_tenant_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"object_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (a ServicePrincipalDataSource) ModelObject() interface{} {
+	return &ServicePrincipalDataSourceModel{}
+}
+
+func (a ServicePrincipalDataSource) ResourceType() string {
+	return "azurerm_service_principal"
+}
+
+func (a ServicePrincipalDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization
+
+			var config ServicePrincipalDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			var filter, description string
+			if config.ClientId != "" {
+				filter = fmt.Sprintf("appId eq '%s'", config.ClientId)
+				description = fmt.Sprintf("Service Principal with Client ID %q", config.ClientId)
+			} else {
+				filter = fmt.Sprintf("displayName eq '%s'", strings.ReplaceAll(config.DisplayName, "'", "''"))
+				description = fmt.Sprintf("Service Principal with Display Name %q", config.DisplayName)
+			}
+
+			servicePrincipals, err := client.ServicePrincipals(ctx, filter)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", description, err)
+			}
+			if servicePrincipals == nil || len(*servicePrincipals) == 0 {
+				return fmt.Errorf("%s was not found", description)
+			}
+			if len(*servicePrincipals) > 1 {
+				return fmt.Errorf("expected a single %s but found %d - `client_id` can be used to identify a specific Service Principal", description, len(*servicePrincipals))
+			}
+
+			servicePrincipal := (*servicePrincipals)[0]
+			if servicePrincipal.ID == nil {
+				return fmt.Errorf("retrieving %s: `id` was nil", description)
+			}
+
+			state := ServicePrincipalDataSourceModel{
+				ApplicationTenantId: pointer.From(servicePrincipal.AppOwnerOrganizationId),
+				ClientId:            pointer.From(servicePrincipal.AppId),
+				DisplayName:         pointer.From(servicePrincipal.DisplayName),
+				ObjectId:            *servicePrincipal.ID,
+			}
+
+			// Service Principals aren't Azure Resources, so the Object ID is used as the ID
+			metadata.ResourceData.SetId(fmt.Sprintf("/servicePrincipals/%s", *servicePrincipal.ID))
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ServicePrincipalDataSource struct{}
+
+func TestAccServicePrincipalDataSource_byDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_service_principal", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ServicePrincipalDataSource{}.byDisplayName(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("client_id").HasValue("a232010e-820c-4083-83bb-3ace5fc29d0b"),
+				check.That(data.ResourceName).Key("object_id").IsUUID(),
+				check.That(data.ResourceName).Key("application_tenant_id").IsUUID(),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipalDataSource_byClientId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_service_principal", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: ServicePrincipalDataSource{}.byClientId(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Azure Cosmos DB"),
+				check.That(data.ResourceName).Key("object_id").IsUUID(),
+			),
+		},
+	})
+}
+
+func (d ServicePrincipalDataSource) byDisplayName() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_service_principal" "test" {
+  display_name = "Azure Cosmos DB"
+}
+`
+}
+
+func (d ServicePrincipalDataSource) byClientId() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_service_principal" "test" {
+  client_id = "a232010e-820c-4083-83bb-3ace5fc29d0b"
+}
+`
+}
@@ -4,6 +4,7 @@
 package cdn
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -42,6 +43,21 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		// validate the managed rule set type, version and action combinations during plan rather than apply
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// unknown values read as empty strings, which would be validated as the wrong version or action - so these
+			// combinations are validated during apply instead
+			if !cdnFrontDoorFirewallManagedRulesKnown(d) {
+				return nil
+			}
+
+			if _, err := expandCdnFrontDoorFirewallManagedRules(d.Get("managed_rule").([]interface{})); err != nil {
+				return err
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -808,6 +824,37 @@ func expandCdnFrontDoorFirewallTransforms(input []interface{}) *[]frontdoor.Tran
 	return &result
 }
 
+// cdnFrontDoorFirewallManagedRulesKnown returns whether the values used to validate the managed rules are known, which
+// isn't the case during plan when they're derived from attributes of other resources which are yet to be created
+func cdnFrontDoorFirewallManagedRulesKnown(d *pluginsdk.ResourceDiff) bool {
+	if !d.NewValueKnown("managed_rule") {
+		return false
+	}
+
+	for i := range d.Get("managed_rule").([]interface{}) {
+		for _, key := range []string{"type", "version", "action", "override"} {
+			if !d.NewValueKnown(fmt.Sprintf("managed_rule.%d.%s", i, key)) {
+				return false
+			}
+		}
+
+		for j := range d.Get(fmt.Sprintf("managed_rule.%d.override", i)).([]interface{}) {
+			rules := fmt.Sprintf("managed_rule.%d.override.%d.rule", i, j)
+			if !d.NewValueKnown(rules) {
+				return false
+			}
+
+			for k := range d.Get(rules).([]interface{}) {
+				if !d.NewValueKnown(fmt.Sprintf("%s.%d.action", rules, k)) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
 func expandCdnFrontDoorFirewallManagedRules(input []interface{}) (*frontdoor.ManagedRuleSetList, error) {
 	if len(input) == 0 {
 		return nil, nil
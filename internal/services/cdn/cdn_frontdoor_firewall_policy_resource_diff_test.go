@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cdn
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// unknownValue is the value the Plugin SDK uses for values which aren't known until apply
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestCdnFrontDoorFirewallPolicyCustomizeDiffManagedRules(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Type          string
+		Version       string
+		Action        string
+		RuleAction    string
+		ExpectedError string
+	}{
+		{
+			Name:       "valid",
+			Type:       "Microsoft_DefaultRuleSet",
+			Version:    "2.1",
+			Action:     "Block",
+			RuleAction: "AnomalyScoring",
+		},
+		{
+			Name:          "invalid rule action",
+			Type:          "Microsoft_DefaultRuleSet",
+			Version:       "2.1",
+			Action:        "Block",
+			RuleAction:    "Block",
+			ExpectedError: "the managed rules 'action' field must be set to 'AnomalyScoring' or 'Log'",
+		},
+		{
+			Name:          "invalid version",
+			Type:          "Microsoft_DefaultRuleSet",
+			Version:       "1.0",
+			Action:        "Block",
+			RuleAction:    "Block",
+			ExpectedError: "please update your 'version' field",
+		},
+		{
+			Name:       "unknown type",
+			Type:       unknownValue,
+			Version:    "1.0",
+			Action:     "Block",
+			RuleAction: "Block",
+		},
+		{
+			// an unknown version would otherwise be validated as `1.0`
+			Name:       "unknown version",
+			Type:       "Microsoft_DefaultRuleSet",
+			Version:    unknownValue,
+			Action:     "Block",
+			RuleAction: "AnomalyScoring",
+		},
+		{
+			Name:       "unknown action",
+			Type:       "Microsoft_DefaultRuleSet",
+			Version:    "2.1",
+			Action:     unknownValue,
+			RuleAction: "AnomalyScoring",
+		},
+		{
+			Name:       "unknown rule action",
+			Type:       "Microsoft_DefaultRuleSet",
+			Version:    "2.1",
+			Action:     "Block",
+			RuleAction: unknownValue,
+		},
+	}
+
+	resource := resourceCdnFrontDoorFirewallPolicy()
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":                "example",
+			"resource_group_name": "example-resources",
+			"sku_name":            "Premium_AzureFrontDoor",
+			"mode":                "Prevention",
+			"managed_rule": []interface{}{
+				map[string]interface{}{
+					"type":    v.Type,
+					"version": v.Version,
+					"action":  v.Action,
+					"override": []interface{}{
+						map[string]interface{}{
+							"rule_group_name": "SQLI",
+							"rule": []interface{}{
+								map[string]interface{}{
+									"rule_id": "942200",
+									"action":  v.RuleAction,
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		_, err := resource.Diff(context.TODO(), nil, config, nil)
+		if v.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), v.ExpectedError) {
+			t.Fatalf("expected an error containing %q but got: %+v", v.ExpectedError, err)
+		}
+	}
+}
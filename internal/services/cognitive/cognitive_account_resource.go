@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -34,7 +35,7 @@ import (
 )
 
 func resourceCognitiveAccount() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceCognitiveAccountCreate,
 		Read:   resourceCognitiveAccountRead,
 		Update: resourceCognitiveAccountUpdate,
@@ -315,6 +316,11 @@ func resourceCognitiveAccount() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	// the schema is captured here, rather than being built each time a plan is computed
+	resource.CustomizeDiff = pluginsdk.CustomizeDiffShim(cognitiveAccountSoftDeletedNameConflictCustomizeDiff(resource.Schema))
+
+	return resource
 }
 
 func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -606,6 +612,25 @@ func resourceCognitiveAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+// cognitiveAccountSoftDeletedNameConflictCustomizeDiff fails the plan when the Cognitive Account would be replaced whilst
+// keeping its name but the deleted Cognitive Account won't be purged (and as such its name can't be reused) - which
+// otherwise fails during apply, after the Cognitive Account has already been deleted
+func cognitiveAccountSoftDeletedNameConflictCustomizeDiff(s map[string]*pluginsdk.Schema) pluginsdk.CustomizeDiffFunc {
+	return func(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		features := meta.(*clients.Client).Features
+		if !features.ResourceReplacement.PreventSoftDeletedNameConflicts || features.CognitiveAccount.PurgeSoftDeleteOnDestroy || d.HasChange("name") {
+			return nil
+		}
+
+		changedKeys := pluginsdk.ChangedForceNewKeys(d, s)
+		if len(changedKeys) == 0 {
+			return nil
+		}
+
+		return fmt.Errorf("changing `%s` requires the Cognitive Account %q to be deleted and recreated with the same name, which will fail since `purge_soft_delete_on_destroy` is disabled in the `cognitive_account` block of the provider `features` block, as such the deleted Cognitive Account won't be purged. Either change the `name` of the Cognitive Account, revert the changes to `%s`, or set `prevent_soft_deleted_name_conflicts` to `false` in the `resource_replacement` block of the provider `features` block to skip this check", strings.Join(changedKeys, "`, `"), d.Get("name").(string), strings.Join(changedKeys, "`, `"))
+	}
+}
+
 func resourceCognitiveAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	accountsClient := meta.(*clients.Client).Cognitive.AccountsClient
 	deletedAccountsClient := meta.(*clients.Client).Cognitive.AccountsClient
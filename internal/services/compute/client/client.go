@@ -38,6 +38,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/usagesinformation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -61,6 +63,8 @@ type Client struct {
 	ImagesClient                                *images.ImagesClient
 	MarketplaceAgreementsClient                 *agreements.AgreementsClient
 	ProximityPlacementGroupsClient              *proximityplacementgroups.ProximityPlacementGroupsClient
+	QuotaInformationClient                      *quotainformation.QuotaInformationClient
+	QuotaUsagesInformationClient                *usagesinformation.UsagesInformationClient
 	SkusClient                                  *skus.SkusClient
 	SSHPublicKeysClient                         *sshpublickeys.SshPublicKeysClient
 	SnapshotsClient                             *snapshots.SnapshotsClient
@@ -177,6 +181,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(proximityPlacementGroupsClient.Client, o.Authorizers.ResourceManager)
 
+	quotaInformationClient, err := quotainformation.NewQuotaInformationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building QuotaInformation client: %+v", err)
+	}
+	o.Configure(quotaInformationClient.Client, o.Authorizers.ResourceManager)
+
+	quotaUsagesInformationClient, err := usagesinformation.NewUsagesInformationClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building UsagesInformation client: %+v", err)
+	}
+	o.Configure(quotaUsagesInformationClient.Client, o.Authorizers.ResourceManager)
+
 	skusClient, err := skus.NewSkusClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Skus client: %+v", err)
@@ -261,6 +277,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ImagesClient:                                imagesClient,
 		MarketplaceAgreementsClient:                 marketplaceAgreementsClient,
 		ProximityPlacementGroupsClient:              proximityPlacementGroupsClient,
+		QuotaInformationClient:                      quotaInformationClient,
+		QuotaUsagesInformationClient:                quotaUsagesInformationClient,
 		SkusClient:                                  skusClient,
 		SSHPublicKeysClient:                         sshPublicKeysClient,
 		SnapshotsClient:                             snapshotsClient,
@@ -1289,34 +1289,39 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		shouldShutDown = true
 		vmSize := d.Get("size").(string)
 
-		// Azure will auto-reboot this for us, providing this machine will fit on this host
-		// otherwise we need to shut down the VM to move it to another host to be able to use this size
-		availableOnThisHost := false
-		sizes, err := client.ListAvailableSizes(ctx, *id)
-		if err != nil {
-			return fmt.Errorf("retrieving available sizes for Linux %s: %+v", id, err)
-		}
+		if meta.(*clients.Client).Features.VirtualMachine.DeallocateBeforeResize {
+			// rather than relying on the live resize failing, always deallocate the VM so it can move to another host
+			shouldDeallocate = true
+		} else {
+			// Azure will auto-reboot this for us, providing this machine will fit on this host
+			// otherwise we need to shut down the VM to move it to another host to be able to use this size
+			availableOnThisHost := false
+			sizes, err := client.ListAvailableSizes(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving available sizes for Linux %s: %+v", id, err)
+			}
 
-		if sizes.Model != nil && sizes.Model.Value != nil {
-			for _, size := range *sizes.Model.Value {
-				if size.Name == nil {
-					continue
-				}
+			if sizes.Model != nil && sizes.Model.Value != nil {
+				for _, size := range *sizes.Model.Value {
+					if size.Name == nil {
+						continue
+					}
 
-				if strings.EqualFold(*size.Name, vmSize) {
-					availableOnThisHost = true
-					break
+					if strings.EqualFold(*size.Name, vmSize) {
+						availableOnThisHost = true
+						break
+					}
 				}
 			}
-		}
 
-		if !availableOnThisHost {
-			log.Printf("[DEBUG] Requested VM Size isn't available on the Host - must switch host to resize..")
-			// Code="OperationNotAllowed"
-			// Message="Unable to resize the VM [name] because the requested size Standard_F4s_v2 is not available in the current hardware cluster.
-			//         The available sizes in this cluster are: [list]. The requested size might be available in other clusters of this region.
-			//         Read more on VM resizing strategy at https://aka.ms/azure-resizevm."
-			shouldDeallocate = true
+			if !availableOnThisHost {
+				log.Printf("[DEBUG] Requested VM Size isn't available on the Host - must switch host to resize..")
+				// Code="OperationNotAllowed"
+				// Message="Unable to resize the VM [name] because the requested size Standard_F4s_v2 is not available in the current hardware cluster.
+				//         The available sizes in this cluster are: [list]. The requested size might be available in other clusters of this region.
+				//         Read more on VM resizing strategy at https://aka.ms/azure-resizevm."
+				shouldDeallocate = true
+			}
 		}
 
 		update.Properties.HardwareProfile = &virtualmachines.HardwareProfile{
@@ -1481,7 +1486,7 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 		shouldUpdate = true
 
 		n, _ := d.GetChange("additional_capabilities")
-		if len(n.([]interface{})) == 0 || d.HasChange("additional_capabilities.0.ultra_ssd_enabled") {
+		if len(n.([]interface{})) == 0 || d.HasChange("additional_capabilities.0.ultra_ssd_enabled") || d.HasChange("additional_capabilities.0.hibernation_enabled") {
 			shouldShutDown = true
 			shouldDeallocate = true
 		}
@@ -4,10 +4,12 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -65,10 +67,60 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"signature": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMarketplaceAgreementCustomizeDiff),
 	}
 }
 
+// resourceMarketplaceAgreementCustomizeDiff forces the Marketplace Terms to be accepted again when the
+// Publisher has changed the Terms for the Plan since they were last read, which is detected by comparing the
+// `signature` in the state with the signature of the current Terms. Changed Terms which aren't accepted are
+// otherwise picked up during the refresh, since the Read removes the Marketplace Agreement from the state.
+func resourceMarketplaceAgreementCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	acceptedSignature := diff.Get("signature").(string)
+	if acceptedSignature == "" {
+		return nil
+	}
+
+	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
+
+	id, err := agreements.ParsePlanID(diff.Id())
+	if err != nil {
+		return err
+	}
+
+	agreementId := agreements.NewOfferPlanID(id.SubscriptionId, id.PublisherId, id.OfferId, id.PlanId)
+	term, err := client.MarketplaceAgreementsGet(ctx, agreementId)
+	if err != nil {
+		return fmt.Errorf("retrieving the Marketplace Terms for %s: %+v", id, err)
+	}
+
+	currentSignature := ""
+	if model := term.Model; model != nil && model.Properties != nil {
+		currentSignature = pointer.From(model.Properties.Signature)
+	}
+
+	if currentSignature == "" || currentSignature == acceptedSignature {
+		return nil
+	}
+
+	log.Printf("[DEBUG] The Marketplace Terms for %s have changed since they were accepted, they need to be accepted again", id)
+	if err := diff.SetNewComputed("signature"); err != nil {
+		return err
+	}
+	return diff.ForceNew("signature")
+}
+
 func resourceMarketplaceAgreementCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -160,6 +212,8 @@ func resourceMarketplaceAgreementRead(d *pluginsdk.ResourceData, meta interface{
 			}
 			d.Set("license_text_link", props.LicenseTextLink)
 			d.Set("privacy_policy_link", props.PrivacyPolicyLink)
+
+			d.Set("signature", pointer.From(props.Signature))
 		}
 	}
 	return nil
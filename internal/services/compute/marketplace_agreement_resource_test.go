@@ -39,6 +39,7 @@ func TestAccMarketplaceAgreement_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("license_text_link").Exists(),
 				check.That(data.ResourceName).Key("privacy_policy_link").Exists(),
+				check.That(data.ResourceName).Key("signature").IsNotEmpty(),
 			),
 		},
 		data.ImportStep(),
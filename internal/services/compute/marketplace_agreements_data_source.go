@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MarketplaceAgreementsDataSource struct{}
+
+var _ sdk.DataSource = MarketplaceAgreementsDataSource{}
+
+type MarketplaceAgreementsDataSourceModel struct {
+	Publisher        string                 `tfschema:"publisher"`
+	Offer            string                 `tfschema:"offer"`
+	IncludeCancelled bool                   `tfschema:"include_cancelled"`
+	Agreements       []MarketplaceAgreement `tfschema:"agreements"`
+}
+
+type MarketplaceAgreement struct {
+	Id          string `tfschema:"id"`
+	Name        string `tfschema:"name"`
+	Publisher   string `tfschema:"publisher"`
+	Offer       string `tfschema:"offer"`
+	State       string `tfschema:"state"`
+	SignDate    string `tfschema:"sign_date"`
+	CancelDate  string `tfschema:"cancel_date"`
+	AgreementId string `tfschema:"agreement_id"`
+}
+
+func (r MarketplaceAgreementsDataSource) ResourceType() string {
+	return "azurerm_marketplace_agreements"
+}
+
+func (r MarketplaceAgreementsDataSource) ModelObject() interface{} {
+	return &MarketplaceAgreementsDataSourceModel{}
+}
+
+func (r MarketplaceAgreementsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"publisher": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"offer": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"include_cancelled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func (r MarketplaceAgreementsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"agreements": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"publisher": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"offer": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sign_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"cancel_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"agreement_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MarketplaceAgreementsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.MarketplaceAgreementsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state MarketplaceAgreementsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewSubscriptionID(subscriptionId)
+
+			resp, err := client.MarketplaceAgreementsList(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing Marketplace Agreements for %s: %+v", id, err)
+			}
+
+			results := make([]MarketplaceAgreement, 0)
+			if model := resp.Model; model != nil {
+				for _, item := range pointer.From(model.Value) {
+					agreement := flattenMarketplaceAgreement(item)
+
+					if state.Publisher != "" && !strings.EqualFold(agreement.Publisher, state.Publisher) {
+						continue
+					}
+					if state.Offer != "" && !strings.EqualFold(agreement.Offer, state.Offer) {
+						continue
+					}
+					if !state.IncludeCancelled && agreement.State != string(agreements.StateActive) {
+						continue
+					}
+
+					results = append(results, agreement)
+				}
+			}
+
+			sort.Slice(results, func(i, j int) bool {
+				return results[i].Id < results[j].Id
+			})
+			state.Agreements = results
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenMarketplaceAgreement(input agreements.OldAgreementTerms) MarketplaceAgreement {
+	output := MarketplaceAgreement{
+		Id:   pointer.From(input.Id),
+		Name: pointer.From(input.Name),
+	}
+
+	if props := input.Properties; props != nil {
+		output.Publisher = pointer.From(props.Publisher)
+		output.Offer = pointer.From(props.Offer)
+		output.State = string(pointer.From(props.State))
+		output.SignDate = pointer.From(props.SignDate)
+		output.CancelDate = pointer.From(props.CancelDate)
+		output.AgreementId = pointer.From(props.Id)
+	}
+
+	return output
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MarketplaceAgreementsDataSource struct{}
+
+func TestAccMarketplaceAgreementsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_marketplace_agreements", "test")
+	d := MarketplaceAgreementsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("agreements.#").Exists(),
+				check.That(data.ResourceName).Key("agreements.0.publisher").HasValue("barracudanetworks"),
+				check.That(data.ResourceName).Key("agreements.0.offer").HasValue("waf"),
+				check.That(data.ResourceName).Key("agreements.0.state").HasValue("Active"),
+				check.That(data.ResourceName).Key("agreements.0.sign_date").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (MarketplaceAgreementsDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_agreement" "test" {
+  publisher = "barracudanetworks"
+  offer     = "waf"
+  plan      = "hourly"
+}
+
+data "azurerm_marketplace_agreements" "test" {
+  publisher = azurerm_marketplace_agreement.test.publisher
+  offer     = azurerm_marketplace_agreement.test.offer
+}
+`
+}
@@ -83,6 +83,8 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		OrchestratedVirtualMachineScaleSetDataSource{},
+		VirtualMachineSizesDataSource{},
+		MarketplaceAgreementsDataSource{},
 	}
 }
 
@@ -0,0 +1,407 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/quota/2023-02-01/quotainformation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualMachineSizesDataSource struct{}
+
+var _ sdk.DataSource = VirtualMachineSizesDataSource{}
+
+type VirtualMachineSizesDataSourceModel struct {
+	Location                     string               `tfschema:"location"`
+	Zone                         string               `tfschema:"zone"`
+	MinimumVCPUs                 int64                `tfschema:"minimum_vcpus"`
+	MaximumVCPUs                 int64                `tfschema:"maximum_vcpus"`
+	MinimumMemoryInGB            float64              `tfschema:"minimum_memory_in_gb"`
+	MaximumMemoryInGB            float64              `tfschema:"maximum_memory_in_gb"`
+	AcceleratedNetworkingEnabled bool                 `tfschema:"accelerated_networking_enabled"`
+	PremiumIOEnabled             bool                 `tfschema:"premium_io_enabled"`
+	AvailableQuotaRequired       bool                 `tfschema:"available_quota_required"`
+	Sizes                        []VirtualMachineSize `tfschema:"sizes"`
+}
+
+type VirtualMachineSize struct {
+	Name                         string   `tfschema:"name"`
+	Family                       string   `tfschema:"family"`
+	VCPUs                        int64    `tfschema:"vcpus"`
+	MemoryInGB                   float64  `tfschema:"memory_in_gb"`
+	AcceleratedNetworkingEnabled bool     `tfschema:"accelerated_networking_enabled"`
+	PremiumIOEnabled             bool     `tfschema:"premium_io_enabled"`
+	Zones                        []string `tfschema:"zones"`
+	QuotaLimit                   int64    `tfschema:"quota_limit"`
+	QuotaUsage                   int64    `tfschema:"quota_usage"`
+}
+
+// virtualMachineSizesRegionalQuotaName is the name of the quota which limits the total number of vCPUs in a region
+const virtualMachineSizesRegionalQuotaName = "cores"
+
+type virtualMachineSizeQuota struct {
+	limit int64
+	usage int64
+}
+
+func (r VirtualMachineSizesDataSource) ResourceType() string {
+	return "azurerm_virtual_machine_sizes"
+}
+
+func (r VirtualMachineSizesDataSource) ModelObject() interface{} {
+	return &VirtualMachineSizesDataSourceModel{}
+}
+
+func (r VirtualMachineSizesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"minimum_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"maximum_vcpus": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"minimum_memory_in_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0.1),
+		},
+
+		"maximum_memory_in_gb": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(0.1),
+		},
+
+		"accelerated_networking_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"premium_io_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"available_quota_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+	}
+}
+
+func (r VirtualMachineSizesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sizes": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"family": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"vcpus": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"memory_in_gb": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"accelerated_networking_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"premium_io_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"zones": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"quota_limit": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"quota_usage": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineSizesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			skusClient := metadata.Client.Compute.SkusClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state VirtualMachineSizesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualmachines.NewLocationID(subscriptionId, location.Normalize(state.Location))
+
+			opts := skus.DefaultResourceSkusListOperationOptions()
+			// by default this API returns every SKU in every Location, so filter to the requested Location only
+			opts.Filter = pointer.To(fmt.Sprintf("location eq '%s'", id.LocationName))
+			skusResp, err := skusClient.ResourceSkusListComplete(ctx, commonids.NewSubscriptionID(subscriptionId), opts)
+			if err != nil {
+				return fmt.Errorf("listing Resource SKUs for %s: %+v", id, err)
+			}
+
+			var quotas map[string]virtualMachineSizeQuota
+			if state.AvailableQuotaRequired {
+				quotas, err = r.listQuotas(ctx, metadata, id)
+				if err != nil {
+					return err
+				}
+			}
+
+			sizes := make([]VirtualMachineSize, 0)
+			for _, sku := range skusResp.Items {
+				if !strings.EqualFold(pointer.From(sku.ResourceType), "virtualMachines") {
+					continue
+				}
+
+				size, ok := flattenVirtualMachineSize(sku, id.LocationName, state.Zone)
+				if !ok || !state.matches(size) {
+					continue
+				}
+
+				if state.AvailableQuotaRequired {
+					familyQuota, ok := quotas[strings.ToLower(size.Family)]
+					if !ok {
+						continue
+					}
+					size.QuotaLimit = familyQuota.limit
+					size.QuotaUsage = familyQuota.usage
+
+					// a single Virtual Machine of this size has to fit into both the quota for the family and for the region
+					if familyQuota.limit-familyQuota.usage < size.VCPUs {
+						continue
+					}
+					if regionalQuota, ok := quotas[virtualMachineSizesRegionalQuotaName]; ok && regionalQuota.limit-regionalQuota.usage < size.VCPUs {
+						continue
+					}
+				}
+
+				sizes = append(sizes, size)
+			}
+
+			sort.Slice(sizes, func(i, j int) bool {
+				return sizes[i].Name < sizes[j].Name
+			})
+			state.Sizes = sizes
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// listQuotas returns the vCPU quota limits and current usages for the location, keyed by the lower-cased quota name,
+// these are named after the family of the Virtual Machine sizes they apply to (e.g. `standardDSv3Family`)
+func (r VirtualMachineSizesDataSource) listQuotas(ctx context.Context, metadata sdk.ResourceMetaData, id virtualmachines.LocationId) (map[string]virtualMachineSizeQuota, error) {
+	quotaClient := metadata.Client.Compute.QuotaInformationClient
+	usagesClient := metadata.Client.Compute.QuotaUsagesInformationClient
+
+	scopeId := commonids.NewScopeID(id.ID())
+	quotas := make(map[string]virtualMachineSizeQuota)
+
+	quotaResp, err := quotaClient.QuotaListComplete(ctx, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("listing quotas for %s: %+v", id, err)
+	}
+	for _, item := range quotaResp.Items {
+		if item.Properties == nil || item.Properties.Name == nil || item.Properties.Name.Value == nil {
+			continue
+		}
+		limit, ok := item.Properties.Limit.(quotainformation.LimitObject)
+		if !ok {
+			continue
+		}
+
+		name := strings.ToLower(*item.Properties.Name.Value)
+		quota := quotas[name]
+		quota.limit = limit.Value
+		quotas[name] = quota
+	}
+
+	usagesResp, err := usagesClient.UsagesListComplete(ctx, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("listing quota usages for %s: %+v", id, err)
+	}
+	for _, item := range usagesResp.Items {
+		if item.Properties == nil || item.Properties.Name == nil || item.Properties.Name.Value == nil || item.Properties.Usages == nil {
+			continue
+		}
+
+		name := strings.ToLower(*item.Properties.Name.Value)
+		quota, ok := quotas[name]
+		if !ok {
+			continue
+		}
+		quota.usage = item.Properties.Usages.Value
+		quotas[name] = quota
+	}
+
+	return quotas, nil
+}
+
+func (m VirtualMachineSizesDataSourceModel) matches(size VirtualMachineSize) bool {
+	if m.MinimumVCPUs > 0 && size.VCPUs < m.MinimumVCPUs {
+		return false
+	}
+	if m.MaximumVCPUs > 0 && size.VCPUs > m.MaximumVCPUs {
+		return false
+	}
+	if m.MinimumMemoryInGB > 0 && size.MemoryInGB < m.MinimumMemoryInGB {
+		return false
+	}
+	if m.MaximumMemoryInGB > 0 && size.MemoryInGB > m.MaximumMemoryInGB {
+		return false
+	}
+	if m.AcceleratedNetworkingEnabled && !size.AcceleratedNetworkingEnabled {
+		return false
+	}
+	if m.PremiumIOEnabled && !size.PremiumIOEnabled {
+		return false
+	}
+
+	return true
+}
+
+// flattenVirtualMachineSize returns the Virtual Machine size for the SKU, and whether it can be deployed into the location
+// (and zone, when specified) by this subscription
+func flattenVirtualMachineSize(input skus.ResourceSku, locationName string, zone string) (VirtualMachineSize, bool) {
+	size := VirtualMachineSize{
+		Name:   pointer.From(input.Name),
+		Family: pointer.From(input.Family),
+		Zones:  make([]string, 0),
+	}
+
+	for _, info := range pointer.From(input.LocationInfo) {
+		if strings.EqualFold(location.Normalize(pointer.From(info.Location)), locationName) {
+			size.Zones = append(size.Zones, pointer.From(info.Zones)...)
+		}
+	}
+
+	for _, restriction := range pointer.From(input.Restrictions) {
+		if restriction.RestrictionInfo == nil {
+			continue
+		}
+
+		switch pointer.From(restriction.Type) {
+		case skus.ResourceSkuRestrictionsTypeLocation:
+			for _, v := range pointer.From(restriction.RestrictionInfo.Locations) {
+				if strings.EqualFold(location.Normalize(v), locationName) {
+					return size, false
+				}
+			}
+		case skus.ResourceSkuRestrictionsTypeZone:
+			restrictedZones := pointer.From(restriction.RestrictionInfo.Zones)
+			availableZones := make([]string, 0)
+			for _, v := range size.Zones {
+				restricted := false
+				for _, restrictedZone := range restrictedZones {
+					if strings.EqualFold(v, restrictedZone) {
+						restricted = true
+					}
+				}
+				if !restricted {
+					availableZones = append(availableZones, v)
+				}
+			}
+			size.Zones = availableZones
+		}
+	}
+	sort.Strings(size.Zones)
+
+	if zone != "" {
+		available := false
+		for _, v := range size.Zones {
+			if strings.EqualFold(v, zone) {
+				available = true
+			}
+		}
+		if !available {
+			return size, false
+		}
+	}
+
+	for _, capability := range pointer.From(input.Capabilities) {
+		if capability.Name == nil || capability.Value == nil {
+			continue
+		}
+
+		switch strings.ToLower(*capability.Name) {
+		case "vcpus":
+			if v, err := strconv.ParseInt(*capability.Value, 10, 64); err == nil {
+				size.VCPUs = v
+			}
+		case "memorygb":
+			if v, err := strconv.ParseFloat(*capability.Value, 64); err == nil {
+				size.MemoryInGB = v
+			}
+		case "acceleratednetworkingenabled":
+			size.AcceleratedNetworkingEnabled = strings.EqualFold(*capability.Value, "True")
+		case "premiumio":
+			size.PremiumIOEnabled = strings.EqualFold(*capability.Value, "True")
+		}
+	}
+
+	return size, true
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualMachineSizesDataSource struct{}
+
+func TestAccVirtualMachineSizesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	d := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.#").Exists(),
+				check.That(data.ResourceName).Key("sizes.0.name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("sizes.0.vcpus").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccVirtualMachineSizesDataSource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_machine_sizes", "test")
+	d := VirtualMachineSizesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sizes.0.vcpus").HasValue("4"),
+				check.That(data.ResourceName).Key("sizes.0.accelerated_networking_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("sizes.0.premium_io_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("sizes.0.quota_limit").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (VirtualMachineSizesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location = %q
+}
+`, data.Locations.Primary)
+}
+
+func (VirtualMachineSizesDataSource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_virtual_machine_sizes" "test" {
+  location                       = %q
+  zone                           = "1"
+  minimum_vcpus                  = 4
+  maximum_vcpus                  = 4
+  minimum_memory_in_gb           = 8
+  accelerated_networking_enabled = true
+  premium_io_enabled             = true
+  available_quota_required       = true
+}
+`, data.Locations.Primary)
+}
@@ -26,6 +26,11 @@ type ContainerConnectedRegistryResource struct{}
 
 var _ sdk.ResourceWithUpdate = ContainerConnectedRegistryResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = ContainerConnectedRegistryResource{}
+
+// connectedRegistryContinuousSyncSchedule is the schedule used when the connected registry is always connected to its parent
+const connectedRegistryContinuousSyncSchedule = "* * * * *"
+
 type ContainerConnectedRegistryModel struct {
 	Name                string                   `tfschema:"name"`
 	ContainerRegistryId string                   `tfschema:"container_registry_id"`
@@ -39,6 +44,8 @@ type ContainerConnectedRegistryModel struct {
 	ClientTokenIds      []string                 `tfschema:"client_token_ids"`
 	LogLevel            string                   `tfschema:"log_level"`
 	AuditLogEnabled     bool                     `tfschema:"audit_log_enabled"`
+	ConnectionState     string                   `tfschema:"connection_state"`
+	LastSyncTime        string                   `tfschema:"last_sync_time"`
 }
 
 type RepositoryNotification struct {
@@ -81,8 +88,8 @@ func (r ContainerConnectedRegistryResource) Arguments() map[string]*pluginsdk.Sc
 		"sync_schedule": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
-			Default:      "* * * * *",
-			ValidateFunc: validation.StringIsNotEmpty,
+			Default:      connectedRegistryContinuousSyncSchedule,
+			ValidateFunc: validate.ConnectedRegistrySyncSchedule,
 		},
 
 		"sync_message_ttl": {
@@ -181,7 +188,17 @@ func (r ContainerConnectedRegistryResource) Arguments() map[string]*pluginsdk.Sc
 }
 
 func (r ContainerConnectedRegistryResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"connection_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_sync_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r ContainerConnectedRegistryResource) ResourceType() string {
@@ -239,7 +256,6 @@ func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
 						SyncProperties: connectedregistries.SyncProperties{
 							TokenId:    model.SyncTokenId,
 							Schedule:   utils.String(model.SyncSchedule),
-							SyncWindow: utils.String(model.SyncWindow),
 							MessageTtl: model.SyncMessageTTL,
 						},
 					},
@@ -252,6 +268,10 @@ func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
 				},
 			}
 
+			if model.SyncWindow != "" {
+				params.Properties.Parent.SyncProperties.SyncWindow = pointer.To(model.SyncWindow)
+			}
+
 			if model.ParentRegistryId != "" {
 				if pid, err := registries.ParseRegistryID(model.ParentRegistryId); err == nil {
 					params.Properties.Parent.Id = utils.String(pid.ID())
@@ -302,11 +322,14 @@ func (r ContainerConnectedRegistryResource) Read() sdk.ResourceFunc {
 				clientTokenIds   []string
 				logLevel         string
 				auditLogEnabled  bool
+				connectionState  string
+				lastSyncTime     string
 			)
 
 			if model := existing.Model; model != nil {
 				if props := model.Properties; props != nil {
 					mode = string(props.Mode)
+					connectionState = string(pointer.From(props.ConnectionState))
 
 					if props.NotificationsList != nil {
 						notificationList = *props.NotificationsList
@@ -342,6 +365,8 @@ func (r ContainerConnectedRegistryResource) Read() sdk.ResourceFunc {
 					if sync.SyncWindow != nil {
 						syncWindow = *sync.SyncWindow
 					}
+
+					lastSyncTime = pointer.From(sync.LastSyncTime)
 				}
 			}
 
@@ -363,6 +388,8 @@ func (r ContainerConnectedRegistryResource) Read() sdk.ResourceFunc {
 				ClientTokenIds:      clientTokenIds,
 				LogLevel:            logLevel,
 				AuditLogEnabled:     auditLogEnabled,
+				ConnectionState:     connectionState,
+				LastSyncTime:        lastSyncTime,
 			}
 
 			return metadata.Encode(&model)
@@ -438,7 +465,7 @@ func (r ContainerConnectedRegistryResource) Update() sdk.ResourceFunc {
 					}
 				}
 
-				sync := props.Parent.SyncProperties
+				sync := &props.Parent.SyncProperties
 				if metadata.ResourceData.HasChange("sync_token_id") {
 					sync.TokenId = state.SyncTokenId
 				}
@@ -449,7 +476,10 @@ func (r ContainerConnectedRegistryResource) Update() sdk.ResourceFunc {
 					sync.MessageTtl = state.SyncMessageTTL
 				}
 				if metadata.ResourceData.HasChange("sync_window") {
-					sync.SyncWindow = &state.SyncWindow
+					sync.SyncWindow = nil
+					if state.SyncWindow != "" {
+						sync.SyncWindow = pointer.To(state.SyncWindow)
+					}
 				}
 			}
 
@@ -462,6 +492,24 @@ func (r ContainerConnectedRegistryResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r ContainerConnectedRegistryResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// a connected registry which isn't continuously synchronised needs to know how long each sync window lasts
+			if schedule := rd.Get("sync_schedule").(string); schedule != "" && schedule != connectedRegistryContinuousSyncSchedule {
+				if rd.NewValueKnown("sync_window") && rd.Get("sync_window").(string) == "" {
+					return fmt.Errorf("`sync_window` must be specified when `sync_schedule` is not %q", connectedRegistryContinuousSyncSchedule)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ContainerConnectedRegistryResource) expandRepoNotifications(input []RepositoryNotification) (*[]string, error) {
 	if len(input) == 0 {
 		return nil, nil
@@ -78,6 +78,46 @@ func TestAccContainerConnectedRegistry_update(t *testing.T) {
 	})
 }
 
+func TestAccContainerConnectedRegistry_syncSchedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_connected_registry", "test")
+	r := ContainerConnectedRegistryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.syncSchedule(data, "0 12 * * *", "PT3H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sync_schedule").HasValue("0 12 * * *"),
+				check.That(data.ResourceName).Key("sync_window").HasValue("PT3H"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.syncSchedule(data, "0 6 * * 1", "PT6H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sync_schedule").HasValue("0 6 * * 1"),
+				check.That(data.ResourceName).Key("sync_window").HasValue("PT6H"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerConnectedRegistry_mirror(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_connected_registry", "test")
 	r := ContainerConnectedRegistryResource{}
@@ -219,6 +259,21 @@ resource "azurerm_container_connected_registry" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ContainerConnectedRegistryResource) syncSchedule(data acceptance.TestData, schedule, window string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_connected_registry" "test" {
+  name                  = "testacccrc%d"
+  container_registry_id = azurerm_container_registry.test.id
+  sync_token_id         = azurerm_container_registry_token.test.id
+  sync_schedule         = "%s"
+  sync_window           = "%s"
+}
+`, template, data.RandomInteger, schedule, window)
+}
+
 func (r ContainerConnectedRegistryResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
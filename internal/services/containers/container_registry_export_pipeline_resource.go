@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/exportpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// exportPipelineTargetTypeAzureStorageBlobContainer isn't defined as a constant in the SDK, since it's not an enum in the API
+const exportPipelineTargetTypeAzureStorageBlobContainer = "AzureStorageBlobContainer"
+
+type ContainerRegistryExportPipelineModel struct {
+	Name                string                                     `tfschema:"name"`
+	ContainerRegistryId string                                     `tfschema:"container_registry_id"`
+	StorageContainerUrl string                                     `tfschema:"storage_container_url"`
+	SasTokenSecretId    string                                     `tfschema:"sas_token_key_vault_secret_id"`
+	Options             []string                                   `tfschema:"options"`
+	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Location            string                                     `tfschema:"location"`
+}
+
+// ContainerRegistryExportPipelineResource manages an Export Pipeline, which transfers artifacts from a Container Registry
+// into a Storage Account, so that they can be imported into another (e.g. air-gapped) Container Registry.
+type ContainerRegistryExportPipelineResource struct{}
+
+var _ sdk.Resource = ContainerRegistryExportPipelineResource{}
+
+func (r ContainerRegistryExportPipelineResource) ResourceType() string {
+	return "azurerm_container_registry_export_pipeline"
+}
+
+func (r ContainerRegistryExportPipelineResource) ModelObject() interface{} {
+	return &ContainerRegistryExportPipelineModel{}
+}
+
+func (r ContainerRegistryExportPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return exportpipelines.ValidateExportPipelineID
+}
+
+func (r ContainerRegistryExportPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"storage_container_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sas_token_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+		},
+
+		"options": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(exportpipelines.PipelineOptionsContinueOnErrors),
+					string(exportpipelines.PipelineOptionsOverwriteBlobs),
+				}, false),
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptionalForceNew(),
+	}
+}
+
+func (r ContainerRegistryExportPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+	}
+}
+
+func (r ContainerRegistryExportPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.ExportPipelines
+			registryClient := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			var model ContainerRegistryExportPipelineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := exportpipelines.NewExportPipelineID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the pipeline is created in the same location as the Container Registry
+			registry, err := registryClient.Get(ctx, *registryId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+			if registry.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", registryId)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			options := make([]exportpipelines.PipelineOptions, 0)
+			for _, v := range model.Options {
+				options = append(options, exportpipelines.PipelineOptions(v))
+			}
+
+			payload := exportpipelines.ExportPipeline{
+				Identity: expandedIdentity,
+				Location: pointer.To(registry.Model.Location),
+				Properties: &exportpipelines.ExportPipelineProperties{
+					Options: &options,
+					Target: exportpipelines.ExportPipelineTargetProperties{
+						KeyVaultUri: model.SasTokenSecretId,
+						Type:        pointer.To(exportPipelineTargetTypeAzureStorageBlobContainer),
+						Uri:         pointer.To(model.StorageContainerUrl),
+					},
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryExportPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.ExportPipelines
+
+			id, err := exportpipelines.ParseExportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContainerRegistryExportPipelineModel{
+				Name:                id.ExportPipelineName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.SasTokenSecretId = props.Target.KeyVaultUri
+					state.StorageContainerUrl = pointer.From(props.Target.Uri)
+
+					options := make([]string, 0)
+					for _, v := range pointer.From(props.Options) {
+						options = append(options, string(v))
+					}
+					state.Options = options
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryExportPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.ExportPipelines
+
+			id, err := exportpipelines.ParseExportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/importpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerRegistryImportPipelineModel struct {
+	Name                 string                                     `tfschema:"name"`
+	ContainerRegistryId  string                                     `tfschema:"container_registry_id"`
+	StorageContainerUrl  string                                     `tfschema:"storage_container_url"`
+	SasTokenSecretId     string                                     `tfschema:"sas_token_key_vault_secret_id"`
+	Options              []string                                   `tfschema:"options"`
+	SourceTriggerEnabled bool                                       `tfschema:"source_trigger_enabled"`
+	Identity             []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	Location             string                                     `tfschema:"location"`
+}
+
+// ContainerRegistryImportPipelineResource manages an Import Pipeline, which imports the artifacts exported by an Export
+// Pipeline from a Storage Account into a Container Registry.
+type ContainerRegistryImportPipelineResource struct{}
+
+var _ sdk.Resource = ContainerRegistryImportPipelineResource{}
+
+func (r ContainerRegistryImportPipelineResource) ResourceType() string {
+	return "azurerm_container_registry_import_pipeline"
+}
+
+func (r ContainerRegistryImportPipelineResource) ModelObject() interface{} {
+	return &ContainerRegistryImportPipelineModel{}
+}
+
+func (r ContainerRegistryImportPipelineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return importpipelines.ValidateImportPipelineID
+}
+
+func (r ContainerRegistryImportPipelineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"storage_container_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"sas_token_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+		},
+
+		"options": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(importpipelines.PipelineOptionsContinueOnErrors),
+					string(importpipelines.PipelineOptionsDeleteSourceBlobOnSuccess),
+					string(importpipelines.PipelineOptionsOverwriteTags),
+				}, false),
+			},
+		},
+
+		"source_trigger_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptionalForceNew(),
+	}
+}
+
+func (r ContainerRegistryImportPipelineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+	}
+}
+
+func (r ContainerRegistryImportPipelineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.ImportPipelines
+			registryClient := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			var model ContainerRegistryImportPipelineModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := importpipelines.NewImportPipelineID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the pipeline is created in the same location as the Container Registry
+			registry, err := registryClient.Get(ctx, *registryId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+			if registry.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", registryId)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAndUserAssignedMapFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			options := make([]importpipelines.PipelineOptions, 0)
+			for _, v := range model.Options {
+				options = append(options, importpipelines.PipelineOptions(v))
+			}
+
+			// when the source trigger is enabled, blobs added to the Storage Container are imported automatically
+			triggerStatus := importpipelines.TriggerStatusDisabled
+			if model.SourceTriggerEnabled {
+				triggerStatus = importpipelines.TriggerStatusEnabled
+			}
+
+			payload := importpipelines.ImportPipeline{
+				Identity: expandedIdentity,
+				Location: pointer.To(registry.Model.Location),
+				Properties: &importpipelines.ImportPipelineProperties{
+					Options: &options,
+					Source: importpipelines.ImportPipelineSourceProperties{
+						KeyVaultUri: model.SasTokenSecretId,
+						Type:        pointer.To(importpipelines.PipelineSourceTypeAzureStorageBlobContainer),
+						Uri:         pointer.To(model.StorageContainerUrl),
+					},
+					Trigger: &importpipelines.PipelineTriggerProperties{
+						SourceTrigger: &importpipelines.PipelineSourceTriggerProperties{
+							Status: triggerStatus,
+						},
+					},
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryImportPipelineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.ImportPipelines
+
+			id, err := importpipelines.ParseImportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContainerRegistryImportPipelineModel{
+				Name:                id.ImportPipelineName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMapToModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				state.Identity = pointer.From(flattenedIdentity)
+
+				if props := model.Properties; props != nil {
+					state.SasTokenSecretId = props.Source.KeyVaultUri
+					state.StorageContainerUrl = pointer.From(props.Source.Uri)
+
+					if trigger := props.Trigger; trigger != nil && trigger.SourceTrigger != nil {
+						state.SourceTriggerEnabled = trigger.SourceTrigger.Status == importpipelines.TriggerStatusEnabled
+					}
+
+					options := make([]string, 0)
+					for _, v := range pointer.From(props.Options) {
+						options = append(options, string(v))
+					}
+					state.Options = options
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryImportPipelineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.ImportPipelines
+
+			id, err := importpipelines.ParseImportPipelineID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/exportpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/importpipelines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/pipelineruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerRegistryPipelineRunModel struct {
+	Name                string   `tfschema:"name"`
+	ContainerRegistryId string   `tfschema:"container_registry_id"`
+	PipelineId          string   `tfschema:"pipeline_id"`
+	BlobName            string   `tfschema:"blob_name"`
+	Artifacts           []string `tfschema:"artifacts"`
+	CatalogDigest       string   `tfschema:"catalog_digest"`
+	ForceUpdateTag      string   `tfschema:"force_update_tag"`
+	Status              string   `tfschema:"status"`
+	ErrorMessage        string   `tfschema:"error_message"`
+	ImportedArtifacts   []string `tfschema:"imported_artifacts"`
+	StartTime           string   `tfschema:"start_time"`
+	FinishTime          string   `tfschema:"finish_time"`
+}
+
+// ContainerRegistryPipelineRunResource runs an Export Pipeline or an Import Pipeline when created, and again whenever
+// the `force_update_tag` changes.
+type ContainerRegistryPipelineRunResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryPipelineRunResource{}
+
+func (r ContainerRegistryPipelineRunResource) ResourceType() string {
+	return "azurerm_container_registry_pipeline_run"
+}
+
+func (r ContainerRegistryPipelineRunResource) ModelObject() interface{} {
+	return &ContainerRegistryPipelineRunModel{}
+}
+
+func (r ContainerRegistryPipelineRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pipelineruns.ValidatePipelineRunID
+}
+
+func (r ContainerRegistryPipelineRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerRegistryPipelineName,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"pipeline_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.Any(exportpipelines.ValidateExportPipelineID, importpipelines.ValidateImportPipelineID),
+		},
+
+		"blob_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"artifacts": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"catalog_digest": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"force_update_tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContainerRegistryPipelineRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"imported_artifacts": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"finish_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerRegistryPipelineRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.PipelineRuns
+
+			var model ContainerRegistryPipelineRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := pipelineruns.NewPipelineRunID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandContainerRegistryPipelineRun(*registryId, model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryPipelineRunResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryPipelineRunModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// only `force_update_tag` can change - sending the same request with a new tag runs the pipeline again
+			payload, err := expandContainerRegistryPipelineRun(registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName), model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryPipelineRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContainerRegistryPipelineRunModel{
+				Name:                id.PipelineRunName,
+				ContainerRegistryId: registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.ForceUpdateTag = pointer.From(props.ForceUpdateTag)
+
+				if request := props.Request; request != nil {
+					state.Artifacts = pointer.From(request.Artifacts)
+					state.CatalogDigest = pointer.From(request.CatalogDigest)
+
+					if pipelineId := pointer.From(request.PipelineResourceId); pipelineId != "" {
+						if exportPipelineId, err := exportpipelines.ParseExportPipelineIDInsensitively(pipelineId); err == nil {
+							state.PipelineId = exportPipelineId.ID()
+						} else if importPipelineId, err := importpipelines.ParseImportPipelineIDInsensitively(pipelineId); err == nil {
+							state.PipelineId = importPipelineId.ID()
+						} else {
+							return fmt.Errorf("parsing the Pipeline ID %q: %+v", pipelineId, err)
+						}
+					}
+
+					if request.Source != nil {
+						state.BlobName = pointer.From(request.Source.Name)
+					}
+					if request.Target != nil {
+						state.BlobName = pointer.From(request.Target.Name)
+					}
+				}
+
+				if resp := props.Response; resp != nil {
+					state.Status = pointer.From(resp.Status)
+					state.ErrorMessage = pointer.From(resp.PipelineRunErrorMessage)
+					state.ImportedArtifacts = pointer.From(resp.ImportedArtifacts)
+					state.StartTime = pointer.From(resp.StartTime)
+					state.FinishTime = pointer.From(resp.FinishTime)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryPipelineRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.PipelineRuns
+
+			id, err := pipelineruns.ParsePipelineRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryPipelineRun(registryId registries.RegistryId, input ContainerRegistryPipelineRunModel) (*pipelineruns.PipelineRun, error) {
+	request := pipelineruns.PipelineRunRequest{}
+
+	// the pipeline has to belong to the Container Registry the run is created in
+	if exportPipelineId, err := exportpipelines.ParseExportPipelineID(input.PipelineId); err == nil {
+		if !strings.EqualFold(registries.NewRegistryID(exportPipelineId.SubscriptionId, exportPipelineId.ResourceGroupName, exportPipelineId.RegistryName).ID(), registryId.ID()) {
+			return nil, fmt.Errorf("the Export Pipeline %q must belong to %s", input.PipelineId, registryId)
+		}
+		if len(input.Artifacts) == 0 {
+			return nil, fmt.Errorf("`artifacts` must be specified when running an Export Pipeline")
+		}
+
+		request.PipelineResourceId = pointer.To(exportPipelineId.ID())
+		request.Artifacts = pointer.To(input.Artifacts)
+		request.Target = &pipelineruns.PipelineRunTargetProperties{
+			Name: pointer.To(input.BlobName),
+			Type: pointer.To(pipelineruns.PipelineRunTargetTypeAzureStorageBlob),
+		}
+	} else {
+		importPipelineId, err := importpipelines.ParseImportPipelineID(input.PipelineId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `pipeline_id`: %+v", err)
+		}
+		if !strings.EqualFold(registries.NewRegistryID(importPipelineId.SubscriptionId, importPipelineId.ResourceGroupName, importPipelineId.RegistryName).ID(), registryId.ID()) {
+			return nil, fmt.Errorf("the Import Pipeline %q must belong to %s", input.PipelineId, registryId)
+		}
+		if len(input.Artifacts) > 0 {
+			return nil, fmt.Errorf("`artifacts` can only be specified when running an Export Pipeline")
+		}
+
+		request.PipelineResourceId = pointer.To(importPipelineId.ID())
+		request.Source = &pipelineruns.PipelineRunSourceProperties{
+			Name: pointer.To(input.BlobName),
+			Type: pointer.To(pipelineruns.PipelineRunSourceTypeAzureStorageBlob),
+		}
+	}
+
+	if input.CatalogDigest != "" {
+		request.CatalogDigest = pointer.To(input.CatalogDigest)
+	}
+
+	output := pipelineruns.PipelineRun{
+		Properties: &pipelineruns.PipelineRunProperties{
+			Request: &request,
+		},
+	}
+	if input.ForceUpdateTag != "" {
+		output.Properties.ForceUpdateTag = pointer.To(input.ForceUpdateTag)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/pipelineruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryPipelineRunResource struct {
+	githubRepo
+}
+
+// the Container Registry needs to contain an artifact to export, which is built from the GitHub repository used by the
+// Container Registry Task tests
+func TestAccContainerRegistryPipelineRun_exportAndImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_pipeline_run", "export")
+
+	preCheckGithubRepo(t)
+
+	r := ContainerRegistryPipelineRunResource{
+		githubRepo: githubRepo{
+			url:   os.Getenv("ARM_TEST_ACR_TASK_GITHUB_REPO_URL"),
+			token: os.Getenv("ARM_TEST_ACR_TASK_GITHUB_USER_TOKEN"),
+		},
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.export(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.export(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.exportAndImport(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_container_registry_pipeline_run.import").ExistsInAzure(r),
+				check.That("azurerm_container_registry_pipeline_run.import").Key("status").HasValue("Succeeded"),
+				check.That("azurerm_container_registry_pipeline_run.import").Key("imported_artifacts.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerRegistryPipelineRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pipelineruns.ParsePipelineRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient_v2021_08_01_preview.PipelineRuns.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerRegistryPipelineRunResource) export(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_pipeline_run" "export" {
+  name                  = "acctestexportrun%d"
+  container_registry_id = azurerm_container_registry.test.id
+  pipeline_id           = azurerm_container_registry_export_pipeline.test.id
+  blob_name             = "acctest-export"
+  artifacts             = ["helloworld:v1"]
+  force_update_tag      = "%s"
+
+  depends_on = [azurerm_container_registry_task_schedule_run_now.test]
+}
+`, r.template(data), data.RandomInteger, tag)
+}
+
+func (r ContainerRegistryPipelineRunResource) exportAndImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_import_pipeline" "test" {
+  name                          = "acctestimport%[2]d"
+  container_registry_id         = azurerm_container_registry.test.id
+  storage_container_url         = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token_key_vault_secret_id = azurerm_key_vault_secret.test.versionless_id
+  source_trigger_enabled        = false
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+
+resource "azurerm_container_registry_pipeline_run" "import" {
+  name                  = "acctestimportrun%[2]d"
+  container_registry_id = azurerm_container_registry.test.id
+  pipeline_id           = azurerm_container_registry_import_pipeline.test.id
+  blob_name             = azurerm_container_registry_pipeline_run.export.blob_name
+}
+`, r.export(data, "second"), data.RandomInteger)
+}
+
+func (r ContainerRegistryPipelineRunResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  platform {
+    os = "Linux"
+  }
+  docker_step {
+    dockerfile_path      = "Dockerfile"
+    context_path         = "%s"
+    context_access_token = "%s"
+    image_names          = ["helloworld:v1"]
+  }
+}
+
+resource "azurerm_container_registry_task_schedule_run_now" "test" {
+  container_registry_task_id = azurerm_container_registry_task.test.id
+}
+
+resource "azurerm_container_registry_export_pipeline" "test" {
+  name                          = "acctestexport%d"
+  container_registry_id         = azurerm_container_registry.test.id
+  storage_container_url         = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  sas_token_key_vault_secret_id = azurerm_key_vault_secret.test.versionless_id
+  options                       = ["OverwriteBlobs"]
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+`, ContainerRegistryPipelineTemplate(data), data.RandomInteger, r.url, r.token, data.RandomInteger)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/agentpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -319,7 +320,7 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 					"task_content": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringIsNotEmpty,
+						ValidateFunc: validate.ContainerRegistryTaskContent,
 						StateFunc:    userDataStateFunc,
 					},
 					"value_content": {
@@ -720,6 +721,9 @@ func (r ContainerRegistryTaskResource) Create() sdk.ResourceFunc {
 			}
 
 			if model.AgentPoolName != "" {
+				if err := checkContainerRegistryAgentPoolExists(ctx, metadata, *registryId, model.AgentPoolName); err != nil {
+					return err
+				}
 				params.Properties.AgentPoolName = &model.AgentPoolName
 			}
 			if model.LogTemplate != "" {
@@ -926,8 +930,14 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("agent_setting") {
 				existing.Model.Properties.AgentConfiguration = expandRegistryTaskAgentProperties(model.AgentConfig)
 			}
-			if metadata.ResourceData.HasChange("agent_pool_name") && model.AgentPoolName != "" {
-				existing.Model.Properties.AgentPoolName = &model.AgentPoolName
+			if metadata.ResourceData.HasChange("agent_pool_name") {
+				existing.Model.Properties.AgentPoolName = nil
+				if model.AgentPoolName != "" {
+					if err := checkContainerRegistryAgentPoolExists(ctx, metadata, registries.NewRegistryID(id.SubscriptionId, id.ResourceGroupName, id.RegistryName), model.AgentPoolName); err != nil {
+						return err
+					}
+					existing.Model.Properties.AgentPoolName = &model.AgentPoolName
+				}
 			}
 			if metadata.ResourceData.HasChange("enabled") {
 				status := tasks.TaskStatusDisabled
@@ -957,6 +967,23 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 	}
 }
 
+// checkContainerRegistryAgentPoolExists ensures the Agent Pool referenced by name exists within the Container Registry,
+// since otherwise the API accepts the task but fails when it's run
+func checkContainerRegistryAgentPoolExists(ctx context.Context, metadata sdk.ResourceMetaData, registryId registries.RegistryId, name string) error {
+	client := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.AgentPools
+
+	agentPoolId := agentpools.NewAgentPoolID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, name)
+	resp, err := client.Get(ctx, agentPoolId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("the Agent Pool %q specified in `agent_pool_name` was not found in %s", name, registryId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", agentPoolId, err)
+	}
+
+	return nil
+}
+
 func expandRegistryTaskTrigger(model ContainerRegistryTaskModel) *tasks.TriggerProperties {
 	baseImageTrigger := expandRegistryTaskBaseImageTrigger(model.BaseImageTrigger)
 	sourceTriggers := expandRegistryTaskSourceTriggers(model.SourceTrigger)
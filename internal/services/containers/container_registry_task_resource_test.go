@@ -155,6 +155,51 @@ func TestAccContainerRegistryTask_encodedTaskStep(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistryTask_encodedTaskStepMultiStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encodedTaskStepMultiStep(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryTask_agentPool(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+	r := ContainerRegistryTaskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.agentPool(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.agentPool(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agent_pool_name").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.agentPool(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccContainerRegistryTask_dockerStepBaseImageTrigger(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
 
@@ -654,6 +699,82 @@ EOF
 `, template, data.RandomInteger, r.githubRepo.url, r.githubRepo.token)
 }
 
+func (r ContainerRegistryTaskResource) encodedTaskStepMultiStep(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  platform {
+    os = "Linux"
+  }
+  encoded_step {
+    task_content = <<EOF
+version: v1.1.0
+steps:
+  - id: first
+    cmd: mcr.microsoft.com/hello-world
+  - id: second
+    cmd: mcr.microsoft.com/hello-world
+EOF
+  }
+  timer_trigger {
+    name     = "daily"
+    schedule = "0 21 * * *"
+  }
+}
+`, template, data.RandomInteger)
+}
+
+func (r ContainerRegistryTaskResource) agentPool(data acceptance.TestData, useAgentPool bool) string {
+	agentPoolName := ""
+	if useAgentPool {
+		agentPoolName = "agent_pool_name       = azurerm_container_registry_agent_pool.test.name"
+	}
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ACRTask-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccrtask%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+
+resource "azurerm_container_registry_agent_pool" "test" {
+  name                    = "ap%[3]d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  container_registry_name = azurerm_container_registry.test.name
+}
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%[1]d"
+  container_registry_id = azurerm_container_registry.test.id
+  %[4]s
+  platform {
+    os = "Linux"
+  }
+  encoded_step {
+    task_content = <<EOF
+version: v1.1.0
+steps:
+  - cmd: mcr.microsoft.com/hello-world
+EOF
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(15), agentPoolName)
+}
+
 func (r ContainerRegistryTaskResource) dockerStepBaseImageTrigger(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
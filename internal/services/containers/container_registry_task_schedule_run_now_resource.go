@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/runs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
@@ -24,7 +25,13 @@ type ContainerRegistryTaskScheduleResource struct{}
 var _ sdk.Resource = ContainerRegistryTaskScheduleResource{}
 
 type ContainerRegistryTaskScheduleModel struct {
-	TaskId string `tfschema:"container_registry_task_id"`
+	TaskId       string `tfschema:"container_registry_task_id"`
+	RunId        string `tfschema:"run_id"`
+	Status       string `tfschema:"status"`
+	ErrorMessage string `tfschema:"error_message"`
+	StartTime    string `tfschema:"start_time"`
+	FinishTime   string `tfschema:"finish_time"`
+	LogUrl       string `tfschema:"log_url"`
 }
 
 func (r ContainerRegistryTaskScheduleResource) Arguments() map[string]*pluginsdk.Schema {
@@ -39,7 +46,38 @@ func (r ContainerRegistryTaskScheduleResource) Arguments() map[string]*pluginsdk
 }
 
 func (r ContainerRegistryTaskScheduleResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"run_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"finish_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"log_url": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
 }
 
 func (r ContainerRegistryTaskScheduleResource) ResourceType() string {
@@ -142,11 +180,18 @@ func (r ContainerRegistryTaskScheduleResource) Create() sdk.ResourceFunc {
 				Timeout:                   time.Until(timeout),
 			}
 			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				// surface where the logs of the failed run can be found, since the run isn't tracked in the state
+				if logResp, logErr := runsClient.GetLogSasUrl(ctx, runId); logErr == nil && logResp.Model != nil && logResp.Model.LogLink != nil {
+					return fmt.Errorf("waiting for scheduled task to finish: %+v\n\nThe logs of %s can be downloaded from: %s", err, runId, *logResp.Model.LogLink)
+				}
 				return fmt.Errorf("waiting for scheduled task to finish: %+v", err)
 			}
 
 			metadata.SetID(parse.NewContainerRegistryTaskScheduleID(taskId.SubscriptionId, taskId.ResourceGroupName, taskId.RegistryName, taskId.TaskName, "schedule"))
-			return nil
+
+			// the ID doesn't contain the run, so it's tracked in the state for retrieving its details and logs
+			model.RunId = runName
+			return metadata.Encode(&model)
 		},
 	}
 }
@@ -160,7 +205,49 @@ func (r ContainerRegistryTaskScheduleResource) Read() sdk.ResourceFunc {
 			if err != nil {
 				return err
 			}
-			model := ContainerRegistryTaskScheduleModel{TaskId: tasks.NewTaskID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TaskName).ID()}
+			var state ContainerRegistryTaskScheduleModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			model := ContainerRegistryTaskScheduleModel{
+				TaskId: tasks.NewTaskID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.TaskName).ID(),
+				RunId:  state.RunId,
+			}
+
+			// runs scheduled before `run_id` was tracked (or imported resources) have no details to retrieve
+			if model.RunId != "" {
+				runsClient := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Runs
+				runId := runs.NewRunID(id.SubscriptionId, id.ResourceGroup, id.RegistryName, model.RunId)
+
+				resp, err := runsClient.Get(ctx, runId)
+				if err != nil {
+					if !response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("retrieving %s: %+v", runId, err)
+					}
+					// the run history is purged after a while, in which case the last known details are kept
+					return metadata.Encode(&state)
+				}
+
+				if resp.Model != nil && resp.Model.Properties != nil {
+					props := resp.Model.Properties
+					if props.Status != nil {
+						model.Status = string(*props.Status)
+					}
+					model.ErrorMessage = pointer.From(props.RunErrorMessage)
+					model.StartTime = pointer.From(props.StartTime)
+					model.FinishTime = pointer.From(props.FinishTime)
+				}
+
+				logResp, err := runsClient.GetLogSasUrl(ctx, runId)
+				if err != nil {
+					return fmt.Errorf("retrieving the log URL for %s: %+v", runId, err)
+				}
+				if logResp.Model != nil {
+					model.LogUrl = pointer.From(logResp.Model.LogLink)
+				}
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -18,6 +19,8 @@ type ContainerRegistryTaskScheduleResource struct {
 	githubRepo
 }
 
+var runDetailAttributes = []string{"run_id", "status", "error_message", "start_time", "finish_time", "log_url"}
+
 func TestAccContainerRegistryTaskSchedule_basic(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_task_schedule_run_now", "test")
 
@@ -33,16 +36,22 @@ func TestAccContainerRegistryTaskSchedule_basic(t *testing.T) {
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
 			Config: r.basic(data, r.dockerTaskStep),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("run_id").IsSet(),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("log_url").IsSet(),
+			),
 		},
-		data.ImportStep(),
+		// the run isn't part of the ID, as such its details can't be imported
+		data.ImportStep(runDetailAttributes...),
 		{
 			Config: r.basic(data, r.fileTaskStep),
 		},
-		data.ImportStep(),
+		data.ImportStep(runDetailAttributes...),
 		{
 			Config: r.basic(data, r.encodedTaskStep),
 		},
-		data.ImportStep(),
+		data.ImportStep(runDetailAttributes...),
 	})
 }
 
@@ -195,3 +195,31 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigAADWithExecCredential(t *testing.T) {
+	encodedConfig := LoadConfig("user_with_exec.yml")
+	if len(encodedConfig) == 0 {
+		t.Fatalf("Failed to read config from file 'user_with_exec.yml'")
+	}
+
+	// an exec credential contains no token or client certificate
+	if _, err := ParseKubeConfig(encodedConfig); err == nil {
+		t.Fatalf("expected parsing the exec credential config as a static config to fail but it didn't")
+	}
+
+	result, err := ParseKubeConfigAAD(encodedConfig)
+	if err != nil {
+		t.Fatalf("parsing exec credential config: %+v", err)
+	}
+
+	expected := cluster{
+		ClusterAuthorityData: "test-cluster-authority-data",
+		Server:               "https://testcluster.org:443",
+	}
+	if !reflect.DeepEqual(expected, result.Clusters[0].Cluster) {
+		t.Fatalf("expected cluster '%+v' but got '%+v'", expected, result.Clusters[0].Cluster)
+	}
+	if result.Users[0].Name != "test-user" {
+		t.Fatalf("expected user 'test-user' but got '%s'", result.Users[0].Name)
+	}
+}
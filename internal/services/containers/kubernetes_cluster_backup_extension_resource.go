@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	backupExtensionType             = "Microsoft.DataProtection.Kubernetes"
+	backupExtensionReleaseNamespace = "dataprotection-microsoft"
+
+	backupExtensionSettingBlobContainer  = "configuration.backupStorageLocation.bucket"
+	backupExtensionSettingResourceGroup  = "configuration.backupStorageLocation.config.resourceGroup"
+	backupExtensionSettingStorageAccount = "configuration.backupStorageLocation.config.storageAccount"
+	backupExtensionSettingSubscriptionId = "configuration.backupStorageLocation.config.subscriptionId"
+	backupExtensionSettingTenantId       = "credentials.tenantId"
+)
+
+type KubernetesClusterBackupExtensionModel struct {
+	Name                string `tfschema:"name"`
+	KubernetesClusterId string `tfschema:"kubernetes_cluster_id"`
+	StorageAccountId    string `tfschema:"storage_account_id"`
+	BlobContainerName   string `tfschema:"blob_container_name"`
+	ReleaseTrain        string `tfschema:"release_train"`
+	CurrentVersion      string `tfschema:"current_version"`
+}
+
+// KubernetesClusterBackupExtensionResource installs the Backup Extension (used by Azure Backup for AKS) into a
+// Kubernetes Cluster, storing the backups in a Blob Container within the specified Storage Account.
+type KubernetesClusterBackupExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterBackupExtensionResource{}
+
+func (r KubernetesClusterBackupExtensionResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_backup_extension"
+}
+
+func (r KubernetesClusterBackupExtensionResource) ModelObject() interface{} {
+	return &KubernetesClusterBackupExtensionModel{}
+}
+
+func (r KubernetesClusterBackupExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return KubernetesClusterExtensionResource{}.IDValidationFunc()
+}
+
+func (r KubernetesClusterBackupExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"blob_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  "azure-aks-backup",
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9-.]{0,252}$"),
+				"name must be between 1 and 253 characters in length and may contain only letters, numbers, periods (.), hyphens (-), and must begin with a letter or number.",
+			),
+		},
+
+		"release_train": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "stable",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesClusterBackupExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"aks_assigned_identity": commonschema.SystemAssignedIdentityComputed(),
+
+		"current_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterBackupExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KubernetesClusterBackupExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+			clusterId, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			storageAccountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := extensions.NewScopedExtensionID(clusterId.ID(), model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := extensions.Extension{
+				Properties: &extensions.ExtensionProperties{
+					AutoUpgradeMinorVersion: pointer.To(true),
+					ConfigurationSettings:   pointer.To(expandBackupExtensionConfigurationSettings(*storageAccountId, model.BlobContainerName, metadata.Client.Account.TenantId)),
+					ExtensionType:           pointer.To(backupExtensionType),
+					ReleaseTrain:            pointer.To(model.ReleaseTrain),
+					Scope: &extensions.Scope{
+						Cluster: &extensions.ScopeCluster{
+							ReleaseNamespace: pointer.To(backupExtensionReleaseNamespace),
+						},
+					},
+				},
+			}
+
+			if err = client.CreateThenPoll(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterBackupExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterBackupExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := extensions.PatchExtension{
+				Properties: &extensions.PatchExtensionProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("storage_account_id", "blob_container_name") {
+				storageAccountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+				if err != nil {
+					return err
+				}
+				properties.Properties.ConfigurationSettings = pointer.To(expandBackupExtensionConfigurationSettings(*storageAccountId, model.BlobContainerName, metadata.Client.Account.TenantId))
+			}
+
+			if metadata.ResourceData.HasChange("release_train") {
+				properties.Properties.ReleaseTrain = pointer.To(model.ReleaseTrain)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterBackupExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			kubernetesClusterId, err := commonids.ParseKubernetesClusterID(id.Scope)
+			if err != nil {
+				return fmt.Errorf("parsing %q as a Kubernetes Cluster ID: %+v", id.Scope, err)
+			}
+
+			state := KubernetesClusterBackupExtensionModel{
+				Name:                id.ExtensionName,
+				KubernetesClusterId: kubernetesClusterId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if properties := model.Properties; properties != nil {
+					if extensionType := pointer.From(properties.ExtensionType); !strings.EqualFold(extensionType, backupExtensionType) {
+						return fmt.Errorf("%s is of the type %q rather than %q, use `azurerm_kubernetes_cluster_extension` instead", id, extensionType, backupExtensionType)
+					}
+
+					if err = metadata.ResourceData.Set("aks_assigned_identity", flattenAksAssignedIdentity(properties.AksAssignedIdentity)); err != nil {
+						return fmt.Errorf("setting `aks_assigned_identity`: %+v", err)
+					}
+
+					settings := pointer.From(properties.ConfigurationSettings)
+					state.BlobContainerName = settings[backupExtensionSettingBlobContainer]
+					if settings[backupExtensionSettingStorageAccount] != "" {
+						state.StorageAccountId = commonids.NewStorageAccountID(settings[backupExtensionSettingSubscriptionId], settings[backupExtensionSettingResourceGroup], settings[backupExtensionSettingStorageAccount]).ID()
+					}
+
+					state.CurrentVersion = pointer.From(properties.CurrentVersion)
+					state.ReleaseTrain = pointer.From(properties.ReleaseTrain)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterBackupExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, extensions.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandBackupExtensionConfigurationSettings(storageAccountId commonids.StorageAccountId, blobContainerName string, tenantId string) map[string]string {
+	return map[string]string{
+		backupExtensionSettingBlobContainer:  blobContainerName,
+		backupExtensionSettingResourceGroup:  storageAccountId.ResourceGroupName,
+		backupExtensionSettingStorageAccount: storageAccountId.StorageAccountName,
+		backupExtensionSettingSubscriptionId: storageAccountId.SubscriptionId,
+		backupExtensionSettingTenantId:       tenantId,
+	}
+}
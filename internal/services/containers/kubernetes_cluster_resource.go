@@ -124,6 +124,19 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("custom_ca_trust_certificates_base64", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Get("kube_config_exec_credential_enabled").(bool) && len(d.Get("azure_active_directory_role_based_access_control").([]interface{})) == 0 {
+					return fmt.Errorf("`kube_config_exec_credential_enabled` can only be set when `azure_active_directory_role_based_access_control` is configured")
+				}
+				if d.HasChange("kube_config_exec_credential_enabled") {
+					for _, key := range []string{"kube_config", "kube_config_raw", "kube_admin_config", "kube_admin_config_raw"} {
+						if err := d.SetNewComputed(key); err != nil {
+							return err
+						}
+					}
+				}
+				return nil
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -564,6 +577,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"kube_config_exec_credential_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"kubelet_identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -2815,7 +2834,14 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	credentials, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.ListClusterUserCredentialsOperationOptions{})
+	// when exec credentials are enabled the kubeconfig uses kubelogin to obtain a token from Entra ID at runtime, rather
+	// than embedding a token or client certificate, so no static credentials are persisted into the state
+	execCredentialEnabled := d.Get("kube_config_exec_credential_enabled").(bool)
+	credentialsOptions := managedclusters.ListClusterUserCredentialsOperationOptions{}
+	if execCredentialEnabled {
+		credentialsOptions.Format = pointer.To(managedclusters.FormatExec)
+	}
+	credentials, err := client.ListClusterUserCredentials(ctx, *id, credentialsOptions)
 	if err != nil {
 		return fmt.Errorf("retrieving User Credentials for %s: %+v", id, err)
 	}
@@ -2825,6 +2851,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("name", id.ManagedClusterName)
 	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("kube_config_exec_credential_enabled", execCredentialEnabled)
 
 	if model := resp.Model; model != nil {
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
@@ -3069,10 +3096,11 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `key_management_service`: %+v", err)
 			}
 
-			// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled
+			// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled, the admin
+			// credentials contain a client certificate so these are omitted when exec credentials are enabled
 			var adminKubeConfigRaw *string
 			adminKubeConfig := make([]interface{}, 0)
-			if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
+			if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) && !execCredentialEnabled {
 				adminCredentials, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.ListClusterAdminCredentialsOperationOptions{})
 				if err != nil {
 					return fmt.Errorf("retrieving Admin Credentials for %s: %+v", id, err)
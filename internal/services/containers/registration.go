@@ -70,10 +70,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	resources := []sdk.Resource{
 		ContainerRegistryCacheRule{},
+		ContainerRegistryExportPipelineResource{},
+		ContainerRegistryImportPipelineResource{},
+		ContainerRegistryPipelineRunResource{},
 		ContainerRegistryTaskResource{},
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		ContainerConnectedRegistryResource{},
+		KubernetesClusterBackupExtensionResource{},
 		KubernetesClusterExtensionResource{},
 		KubernetesFluxConfigurationResource{},
 		KubernetesFleetManagerResource{},
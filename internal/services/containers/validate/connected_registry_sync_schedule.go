@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ConnectedRegistrySyncSchedule validates that the value is a cron expression made up of five fields
+// (minute, hour, day of month, month and day of week)
+func ConnectedRegistrySyncSchedule(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) != 5 {
+		errors = append(errors, fmt.Errorf("%q must be a cron expression with 5 fields, got %d in %q", k, len(fields), value))
+		return
+	}
+
+	fieldRegex := regexp.MustCompile(`^(\*|[0-9]+(-[0-9]+)?)(/[0-9]+)?(,(\*|[0-9]+(-[0-9]+)?)(/[0-9]+)?)*$`)
+	for i, field := range fields {
+		if !fieldRegex.MatchString(field) {
+			errors = append(errors, fmt.Errorf("field %d (%q) of %q is not a valid cron field", i+1, field, k))
+		}
+	}
+
+	return
+}
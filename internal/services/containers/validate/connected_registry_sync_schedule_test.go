@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestConnectedRegistrySyncSchedule(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "* * * * *",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0 9 * * 1-5",
+			ErrCount: 0,
+		},
+		{
+			Value:    "*/15 0,12 1 */2 *",
+			ErrCount: 0,
+		},
+		{
+			Value:    "* * * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0 0 * * * *",
+			ErrCount: 1,
+		},
+		{
+			Value:    "0 noon * * *",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ConnectedRegistrySyncSchedule(tc.Value, "sync_schedule")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("expected %d errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
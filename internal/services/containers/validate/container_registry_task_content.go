@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// containerRegistryTaskStepTypes are the keys, exactly one of which has to be specified for each step of a multi-step task
+var containerRegistryTaskStepTypes = []string{"build", "cmd", "push"}
+
+var dockerfileFromRegex = regexp.MustCompile(`(?im)^\s*FROM\s+\S+`)
+
+// ContainerRegistryTaskContent validates the (optionally base64 encoded) content of an encoded task step, which is
+// either a multi-step task YAML definition or a Dockerfile.
+func ContainerRegistryTaskContent(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	content := value
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+		content = string(decoded)
+	}
+
+	// task definitions are rendered as Go templates (e.g. `{{.Run.Registry}}`) by ACR before being parsed, which isn't
+	// necessarily valid YAML beforehand, so the structure can only be validated for content without template actions
+	if strings.Contains(content, "{{") {
+		return
+	}
+
+	var task interface{}
+	if err := yaml.Unmarshal([]byte(content), &task); err != nil {
+		if dockerfileFromRegex.MatchString(content) {
+			return
+		}
+		errors = append(errors, fmt.Errorf("parsing %q as a task YAML definition: %+v", k, err))
+		return
+	}
+
+	definition, ok := task.(map[string]interface{})
+	if !ok {
+		if !dockerfileFromRegex.MatchString(content) {
+			errors = append(errors, fmt.Errorf("%q must be either a task YAML definition or a Dockerfile", k))
+		}
+		return
+	}
+
+	if version, ok := definition["version"]; ok {
+		if _, ok := version.(string); !ok {
+			errors = append(errors, fmt.Errorf("`version` in %q must be a string", k))
+		}
+	}
+
+	rawSteps, ok := definition["steps"]
+	if !ok {
+		errors = append(errors, fmt.Errorf("%q must contain `steps`", k))
+		return
+	}
+
+	steps, ok := rawSteps.([]interface{})
+	if !ok || len(steps) == 0 {
+		errors = append(errors, fmt.Errorf("`steps` in %q must be a list containing at least one step", k))
+		return
+	}
+
+	for i, rawStep := range steps {
+		step, ok := rawStep.(map[string]interface{})
+		if !ok {
+			errors = append(errors, fmt.Errorf("step %d in %q must be a map", i, k))
+			continue
+		}
+
+		stepTypes := make([]string, 0)
+		for _, stepType := range containerRegistryTaskStepTypes {
+			if _, ok := step[stepType]; ok {
+				stepTypes = append(stepTypes, stepType)
+			}
+		}
+		if len(stepTypes) != 1 {
+			errors = append(errors, fmt.Errorf("step %d in %q must specify exactly one of `%s`, got %d", i, k, strings.Join(containerRegistryTaskStepTypes, "`, `"), len(stepTypes)))
+		}
+	}
+
+	return
+}
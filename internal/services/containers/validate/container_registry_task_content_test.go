@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+func TestContainerRegistryTaskContent(t *testing.T) {
+	multiStep := `version: v1.1.0
+steps:
+  - build: -t $Registry/hello-world:$ID .
+  - push:
+    - $Registry/hello-world:$ID
+  - cmd: $Registry/hello-world:$ID
+`
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    multiStep,
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString([]byte(multiStep)),
+			ErrCount: 0,
+		},
+		{
+			// a multi-step task using Go template actions
+			Value:    "steps:\n  - build: -t {{.Run.Registry}}/hello-world:{{.Run.ID}} .\n  - push: [\"{{.Run.Registry}}/hello-world:{{.Run.ID}}\"]\n",
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString([]byte("steps:\n  - build: -t {{.Run.Registry}}/hello-world:{{.Run.ID}} .\n")),
+			ErrCount: 0,
+		},
+		{
+			// a Dockerfile
+			Value: `FROM node:15-alpine
+
+COPY . /src
+RUN cd /src && npm install
+EXPOSE 80
+CMD ["node", "/src/server.js"]
+`,
+			ErrCount: 0,
+		},
+		{
+			// a Dockerfile with a build argument
+			Value:    "ARG REGISTRY_NAME\nFROM ${REGISTRY_NAME}/baseimages/node:15-alpine\n",
+			ErrCount: 0,
+		},
+		{
+			// invalid YAML
+			Value:    "steps:\n  - build: [",
+			ErrCount: 1,
+		},
+		{
+			// no steps
+			Value:    "version: v1.1.0\n",
+			ErrCount: 1,
+		},
+		{
+			// empty steps
+			Value:    "version: v1.1.0\nsteps: []\n",
+			ErrCount: 1,
+		},
+		{
+			// non-string version
+			Value:    "version: [1]\nsteps:\n  - cmd: hello-world\n",
+			ErrCount: 1,
+		},
+		{
+			// a step without a type
+			Value:    "steps:\n  - id: hello\n",
+			ErrCount: 1,
+		},
+		{
+			// a step with multiple types
+			Value:    "steps:\n  - cmd: hello-world\n    build: .\n",
+			ErrCount: 1,
+		},
+		{
+			// a step which isn't a map
+			Value:    "steps:\n  - hello-world\n",
+			ErrCount: 1,
+		},
+		{
+			// neither a task definition nor a Dockerfile
+			Value:    "hello world",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validate.ContainerRegistryTaskContent(tc.Value, "task_content")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected ContainerRegistryTaskContent to return %d errors for %q, got %d: %+v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
@@ -312,6 +312,45 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"enhanced_security_compliance": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"automatic_cluster_update_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliance_security_profile_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"compliance_security_profile_standards": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(workspaces.ComplianceStandardHIPAA),
+									string(workspaces.ComplianceStandardPCIDSS),
+								}, false),
+							},
+						},
+
+						"enhanced_security_monitoring_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"storage_account_identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -378,6 +417,28 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				return fmt.Errorf("'customer_managed_key_enabled', 'default_storage_firewall_enabled', 'infrastructure_encryption_enabled', 'managed_disk_cmk_key_vault_key_id' and 'managed_services_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
 			}
 
+			oldEsc, newEsc := d.GetChange("enhanced_security_compliance")
+			if newEscList := newEsc.([]interface{}); len(newEscList) > 0 && newEscList[0] != nil {
+				if !strings.EqualFold("premium", newSku.(string)) {
+					return fmt.Errorf("'enhanced_security_compliance' is only available with a 'premium' workspace 'sku', got %q", newSku)
+				}
+
+				config := newEscList[0].(map[string]interface{})
+				complianceSecurityProfileEnabled := config["compliance_security_profile_enabled"].(bool)
+				if !complianceSecurityProfileEnabled && config["compliance_security_profile_standards"].(*pluginsdk.Set).Len() > 0 {
+					return fmt.Errorf("'compliance_security_profile_standards' can only be specified when 'compliance_security_profile_enabled' is set to 'true'")
+				}
+				if complianceSecurityProfileEnabled && (!config["automatic_cluster_update_enabled"].(bool) || !config["enhanced_security_monitoring_enabled"].(bool)) {
+					return fmt.Errorf("'automatic_cluster_update_enabled' and 'enhanced_security_monitoring_enabled' must be set to 'true' when 'compliance_security_profile_enabled' is set to 'true'")
+				}
+			}
+
+			// the Compliance Security Profile can't be disabled once it has been enabled on a workspace
+			if workspaceComplianceSecurityProfileEnabled(oldEsc.([]interface{})) && !workspaceComplianceSecurityProfileEnabled(newEsc.([]interface{})) {
+				log.Printf("[DEBUG] recreate databricks workspace, the compliance security profile cannot be disabled")
+				d.ForceNew("enhanced_security_compliance")
+			}
+
 			return nil
 		}),
 	}
@@ -582,8 +643,8 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
-	if rotationEnabled := d.Get("managed_disk_cmk_rotation_to_latest_version_enabled").(bool); rotationEnabled {
-		encrypt.Entities.ManagedDisk.RotationToLatestKeyVersionEnabled = utils.Bool(rotationEnabled)
+	if encrypt.Entities.ManagedDisk != nil {
+		encrypt.Entities.ManagedDisk.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("managed_disk_cmk_rotation_to_latest_version_enabled").(bool))
 	}
 
 	// Including the Tags in the workspace parameters will update the tags on
@@ -601,6 +662,10 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if enhancedSecurityCompliance := d.Get("enhanced_security_compliance").([]interface{}); len(enhancedSecurityCompliance) > 0 || d.HasChange("enhanced_security_compliance") {
+		workspace.Properties.EnhancedSecurityCompliance = expandWorkspaceEnhancedSecurityCompliance(enhancedSecurityCompliance)
+	}
+
 	if defaultStorageFirewallEnabledRaw {
 		accessConnectorProperties := workspaces.WorkspacePropertiesAccessConnector{}
 		accessConnectorIdRaw := d.Get("access_connector_id").(string)
@@ -825,7 +890,9 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 					}
 				}
 
-				encryptDiskRotationEnabled = *encryptionProps.RotationToLatestKeyVersionEnabled
+				if encryptionProps.RotationToLatestKeyVersionEnabled != nil {
+					encryptDiskRotationEnabled = *encryptionProps.RotationToLatestKeyVersionEnabled
+				}
 			}
 		}
 
@@ -843,6 +910,10 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("managed_disk_cmk_key_vault_id", diskKeyVaultId)
 		d.Set("managed_disk_cmk_rotation_to_latest_version_enabled", encryptDiskRotationEnabled)
 
+		if err := d.Set("enhanced_security_compliance", flattenWorkspaceEnhancedSecurityCompliance(model.Properties.EnhancedSecurityCompliance)); err != nil {
+			return fmt.Errorf("setting `enhanced_security_compliance`: %+v", err)
+		}
+
 		return tags.FlattenAndSet(d, model.Tags)
 	}
 
@@ -866,6 +937,105 @@ func resourceDatabricksWorkspaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+func workspaceComplianceSecurityProfileEnabled(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	return input[0].(map[string]interface{})["compliance_security_profile_enabled"].(bool)
+}
+
+func expandWorkspaceEnhancedSecurityCompliance(input []interface{}) *workspaces.EnhancedSecurityComplianceDefinition {
+	automaticClusterUpdate := workspaces.AutomaticClusterUpdateValueDisabled
+	complianceSecurityProfile := workspaces.ComplianceSecurityProfileValueDisabled
+	enhancedSecurityMonitoring := workspaces.EnhancedSecurityMonitoringValueDisabled
+	complianceStandards := make([]workspaces.ComplianceStandard, 0)
+
+	if len(input) > 0 && input[0] != nil {
+		config := input[0].(map[string]interface{})
+
+		if config["automatic_cluster_update_enabled"].(bool) {
+			automaticClusterUpdate = workspaces.AutomaticClusterUpdateValueEnabled
+		}
+
+		if config["compliance_security_profile_enabled"].(bool) {
+			complianceSecurityProfile = workspaces.ComplianceSecurityProfileValueEnabled
+		}
+
+		if config["enhanced_security_monitoring_enabled"].(bool) {
+			enhancedSecurityMonitoring = workspaces.EnhancedSecurityMonitoringValueEnabled
+		}
+
+		for _, v := range config["compliance_security_profile_standards"].(*pluginsdk.Set).List() {
+			complianceStandards = append(complianceStandards, workspaces.ComplianceStandard(v.(string)))
+		}
+	}
+
+	// the API expects `NONE` rather than an empty list when the profile is enabled without any standards
+	if complianceSecurityProfile == workspaces.ComplianceSecurityProfileValueEnabled && len(complianceStandards) == 0 {
+		complianceStandards = append(complianceStandards, workspaces.ComplianceStandardNONE)
+	}
+
+	return &workspaces.EnhancedSecurityComplianceDefinition{
+		AutomaticClusterUpdate: &workspaces.AutomaticClusterUpdateDefinition{
+			Value: &automaticClusterUpdate,
+		},
+		ComplianceSecurityProfile: &workspaces.ComplianceSecurityProfileDefinition{
+			ComplianceStandards: &complianceStandards,
+			Value:               &complianceSecurityProfile,
+		},
+		EnhancedSecurityMonitoring: &workspaces.EnhancedSecurityMonitoringDefinition{
+			Value: &enhancedSecurityMonitoring,
+		},
+	}
+}
+
+func flattenWorkspaceEnhancedSecurityCompliance(input *workspaces.EnhancedSecurityComplianceDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var automaticClusterUpdateEnabled, complianceSecurityProfileEnabled, enhancedSecurityMonitoringEnabled bool
+	complianceStandards := make([]interface{}, 0)
+
+	if v := input.AutomaticClusterUpdate; v != nil && v.Value != nil {
+		automaticClusterUpdateEnabled = *v.Value == workspaces.AutomaticClusterUpdateValueEnabled
+	}
+
+	if v := input.ComplianceSecurityProfile; v != nil {
+		if v.Value != nil {
+			complianceSecurityProfileEnabled = *v.Value == workspaces.ComplianceSecurityProfileValueEnabled
+		}
+
+		if v.ComplianceStandards != nil {
+			for _, standard := range *v.ComplianceStandards {
+				if standard == workspaces.ComplianceStandardNONE {
+					continue
+				}
+				complianceStandards = append(complianceStandards, string(standard))
+			}
+		}
+	}
+
+	if v := input.EnhancedSecurityMonitoring; v != nil && v.Value != nil {
+		enhancedSecurityMonitoringEnabled = *v.Value == workspaces.EnhancedSecurityMonitoringValueEnabled
+	}
+
+	// the API returns these as disabled when they've not been configured, so omit the block to match the config
+	if !automaticClusterUpdateEnabled && !complianceSecurityProfileEnabled && !enhancedSecurityMonitoringEnabled && len(complianceStandards) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"automatic_cluster_update_enabled":      automaticClusterUpdateEnabled,
+			"compliance_security_profile_enabled":   complianceSecurityProfileEnabled,
+			"compliance_security_profile_standards": complianceStandards,
+			"enhanced_security_monitoring_enabled":  enhancedSecurityMonitoringEnabled,
+		},
+	}
+}
+
 func flattenWorkspaceManagedIdentity(input *workspaces.ManagedIdentityConfiguration) []interface{} {
 	if input == nil {
 		return nil
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type BackupInstanceKubernatesClusterModel struct {
@@ -32,13 +33,19 @@ type BackupInstanceKubernatesClusterModel struct {
 }
 
 type BackupDatasourceParameters struct {
-	IncludedNamespaces          []string `tfschema:"included_namespaces"`
-	IncludedResourceTypes       []string `tfschema:"included_resource_types"`
-	ExcludedNamespaces          []string `tfschema:"excluded_namespaces"`
-	ExcludedResourceTypes       []string `tfschema:"excluded_resource_types"`
-	LabelSelectors              []string `tfschema:"label_selectors"`
-	VolumeSnapshotEnabled       bool     `tfschema:"volume_snapshot_enabled"`
-	ClusterScopeResourceEnabled bool     `tfschema:"cluster_scoped_resources_enabled"`
+	IncludedNamespaces          []string              `tfschema:"included_namespaces"`
+	IncludedResourceTypes       []string              `tfschema:"included_resource_types"`
+	ExcludedNamespaces          []string              `tfschema:"excluded_namespaces"`
+	ExcludedResourceTypes       []string              `tfschema:"excluded_resource_types"`
+	LabelSelectors              []string              `tfschema:"label_selectors"`
+	VolumeSnapshotEnabled       bool                  `tfschema:"volume_snapshot_enabled"`
+	ClusterScopeResourceEnabled bool                  `tfschema:"cluster_scoped_resources_enabled"`
+	BackupHookReferences        []NamespacedNameModel `tfschema:"backup_hook_reference"`
+}
+
+type NamespacedNameModel struct {
+	Name      string `tfschema:"name"`
+	Namespace string `tfschema:"namespace"`
 }
 
 type DataProtectionBackupInstanceKubernatesClusterResource struct{}
@@ -97,6 +104,7 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Arguments() map[s
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
+					"backup_hook_reference": namespacedNameSchema(),
 					"excluded_namespaces": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -338,6 +346,7 @@ func expandBackupDatasourceParameters(input []BackupDatasourceParameters) *[]bac
 		IncludedResourceTypes:        pointer.To(input[0].IncludedResourceTypes),
 		LabelSelectors:               pointer.To(input[0].LabelSelectors),
 		SnapshotVolumes:              input[0].VolumeSnapshotEnabled,
+		BackupHookReferences:         expandNamespacedNameResources(input[0].BackupHookReferences),
 	})
 	return &results
 }
@@ -357,7 +366,62 @@ func flattenBackupDatasourceParameters(input []backupinstances.BackupDatasourceP
 			IncludedResourceTypes:       pointer.From(item.IncludedResourceTypes),
 			LabelSelectors:              pointer.From(item.LabelSelectors),
 			VolumeSnapshotEnabled:       item.SnapshotVolumes,
+			BackupHookReferences:        flattenNamespacedNameResources(item.BackupHookReferences),
+		})
+	}
+	return &results
+}
+
+func namespacedNameSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"namespace": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandNamespacedNameResources(input []NamespacedNameModel) *[]backupinstances.NamespacedNameResource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]backupinstances.NamespacedNameResource, 0)
+	for _, item := range input {
+		results = append(results, backupinstances.NamespacedNameResource{
+			Name:      pointer.To(item.Name),
+			Namespace: pointer.To(item.Namespace),
 		})
 	}
 	return &results
 }
+
+func flattenNamespacedNameResources(input *[]backupinstances.NamespacedNameResource) []NamespacedNameModel {
+	results := make([]NamespacedNameModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, NamespacedNameModel{
+			Name:      pointer.From(item.Name),
+			Namespace: pointer.From(item.Namespace),
+		})
+	}
+	return results
+}
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type BackupInstanceKubernetesClusterRestoreModel struct {
+	BackupInstanceId            string                `tfschema:"backup_instance_id"`
+	RecoveryPointId             string                `tfschema:"recovery_point_id"`
+	TargetKubernetesClusterId   string                `tfschema:"target_kubernetes_cluster_id"`
+	Location                    string                `tfschema:"location"`
+	IncludedNamespaces          []string              `tfschema:"included_namespaces"`
+	ExcludedNamespaces          []string              `tfschema:"excluded_namespaces"`
+	IncludedResourceTypes       []string              `tfschema:"included_resource_types"`
+	ExcludedResourceTypes       []string              `tfschema:"excluded_resource_types"`
+	LabelSelectors              []string              `tfschema:"label_selectors"`
+	ClusterScopeResourceEnabled bool                  `tfschema:"cluster_scoped_resources_enabled"`
+	NamespaceMappings           map[string]string     `tfschema:"namespace_mappings"`
+	ConflictPolicy              string                `tfschema:"conflict_policy"`
+	PersistentVolumeRestoreMode string                `tfschema:"persistent_volume_restore_mode"`
+	RestoreHookReferences       []NamespacedNameModel `tfschema:"restore_hook_reference"`
+	Triggers                    map[string]string     `tfschema:"triggers"`
+}
+
+// DataProtectionBackupInstanceKubernetesClusterRestoreResource restores a Recovery Point of a Kubernetes Cluster Backup
+// Instance when created, or when one of the triggers changes - the restore can't be reverted, so removing this resource
+// only removes it from the state.
+type DataProtectionBackupInstanceKubernetesClusterRestoreResource struct{}
+
+var _ sdk.ResourceWithCustomImporter = DataProtectionBackupInstanceKubernetesClusterRestoreResource{}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) ResourceType() string {
+	return "azurerm_data_protection_backup_instance_kubernetes_cluster_restore"
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) ModelObject() interface{} {
+	return &BackupInstanceKubernetesClusterRestoreModel{}
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.BackupInstanceRestoreID
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"backup_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: backupinstances.ValidateBackupInstanceID,
+		},
+
+		"recovery_point_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"location": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+		},
+
+		"included_namespaces": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"excluded_namespaces": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"included_resource_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"excluded_resource_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"label_selectors": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"cluster_scoped_resources_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"namespace_mappings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"conflict_policy": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(backupinstances.ExistingResourcePolicySkip),
+			ValidateFunc: validation.StringInSlice(backupinstances.PossibleValuesForExistingResourcePolicy(), false),
+		},
+
+		"persistent_volume_restore_mode": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(backupinstances.PersistentVolumeRestoreModeRestoreWithVolumeData),
+			ValidateFunc: validation.StringInSlice(backupinstances.PossibleValuesForPersistentVolumeRestoreMode(), false),
+		},
+
+		"restore_hook_reference": namespacedNameSchema(),
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.BackupInstanceClient
+
+			var model BackupInstanceKubernetesClusterRestoreModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			backupInstanceId, err := backupinstances.ParseBackupInstanceID(model.BackupInstanceId)
+			if err != nil {
+				return err
+			}
+
+			name, err := sdk.NewActionResourceName()
+			if err != nil {
+				return err
+			}
+			id := parse.NewBackupInstanceRestoreID(backupInstanceId.SubscriptionId, backupInstanceId.ResourceGroupName, backupInstanceId.BackupVaultName, backupInstanceId.BackupInstanceName, name)
+
+			existing, err := client.Get(ctx, *backupInstanceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", backupInstanceId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", backupInstanceId)
+			}
+			source := existing.Model.Properties.DataSourceInfo
+
+			// the backup is restored into the backed up Kubernetes Cluster unless another one is specified
+			targetClusterId, err := commonids.ParseKubernetesClusterIDInsensitively(source.ResourceID)
+			if err != nil {
+				return err
+			}
+			if model.TargetKubernetesClusterId != "" {
+				if targetClusterId, err = commonids.ParseKubernetesClusterID(model.TargetKubernetesClusterId); err != nil {
+					return err
+				}
+			}
+
+			restoreLocation := location.NormalizeNilable(source.ResourceLocation)
+			if model.Location != "" {
+				restoreLocation = location.Normalize(model.Location)
+			}
+
+			request := backupinstances.AzureBackupRecoveryPointBasedRestoreRequest{
+				RecoveryPointId:     model.RecoveryPointId,
+				SourceDataStoreType: backupinstances.SourceDataStoreTypeOperationalStore,
+				SourceResourceId:    pointer.To(source.ResourceID),
+				RestoreTargetInfo: backupinstances.ItemLevelRestoreTargetInfo{
+					DatasourceInfo: backupinstances.Datasource{
+						DatasourceType:   pointer.To("Microsoft.ContainerService/managedClusters"),
+						ObjectType:       pointer.To("Datasource"),
+						ResourceID:       targetClusterId.ID(),
+						ResourceLocation: pointer.To(restoreLocation),
+						ResourceName:     pointer.To(targetClusterId.ManagedClusterName),
+						ResourceType:     pointer.To("Microsoft.ContainerService/managedClusters"),
+						ResourceUri:      pointer.To(targetClusterId.ID()),
+					},
+					RecoveryOption:  backupinstances.RecoveryOptionFailIfExists,
+					RestoreLocation: pointer.To(restoreLocation),
+					RestoreCriteria: []backupinstances.ItemLevelRestoreCriteria{
+						expandKubernetesClusterRestoreCriteria(model),
+					},
+				},
+			}
+
+			if err := client.ValidateForRestoreThenPoll(ctx, *backupInstanceId, backupinstances.ValidateRestoreRequestObject{RestoreRequestObject: request}); err != nil {
+				return fmt.Errorf("validating the restore of the Recovery Point %q of %s: %+v", model.RecoveryPointId, backupInstanceId, err)
+			}
+
+			if err := client.TriggerRestoreThenPoll(ctx, *backupInstanceId, request, backupinstances.DefaultTriggerRestoreOperationOptions()); err != nil {
+				return fmt.Errorf("restoring the Recovery Point %q of %s: %+v", model.RecoveryPointId, backupInstanceId, err)
+			}
+
+			if err := metadata.ResourceData.Set("target_kubernetes_cluster_id", targetClusterId.ID()); err != nil {
+				return fmt.Errorf("setting `target_kubernetes_cluster_id`: %+v", err)
+			}
+			if err := metadata.ResourceData.Set("location", restoreLocation); err != nil {
+				return fmt.Errorf("setting `location`: %+v", err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DataProtection.BackupInstanceClient
+
+			id, err := parse.BackupInstanceRestoreID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			backupInstanceId := backupinstances.NewBackupInstanceID(id.SubscriptionId, id.ResourceGroup, id.BackupVaultName, id.BackupInstanceName)
+			resp, err := client.Get(ctx, backupInstanceId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", backupInstanceId, err)
+			}
+
+			// a restore is a one-off operation, so there's nothing to read back other than the Backup Instance still existing
+			var state BackupInstanceKubernetesClusterRestoreModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.BackupInstanceId = backupInstanceId.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) Delete() sdk.ResourceFunc {
+	return sdk.ActionResourceDelete()
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreResource) CustomImporter() sdk.ResourceRunFunc {
+	return sdk.ActionResourceImporter(r.ResourceType())
+}
+
+func expandKubernetesClusterRestoreCriteria(input BackupInstanceKubernetesClusterRestoreModel) backupinstances.KubernetesClusterRestoreCriteria {
+	output := backupinstances.KubernetesClusterRestoreCriteria{
+		ConflictPolicy:               pointer.To(backupinstances.ExistingResourcePolicy(input.ConflictPolicy)),
+		IncludeClusterScopeResources: input.ClusterScopeResourceEnabled,
+		PersistentVolumeRestoreMode:  pointer.To(backupinstances.PersistentVolumeRestoreMode(input.PersistentVolumeRestoreMode)),
+		RestoreHookReferences:        expandNamespacedNameResources(input.RestoreHookReferences),
+	}
+
+	if len(input.IncludedNamespaces) > 0 {
+		output.IncludedNamespaces = pointer.To(input.IncludedNamespaces)
+	}
+	if len(input.ExcludedNamespaces) > 0 {
+		output.ExcludedNamespaces = pointer.To(input.ExcludedNamespaces)
+	}
+	if len(input.IncludedResourceTypes) > 0 {
+		output.IncludedResourceTypes = pointer.To(input.IncludedResourceTypes)
+	}
+	if len(input.ExcludedResourceTypes) > 0 {
+		output.ExcludedResourceTypes = pointer.To(input.ExcludedResourceTypes)
+	}
+	if len(input.LabelSelectors) > 0 {
+		output.LabelSelectors = pointer.To(input.LabelSelectors)
+	}
+	if len(input.NamespaceMappings) > 0 {
+		output.NamespaceMappings = pointer.To(input.NamespaceMappings)
+	}
+
+	return output
+}
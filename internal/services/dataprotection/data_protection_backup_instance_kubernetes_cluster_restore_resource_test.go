@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backupinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DataProtectionBackupInstanceKubernetesClusterRestoreTestResource struct{}
+
+// a Recovery Point is only available once a backup has completed, which takes longer than a test run - as such these
+// tests restore an existing Recovery Point of an existing Backup Instance
+func TestAccDataProtectionBackupInstanceKubernetesClusterRestore_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_BACKUP_INSTANCE_ID") == "" || os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_RECOVERY_POINT_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_PROTECTION_KUBERNETES_BACKUP_INSTANCE_ID and/or ARM_TEST_DATA_PROTECTION_KUBERNETES_RECOVERY_POINT_ID are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_instance_kubernetes_cluster_restore", "test")
+	r := DataProtectionBackupInstanceKubernetesClusterRestoreTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_kubernetes_cluster_id").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataProtectionBackupInstanceKubernetesClusterRestore_namespaceMappings(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_BACKUP_INSTANCE_ID") == "" || os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_RECOVERY_POINT_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_PROTECTION_KUBERNETES_BACKUP_INSTANCE_ID and/or ARM_TEST_DATA_PROTECTION_KUBERNETES_RECOVERY_POINT_ID are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_protection_backup_instance_kubernetes_cluster_restore", "test")
+	r := DataProtectionBackupInstanceKubernetesClusterRestoreTestResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.namespaceMappings(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.namespaceMappings(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreTestResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BackupInstanceRestoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	// the restore itself can't be retrieved, so check that the Backup Instance which was restored still exists
+	backupInstanceId := backupinstances.NewBackupInstanceID(id.SubscriptionId, id.ResourceGroup, id.BackupVaultName, id.BackupInstanceName)
+	resp, err := client.DataProtection.BackupInstanceClient.Get(ctx, backupInstanceId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", backupInstanceId, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_data_protection_backup_instance_kubernetes_cluster_restore" "test" {
+  backup_instance_id = "%s"
+  recovery_point_id  = "%s"
+}
+`, os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_BACKUP_INSTANCE_ID"), os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_RECOVERY_POINT_ID"))
+}
+
+func (r DataProtectionBackupInstanceKubernetesClusterRestoreTestResource) namespaceMappings(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_data_protection_backup_instance_kubernetes_cluster_restore" "test" {
+  backup_instance_id               = "%[1]s"
+  recovery_point_id                = "%[2]s"
+  included_namespaces              = ["default"]
+  cluster_scoped_resources_enabled = false
+  conflict_policy                  = "Patch"
+  persistent_volume_restore_mode   = "RestoreWithoutVolumeData"
+
+  namespace_mappings = {
+    default = "acctest-restored-%[3]d"
+  }
+
+  triggers = {
+    restore = "%[4]s"
+  }
+}
+`, os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_BACKUP_INSTANCE_ID"), os.Getenv("ARM_TEST_DATA_PROTECTION_KUBERNETES_RECOVERY_POINT_ID"), data.RandomInteger, trigger)
+}
@@ -61,6 +61,7 @@ func (r Registration) Resources() []sdk.Resource {
 		DataProtectionBackupPolicyKubernatesClusterResource{},
 		DataProtectionBackupPolicyPostgreSQLFlexibleServerResource{},
 		DataProtectionBackupInstanceKubernatesClusterResource{},
+		DataProtectionBackupInstanceKubernetesClusterRestoreResource{},
 		DataProtectionBackupInstancePostgreSQLFlexibleServerResource{},
 	}
 }
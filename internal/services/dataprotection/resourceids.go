@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataprotection
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=BackupInstanceRestore -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataProtection/backupVaults/vault1/backupInstances/backupInstance1/restores/restore1
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/datasetmapping"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDataShareDataSetMappingBlobStorage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareDataSetMappingBlobStorageCreate,
+		Read:   resourceDataShareDataSetMappingBlobStorageRead,
+		Delete: resourceDataShareDataSetMappingBlobStorageDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := datasetmapping.ParseDataSetMappingID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataSetName(),
+			},
+
+			"share_subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: sharesubscription.ValidateShareSubscriptionID,
+			},
+
+			"source_dataset_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DataSetName(),
+			},
+
+			"container_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageContainerName,
+			},
+
+			"storage_account": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: storageValidate.StorageAccountName,
+						},
+
+						"resource_group_name": commonschema.ResourceGroupName(),
+
+						"subscription_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+
+			"file_path": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"folder_path"},
+			},
+
+			"folder_path": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"file_path"},
+			},
+
+			"source_dataset_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareDataSetMappingBlobStorageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	shareSubscriptionId, err := sharesubscription.ParseShareSubscriptionID(d.Get("share_subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	id := datasetmapping.NewDataSetMappingID(shareSubscriptionId.SubscriptionId, shareSubscriptionId.ResourceGroupName, shareSubscriptionId.AccountName, shareSubscriptionId.ShareSubscriptionName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_data_share_dataset_mapping_blob_storage", id.ID())
+	}
+
+	// the mapping references the dataset by the ID assigned by the provider of the share, which is only exposed via the
+	// source datasets of the share subscription
+	sourceDataSetId, err := findDataShareSubscriptionSourceDataSetId(ctx, meta, *shareSubscriptionId, d.Get("source_dataset_name").(string))
+	if err != nil {
+		return err
+	}
+
+	var mapping datasetmapping.DataSetMapping
+	if filePath, ok := d.GetOk("file_path"); ok {
+		mapping = datasetmapping.BlobDataSetMapping{
+			Properties: datasetmapping.BlobMappingProperties{
+				ContainerName:      d.Get("container_name").(string),
+				DataSetId:          sourceDataSetId,
+				StorageAccountName: d.Get("storage_account.0.name").(string),
+				ResourceGroup:      d.Get("storage_account.0.resource_group_name").(string),
+				SubscriptionId:     d.Get("storage_account.0.subscription_id").(string),
+				FilePath:           filePath.(string),
+			},
+		}
+	} else if folderPath, ok := d.GetOk("folder_path"); ok {
+		mapping = datasetmapping.BlobFolderDataSetMapping{
+			Properties: datasetmapping.BlobFolderMappingProperties{
+				ContainerName:      d.Get("container_name").(string),
+				DataSetId:          sourceDataSetId,
+				StorageAccountName: d.Get("storage_account.0.name").(string),
+				ResourceGroup:      d.Get("storage_account.0.resource_group_name").(string),
+				SubscriptionId:     d.Get("storage_account.0.subscription_id").(string),
+				Prefix:             folderPath.(string),
+			},
+		}
+	} else {
+		mapping = datasetmapping.BlobContainerDataSetMapping{
+			Properties: datasetmapping.BlobContainerMappingProperties{
+				ContainerName:      d.Get("container_name").(string),
+				DataSetId:          sourceDataSetId,
+				StorageAccountName: d.Get("storage_account.0.name").(string),
+				ResourceGroup:      d.Get("storage_account.0.resource_group_name").(string),
+				SubscriptionId:     d.Get("storage_account.0.subscription_id").(string),
+			},
+		}
+	}
+
+	if _, err := client.Create(ctx, id, mapping); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceDataShareDataSetMappingBlobStorageRead(d, meta)
+}
+
+func resourceDataShareDataSetMappingBlobStorageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datasetmapping.ParseDataSetMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	shareSubscriptionId := sharesubscription.NewShareSubscriptionID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, id.ShareSubscriptionName)
+
+	d.Set("name", id.DataSetMappingName)
+	d.Set("share_subscription_id", shareSubscriptionId.ID())
+
+	if model := resp.Model; model != nil {
+		var dataSetId string
+		var status *datasetmapping.DataSetMappingStatus
+
+		m := *model
+		if mapping, ok := m.(datasetmapping.BlobDataSetMapping); ok {
+			props := mapping.Properties
+			d.Set("container_name", props.ContainerName)
+			if err := d.Set("storage_account", flattenAzureRmDataShareDataSetBlobStorageAccount(props.StorageAccountName, props.ResourceGroup, props.SubscriptionId)); err != nil {
+				return fmt.Errorf("setting `storage_account`: %+v", err)
+			}
+			d.Set("file_path", props.FilePath)
+			dataSetId = props.DataSetId
+			status = props.DataSetMappingStatus
+		} else if mapping, ok := m.(datasetmapping.BlobFolderDataSetMapping); ok {
+			props := mapping.Properties
+			d.Set("container_name", props.ContainerName)
+			if err := d.Set("storage_account", flattenAzureRmDataShareDataSetBlobStorageAccount(props.StorageAccountName, props.ResourceGroup, props.SubscriptionId)); err != nil {
+				return fmt.Errorf("setting `storage_account`: %+v", err)
+			}
+			d.Set("folder_path", props.Prefix)
+			dataSetId = props.DataSetId
+			status = props.DataSetMappingStatus
+		} else if mapping, ok := m.(datasetmapping.BlobContainerDataSetMapping); ok {
+			props := mapping.Properties
+			d.Set("container_name", props.ContainerName)
+			if err := d.Set("storage_account", flattenAzureRmDataShareDataSetBlobStorageAccount(props.StorageAccountName, props.ResourceGroup, props.SubscriptionId)); err != nil {
+				return fmt.Errorf("setting `storage_account`: %+v", err)
+			}
+			dataSetId = props.DataSetId
+			status = props.DataSetMappingStatus
+		} else {
+			return fmt.Errorf("%s is not a blob storage dataset mapping", *id)
+		}
+
+		d.Set("source_dataset_id", dataSetId)
+		d.Set("status", string(pointer.From(status)))
+
+		// the source dataset is removed from the share subscription when the provider removes it from the share, in which
+		// case the name in the state is kept so that the mapping (now `Broken`) can still be removed
+		dataSetName, err := findDataShareSubscriptionSourceDataSetName(ctx, meta, shareSubscriptionId, dataSetId)
+		if err != nil {
+			return err
+		}
+		if dataSetName != "" {
+			d.Set("source_dataset_name", dataSetName)
+		}
+	}
+
+	return nil
+}
+
+func resourceDataShareDataSetMappingBlobStorageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.DataSetMappingClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := datasetmapping.ParseDataSetMappingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func findDataShareSubscriptionSourceDataSetId(ctx context.Context, meta interface{}, id sharesubscription.ShareSubscriptionId, name string) (string, error) {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+
+	resp, err := client.ConsumerSourceDataSetsListByShareSubscriptionComplete(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("listing source datasets for %s: %+v", id, err)
+	}
+
+	for _, item := range resp.Items {
+		if props := item.Properties; props != nil && strings.EqualFold(pointer.From(props.DataSetName), name) {
+			return pointer.From(props.DataSetId), nil
+		}
+	}
+
+	return "", fmt.Errorf("source dataset %q was not found within %s", name, id)
+}
+
+func findDataShareSubscriptionSourceDataSetName(ctx context.Context, meta interface{}, id sharesubscription.ShareSubscriptionId, dataSetId string) (string, error) {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionClient
+
+	resp, err := client.ConsumerSourceDataSetsListByShareSubscriptionComplete(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("listing source datasets for %s: %+v", id, err)
+	}
+
+	for _, item := range resp.Items {
+		if props := item.Properties; props != nil && strings.EqualFold(pointer.From(props.DataSetId), dataSetId) {
+			return pointer.From(props.DataSetName), nil
+		}
+	}
+
+	return "", nil
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/datasetmapping"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareDataSetMappingBlobStorageResource struct{}
+
+func TestAccDataShareDataSetMappingBlobStorage_basicContainer(t *testing.T) {
+	skipDataShareSubscriptionTestIfInvitationNotSet(t)
+	if os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_DATASET_NAME") == "" {
+		t.Skipf("Skipping as ARM_TEST_DATA_SHARE_SOURCE_DATASET_NAME is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_blob_storage", "test")
+	r := DataShareDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_dataset_id").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Ok"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingBlobStorage_requiresImport(t *testing.T) {
+	skipDataShareSubscriptionTestIfInvitationNotSet(t)
+	if os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_DATASET_NAME") == "" {
+		t.Skipf("Skipping as ARM_TEST_DATA_SHARE_SOURCE_DATASET_NAME is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_blob_storage", "test")
+	r := DataShareDataSetMappingBlobStorageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t DataShareDataSetMappingBlobStorageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := datasetmapping.ParseDataSetMappingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.DataSetMappingClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil {
+		m := *model
+		if _, ok := m.(datasetmapping.BlobDataSetMapping); ok {
+			return utils.Bool(true), nil
+		}
+		if _, ok := m.(datasetmapping.BlobFolderDataSetMapping); ok {
+			return utils.Bool(true), nil
+		}
+		if _, ok := m.(datasetmapping.BlobContainerDataSetMapping); ok {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s is not a blob storage dataset mapping", *id)
+}
+
+func (DataShareDataSetMappingBlobStorageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-DSA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_DSS_%[1]d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%[4]s"
+  source_share_location = "%[5]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctest-sc-%[1]d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+data "azuread_service_principal" "test" {
+  display_name = azurerm_data_share_account.test.name
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = data.azuread_service_principal.test.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"), os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_LOCATION"))
+}
+
+func (r DataShareDataSetMappingBlobStorageResource) basicContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_share_dataset_mapping_blob_storage" "test" {
+  name                  = "acctest-DSDSMBS-%[2]d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_dataset_name   = "%[3]s"
+  container_name        = azurerm_storage_container.test.name
+  storage_account {
+    name                = azurerm_storage_account.test.name
+    resource_group_name = azurerm_storage_account.test.resource_group_name
+    subscription_id     = "%[4]s"
+  }
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_DATASET_NAME"), os.Getenv("ARM_SUBSCRIPTION_ID"))
+}
+
+func (r DataShareDataSetMappingBlobStorageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_blob_storage" "import" {
+  name                  = azurerm_data_share_dataset_mapping_blob_storage.test.name
+  share_subscription_id = azurerm_data_share_dataset_mapping_blob_storage.test.share_subscription_id
+  source_dataset_name   = azurerm_data_share_dataset_mapping_blob_storage.test.source_dataset_name
+  container_name        = azurerm_data_share_dataset_mapping_blob_storage.test.container_name
+  storage_account {
+    name                = azurerm_data_share_dataset_mapping_blob_storage.test.storage_account.0.name
+    resource_group_name = azurerm_data_share_dataset_mapping_blob_storage.test.storage_account.0.resource_group_name
+    subscription_id     = azurerm_data_share_dataset_mapping_blob_storage.test.storage_account.0.subscription_id
+  }
+}
+`, r.basicContainer(data))
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/sharesubscription"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataShareSubscriptionResource struct{}
+
+// the invitation has to be sent to the principal running the tests by the provider of a data share, which can't be done
+// from within the same configuration since an invitation can't be accepted by the account which sent it
+func skipDataShareSubscriptionTestIfInvitationNotSet(t *testing.T) {
+	if os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID") == "" || os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_LOCATION") == "" {
+		t.Skipf("Skipping as either ARM_TEST_DATA_SHARE_INVITATION_ID or ARM_TEST_DATA_SHARE_SOURCE_LOCATION is not set")
+	}
+}
+
+func TestAccDataShareSubscription_basic(t *testing.T) {
+	skipDataShareSubscriptionTestIfInvitationNotSet(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("share_name").Exists(),
+				check.That(data.ResourceName).Key("status").HasValue("Active"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareSubscription_requiresImport(t *testing.T) {
+	skipDataShareSubscriptionTestIfInvitationNotSet(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareSubscription_snapshotSchedule(t *testing.T) {
+	skipDataShareSubscriptionTestIfInvitationNotSet(t)
+
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	r := DataShareSubscriptionResource{}
+	startTime := time.Now().Add(time.Hour * 7).Format(time.RFC3339)
+	startTime2 := time.Now().Add(time.Hour * 8).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_schedule.0.synchronization_mode").HasValue("Incremental"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotScheduleUpdated(data, startTime2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_schedule.0.synchronization_mode").HasValue("FullSync"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataShareSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sharesubscription.ParseShareSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.ShareSubscriptionClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (DataShareSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-dsa-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DataShareSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_dss_%d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%s"
+  source_share_location = "%s"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"), os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_LOCATION"))
+}
+
+func (r DataShareSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "import" {
+  name                  = azurerm_data_share_subscription.test.name
+  account_id            = azurerm_data_share_subscription.test.account_id
+  invitation_id         = azurerm_data_share_subscription.test.invitation_id
+  source_share_location = azurerm_data_share_subscription.test.source_share_location
+}
+`, r.basic(data))
+}
+
+func (r DataShareSubscriptionResource) snapshotSchedule(data acceptance.TestData, startTime string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_dss_%[2]d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%[3]s"
+  source_share_location = "%[4]s"
+
+  snapshot_schedule {
+    name       = "acctest-ss-%[2]d"
+    recurrence = "Day"
+    start_time = "%[5]s"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"), os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_LOCATION"), startTime)
+}
+
+func (r DataShareSubscriptionResource) snapshotScheduleUpdated(data acceptance.TestData, startTime string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_dss_%[2]d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%[3]s"
+  source_share_location = "%[4]s"
+
+  snapshot_schedule {
+    name                 = "acctest-ss2-%[2]d"
+    recurrence           = "Hour"
+    start_time           = "%[5]s"
+    synchronization_mode = "FullSync"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"), os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_LOCATION"), startTime)
+}
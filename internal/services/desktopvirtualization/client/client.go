@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/scalingplan"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/sessionhost"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-09-09/msixpackage"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -21,6 +22,7 @@ type Client struct {
 	ApplicationsClient      *application.ApplicationClient
 	DesktopsClient          *desktop.DesktopClient
 	HostPoolsClient         *hostpool.HostPoolClient
+	MSIXPackagesClient      *msixpackage.MSIXPackageClient
 	SessionHostsClient      *sessionhost.SessionHostClient
 	ScalingPlansClient      *scalingplan.ScalingPlanClient
 	WorkspacesClient        *workspace.WorkspaceClient
@@ -51,6 +53,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(hostPoolsClient.Client, o.Authorizers.ResourceManager)
 
+	msixPackagesClient, err := msixpackage.NewMSIXPackageClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building MSIXPackages Client: %+v", err)
+	}
+	o.Configure(msixPackagesClient.Client, o.Authorizers.ResourceManager)
+
 	sessionHostsClient, err := sessionhost.NewSessionHostClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building SessionHost Client: %+v", err)
@@ -74,6 +82,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ApplicationsClient:      applicationsClient,
 		DesktopsClient:          desktopsClient,
 		HostPoolsClient:         hostPoolsClient,
+		MSIXPackagesClient:      msixPackagesClient,
 		SessionHostsClient:      sessionHostsClient,
 		ScalingPlansClient:      scalingPlansClient,
 		WorkspacesClient:        workspacesClient,
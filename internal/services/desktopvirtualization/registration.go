@@ -34,11 +34,15 @@ func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		DesktopVirtualizationWorkspaceDataSource{},
 		DesktopVirtualizationApplicationGroupDataSource{},
+		VirtualDesktopHostPoolRegistrationInfoDataSource{},
 	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		VirtualDesktopHostPoolMSIXPackageResource{},
+		VirtualDesktopSessionHostResource{},
+	}
 }
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
@@ -0,0 +1,494 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-09-09/msixpackage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualDesktopHostPoolMSIXPackageModel struct {
+	Name                       string                                         `tfschema:"name"`
+	HostPoolId                 string                                         `tfschema:"host_pool_id"`
+	ImagePath                  string                                         `tfschema:"image_path"`
+	PackageName                string                                         `tfschema:"package_name"`
+	PackageFamilyName          string                                         `tfschema:"package_family_name"`
+	PackageRelativePath        string                                         `tfschema:"package_relative_path"`
+	Version                    string                                         `tfschema:"version"`
+	DisplayName                string                                         `tfschema:"display_name"`
+	Enabled                    bool                                           `tfschema:"enabled"`
+	RegularRegistrationEnabled bool                                           `tfschema:"regular_registration_enabled"`
+	LastUpdated                string                                         `tfschema:"last_updated"`
+	PackageApplication         []VirtualDesktopHostPoolMSIXPackageApplication `tfschema:"package_application"`
+	PackageDependency          []VirtualDesktopHostPoolMSIXPackageDependency  `tfschema:"package_dependency"`
+}
+
+type VirtualDesktopHostPoolMSIXPackageApplication struct {
+	AppId          string `tfschema:"app_id"`
+	AppUserModelId string `tfschema:"app_user_model_id"`
+	Description    string `tfschema:"description"`
+	FriendlyName   string `tfschema:"friendly_name"`
+	IconImageName  string `tfschema:"icon_image_name"`
+	RawIcon        string `tfschema:"raw_icon"`
+	RawPng         string `tfschema:"raw_png"`
+}
+
+type VirtualDesktopHostPoolMSIXPackageDependency struct {
+	DependencyName string `tfschema:"dependency_name"`
+	MinVersion     string `tfschema:"min_version"`
+	Publisher      string `tfschema:"publisher"`
+}
+
+type VirtualDesktopHostPoolMSIXPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualDesktopHostPoolMSIXPackageResource{}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) ResourceType() string {
+	return "azurerm_virtual_desktop_host_pool_msix_package"
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) ModelObject() interface{} {
+	return &VirtualDesktopHostPoolMSIXPackageModel{}
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return msixpackage.ValidateMsixPackageID
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: msixpackage.ValidateHostPoolID,
+		},
+
+		"image_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"package_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"package_family_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"package_relative_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"regular_registration_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"last_updated": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"package_application": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"app_user_model_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"friendly_name": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"icon_image_name": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"raw_icon": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"raw_png": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+				},
+			},
+		},
+
+		"package_dependency": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dependency_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"min_version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.MSIXPackagesClient
+
+			var model VirtualDesktopHostPoolMSIXPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			hostPoolId, err := msixpackage.ParseHostPoolID(model.HostPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := msixpackage.NewMsixPackageID(hostPoolId.SubscriptionId, hostPoolId.ResourceGroupName, hostPoolId.HostPoolName, model.Name)
+
+			locks.ByName(id.HostPoolName, hostPoolResourceType)
+			defer locks.UnlockByName(id.HostPoolName, hostPoolResourceType)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			lastUpdated := model.LastUpdated
+			if lastUpdated == "" {
+				lastUpdated = time.Now().UTC().Format(time.RFC3339)
+			}
+
+			payload := msixpackage.MSIXPackage{
+				Properties: msixpackage.MSIXPackageProperties{
+					ImagePath:             pointer.To(model.ImagePath),
+					IsActive:              pointer.To(model.Enabled),
+					IsRegularRegistration: pointer.To(model.RegularRegistrationEnabled),
+					LastUpdated:           pointer.To(lastUpdated),
+					PackageApplications:   expandVirtualDesktopHostPoolMSIXPackageApplications(model.PackageApplication),
+					PackageDependencies:   expandVirtualDesktopHostPoolMSIXPackageDependencies(model.PackageDependency),
+					PackageFamilyName:     pointer.To(model.PackageFamilyName),
+					PackageName:           pointer.To(model.PackageName),
+					PackageRelativePath:   pointer.To(model.PackageRelativePath),
+					Version:               pointer.To(model.Version),
+				},
+			}
+
+			if model.DisplayName != "" {
+				payload.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.MSIXPackagesClient
+
+			id, err := msixpackage.ParseMsixPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualDesktopHostPoolMSIXPackageModel{
+				Name:       id.MsixPackageName,
+				HostPoolId: msixpackage.NewHostPoolID(id.SubscriptionId, id.ResourceGroupName, id.HostPoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DisplayName = pointer.From(props.DisplayName)
+				state.Enabled = pointer.From(props.IsActive)
+				state.ImagePath = pointer.From(props.ImagePath)
+				state.LastUpdated = pointer.From(props.LastUpdated)
+				state.PackageApplication = flattenVirtualDesktopHostPoolMSIXPackageApplications(props.PackageApplications)
+				state.PackageDependency = flattenVirtualDesktopHostPoolMSIXPackageDependencies(props.PackageDependencies)
+				state.PackageFamilyName = pointer.From(props.PackageFamilyName)
+				state.PackageName = pointer.From(props.PackageName)
+				state.PackageRelativePath = pointer.From(props.PackageRelativePath)
+				state.RegularRegistrationEnabled = pointer.From(props.IsRegularRegistration)
+				state.Version = pointer.From(props.Version)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.MSIXPackagesClient
+
+			id, err := msixpackage.ParseMsixPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualDesktopHostPoolMSIXPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.HostPoolName, hostPoolResourceType)
+			defer locks.UnlockByName(id.HostPoolName, hostPoolResourceType)
+
+			payload := msixpackage.MSIXPackagePatch{
+				Properties: &msixpackage.MSIXPackagePatchProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.IsActive = pointer.To(model.Enabled)
+			}
+
+			if metadata.ResourceData.HasChange("regular_registration_enabled") {
+				payload.Properties.IsRegularRegistration = pointer.To(model.RegularRegistrationEnabled)
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.MSIXPackagesClient
+
+			id, err := msixpackage.ParseMsixPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.HostPoolName, hostPoolResourceType)
+			defer locks.UnlockByName(id.HostPoolName, hostPoolResourceType)
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVirtualDesktopHostPoolMSIXPackageApplications(input []VirtualDesktopHostPoolMSIXPackageApplication) *[]msixpackage.MsixPackageApplications {
+	results := make([]msixpackage.MsixPackageApplications, 0)
+	for _, v := range input {
+		item := msixpackage.MsixPackageApplications{
+			AppId:          pointer.To(v.AppId),
+			AppUserModelID: pointer.To(v.AppUserModelId),
+		}
+
+		if v.Description != "" {
+			item.Description = pointer.To(v.Description)
+		}
+		if v.FriendlyName != "" {
+			item.FriendlyName = pointer.To(v.FriendlyName)
+		}
+		if v.IconImageName != "" {
+			item.IconImageName = pointer.To(v.IconImageName)
+		}
+		if v.RawIcon != "" {
+			item.RawIcon = pointer.To(v.RawIcon)
+		}
+		if v.RawPng != "" {
+			item.RawPng = pointer.To(v.RawPng)
+		}
+
+		results = append(results, item)
+	}
+
+	return &results
+}
+
+func flattenVirtualDesktopHostPoolMSIXPackageApplications(input *[]msixpackage.MsixPackageApplications) []VirtualDesktopHostPoolMSIXPackageApplication {
+	results := make([]VirtualDesktopHostPoolMSIXPackageApplication, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, VirtualDesktopHostPoolMSIXPackageApplication{
+			AppId:          pointer.From(v.AppId),
+			AppUserModelId: pointer.From(v.AppUserModelID),
+			Description:    pointer.From(v.Description),
+			FriendlyName:   pointer.From(v.FriendlyName),
+			IconImageName:  pointer.From(v.IconImageName),
+			RawIcon:        pointer.From(v.RawIcon),
+			RawPng:         pointer.From(v.RawPng),
+		})
+	}
+
+	return results
+}
+
+func expandVirtualDesktopHostPoolMSIXPackageDependencies(input []VirtualDesktopHostPoolMSIXPackageDependency) *[]msixpackage.MsixPackageDependencies {
+	results := make([]msixpackage.MsixPackageDependencies, 0)
+	for _, v := range input {
+		item := msixpackage.MsixPackageDependencies{
+			DependencyName: pointer.To(v.DependencyName),
+			Publisher:      pointer.To(v.Publisher),
+		}
+
+		if v.MinVersion != "" {
+			item.MinVersion = pointer.To(v.MinVersion)
+		}
+
+		results = append(results, item)
+	}
+
+	return &results
+}
+
+func flattenVirtualDesktopHostPoolMSIXPackageDependencies(input *[]msixpackage.MsixPackageDependencies) []VirtualDesktopHostPoolMSIXPackageDependency {
+	results := make([]VirtualDesktopHostPoolMSIXPackageDependency, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, VirtualDesktopHostPoolMSIXPackageDependency{
+			DependencyName: pointer.From(v.DependencyName),
+			MinVersion:     pointer.From(v.MinVersion),
+			Publisher:      pointer.From(v.Publisher),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-09-09/msixpackage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualDesktopHostPoolMSIXPackageResource struct{}
+
+func TestAccVirtualDesktopHostPoolMSIXPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_host_pool_msix_package", "test")
+	r := VirtualDesktopHostPoolMSIXPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualDesktopHostPoolMSIXPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_host_pool_msix_package", "test")
+	r := VirtualDesktopHostPoolMSIXPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualDesktopHostPoolMSIXPackage_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_host_pool_msix_package", "test")
+	r := VirtualDesktopHostPoolMSIXPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VirtualDesktopHostPoolMSIXPackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := msixpackage.ParseMsixPackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DesktopVirtualization.MSIXPackagesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VirtualDesktopHostPoolMSIXPackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktopmsix-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                = "acctestHP%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Pooled"
+  load_balancer_type  = "BreadthFirst"
+}
+`, data.RandomInteger, data.Locations.Secondary, data.RandomString)
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_host_pool_msix_package" "test" {
+  name                  = "acctest_1.0.0.0_x64__%s"
+  host_pool_id          = azurerm_virtual_desktop_host_pool.test.id
+  image_path            = "\\\\acctest%s.file.core.windows.net\\msix\\acctest.vhdx"
+  package_name          = "acctest"
+  package_family_name   = "acctest_%s"
+  package_relative_path = "\\apps\\acctest_1.0.0.0_x64__%s"
+  version               = "1.0.0.0"
+  last_updated          = "2024-01-01T00:00:00Z"
+}
+`, r.template(data), data.RandomString, data.RandomString, data.RandomString, data.RandomString)
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_host_pool_msix_package" "import" {
+  name                  = azurerm_virtual_desktop_host_pool_msix_package.test.name
+  host_pool_id          = azurerm_virtual_desktop_host_pool_msix_package.test.host_pool_id
+  image_path            = azurerm_virtual_desktop_host_pool_msix_package.test.image_path
+  package_name          = azurerm_virtual_desktop_host_pool_msix_package.test.package_name
+  package_family_name   = azurerm_virtual_desktop_host_pool_msix_package.test.package_family_name
+  package_relative_path = azurerm_virtual_desktop_host_pool_msix_package.test.package_relative_path
+  version               = azurerm_virtual_desktop_host_pool_msix_package.test.version
+  last_updated          = azurerm_virtual_desktop_host_pool_msix_package.test.last_updated
+}
+`, r.basic(data))
+}
+
+func (r VirtualDesktopHostPoolMSIXPackageResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_host_pool_msix_package" "test" {
+  name                         = "acctest_1.0.0.0_x64__%s"
+  host_pool_id                 = azurerm_virtual_desktop_host_pool.test.id
+  image_path                   = "\\\\acctest%s.file.core.windows.net\\msix\\acctest.vhdx"
+  package_name                 = "acctest"
+  package_family_name          = "acctest_%s"
+  package_relative_path        = "\\apps\\acctest_1.0.0.0_x64__%s"
+  version                      = "1.0.0.0"
+  last_updated                 = "2024-01-01T00:00:00Z"
+  display_name                 = "Acceptance Test"
+  enabled                      = false
+  regular_registration_enabled = true
+}
+`, r.template(data), data.RandomString, data.RandomString, data.RandomString, data.RandomString)
+}
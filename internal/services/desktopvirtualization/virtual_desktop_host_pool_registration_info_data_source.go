@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/hostpool"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// VirtualDesktopHostPoolRegistrationInfoDataSource exposes whether a Host Pool currently has a valid Registration
+// Token, without storing the token itself, so that the token can be rotated before it expires
+type VirtualDesktopHostPoolRegistrationInfoDataSource struct{}
+
+var _ sdk.DataSource = VirtualDesktopHostPoolRegistrationInfoDataSource{}
+
+type VirtualDesktopHostPoolRegistrationInfoDataSourceModel struct {
+	HostPoolId     string `tfschema:"hostpool_id"`
+	ExpirationDate string `tfschema:"expiration_date"`
+	Valid          bool   `tfschema:"valid"`
+}
+
+func (d VirtualDesktopHostPoolRegistrationInfoDataSource) ModelObject() interface{} {
+	return &VirtualDesktopHostPoolRegistrationInfoDataSourceModel{}
+}
+
+func (d VirtualDesktopHostPoolRegistrationInfoDataSource) ResourceType() string {
+	return "azurerm_virtual_desktop_host_pool_registration_info"
+}
+
+func (d VirtualDesktopHostPoolRegistrationInfoDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"hostpool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: hostpool.ValidateHostPoolID,
+		},
+	}
+}
+
+func (d VirtualDesktopHostPoolRegistrationInfoDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"expiration_date": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"valid": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (d VirtualDesktopHostPoolRegistrationInfoDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.HostPoolsClient
+
+			var state VirtualDesktopHostPoolRegistrationInfoDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			hostPoolId, err := hostpool.ParseHostPoolID(state.HostPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewHostPoolRegistrationInfoID(hostPoolId.SubscriptionId, hostPoolId.ResourceGroupName, hostPoolId.HostPoolName, "default")
+
+			resp, err := client.RetrieveRegistrationToken(ctx, *hostPoolId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", hostPoolId)
+				}
+				return fmt.Errorf("retrieving Registration Token for %s: %+v", hostPoolId, err)
+			}
+
+			state.HostPoolId = hostPoolId.ID()
+			if model := resp.Model; model != nil && pointer.From(model.Token) != "" {
+				state.ExpirationDate = pointer.From(model.ExpirationTime)
+				state.Valid = state.ExpirationDate != "" && !registrationTokenHasExpired(state.ExpirationDate)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualDesktopHostPoolRegistrationInfoDataSource struct{}
+
+func TestAccVirtualDesktopHostPoolRegistrationInfoDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_desktop_host_pool_registration_info", "test")
+	d := VirtualDesktopHostPoolRegistrationInfoDataSource{}
+
+	expirationTime := time.Now().UTC().AddDate(0, 0, 1).Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data, expirationTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("expiration_date").HasValue(expirationTime),
+				check.That(data.ResourceName).Key("valid").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (VirtualDesktopHostPoolRegistrationInfoDataSource) basic(data acceptance.TestData, expirationDate string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_desktop_host_pool_registration_info" "test" {
+  hostpool_id = azurerm_virtual_desktop_host_pool_registration_info.test.hostpool_id
+}
+`, VirtualDesktopHostPoolRegistrationInfoResource{}.basic(data, expirationDate))
+}
@@ -66,13 +66,32 @@ func resourceVirtualDesktopHostPoolRegistrationInfo() *pluginsdk.Resource {
 }
 
 func hostpoolRegistrationInfoCustomDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
-	if d.HasChange("expiration_date") {
-		if err := d.SetNewComputed("token"); err != nil {
-			return err
-		}
+	if !d.NewValueKnown("expiration_date") {
+		return d.SetNewComputed("token")
+	}
+
+	oldExpirationDate, newExpirationDate := d.GetChange("expiration_date")
+	tokenExpired := d.Id() != "" && registrationTokenHasExpired(oldExpirationDate.(string))
+
+	if !d.HasChange("expiration_date") && !tokenExpired {
 		return nil
 	}
-	return nil
+
+	// the token is rotated whenever the expiration date changes, or once the existing token has expired
+	if registrationTokenHasExpired(newExpirationDate.(string)) {
+		return fmt.Errorf("`expiration_date` must be in the future to generate a new registration token, got %q", newExpirationDate.(string))
+	}
+
+	return d.SetNewComputed("token")
+}
+
+func registrationTokenHasExpired(expirationDate string) bool {
+	expiresAt, err := time.Parse(time.RFC3339, expirationDate)
+	if err != nil {
+		return false
+	}
+
+	return !time.Now().Before(expiresAt)
 }
 
 func resourceVirtualDesktopHostPoolRegistrationInfoCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/sessionhost"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualDesktopSessionHostModel struct {
+	Name                     string                                 `tfschema:"name"`
+	HostPoolId               string                                 `tfschema:"host_pool_id"`
+	DrainModeEnabled         bool                                   `tfschema:"drain_mode_enabled"`
+	AssignedUser             string                                 `tfschema:"assigned_user"`
+	FriendlyName             string                                 `tfschema:"friendly_name"`
+	ForceDeleteEnabled       bool                                   `tfschema:"force_delete_enabled"`
+	AgentVersion             string                                 `tfschema:"agent_version"`
+	HealthCheck              []VirtualDesktopSessionHostHealthCheck `tfschema:"health_check"`
+	LastHeartbeat            string                                 `tfschema:"last_heartbeat"`
+	OsVersion                string                                 `tfschema:"os_version"`
+	Sessions                 int64                                  `tfschema:"sessions"`
+	Status                   string                                 `tfschema:"status"`
+	UpdateErrorMessage       string                                 `tfschema:"update_error_message"`
+	UpdateState              string                                 `tfschema:"update_state"`
+	VirtualMachineId         string                                 `tfschema:"virtual_machine_id"`
+	VirtualMachineResourceId string                                 `tfschema:"virtual_machine_resource_id"`
+}
+
+type VirtualDesktopSessionHostHealthCheck struct {
+	Name                string `tfschema:"name"`
+	Result              string `tfschema:"result"`
+	ErrorCode           int64  `tfschema:"error_code"`
+	Message             string `tfschema:"message"`
+	LastHealthCheckTime string `tfschema:"last_health_check_time"`
+}
+
+type VirtualDesktopSessionHostResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualDesktopSessionHostResource{}
+
+func (r VirtualDesktopSessionHostResource) ResourceType() string {
+	return "azurerm_virtual_desktop_session_host"
+}
+
+func (r VirtualDesktopSessionHostResource) ModelObject() interface{} {
+	return &VirtualDesktopSessionHostModel{}
+}
+
+func (r VirtualDesktopSessionHostResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sessionhost.ValidateSessionHostID
+}
+
+func (r VirtualDesktopSessionHostResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"host_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sessionhost.ValidateHostPoolID,
+		},
+
+		"drain_mode_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"assigned_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"friendly_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"force_delete_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r VirtualDesktopSessionHostResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"agent_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"health_check": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"result": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_health_check_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"last_heartbeat": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"sessions": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_machine_resource_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VirtualDesktopSessionHostResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.SessionHostsClient
+
+			var model VirtualDesktopSessionHostModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			hostPoolId, err := sessionhost.ParseHostPoolID(model.HostPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := sessionhost.NewSessionHostID(hostPoolId.SubscriptionId, hostPoolId.ResourceGroupName, hostPoolId.HostPoolName, model.Name)
+
+			// Session Hosts are added to the Host Pool by the agent running on the Virtual Machine when it registers
+			// using a Registration Token, as such we wait for the Session Host to become available before managing it
+			log.Printf("[DEBUG] Waiting for %s to be registered..", id)
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context has no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{"NotRegistered"},
+				Target:                    []string{"Registered"},
+				Refresh:                   virtualDesktopSessionHostRegistrationRefreshFunc(ctx, client, id),
+				MinTimeout:                15 * time.Second,
+				ContinuousTargetOccurence: 2,
+				Timeout:                   time.Until(deadline),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be registered: %+v", id, err)
+			}
+
+			payload := sessionhost.SessionHostPatch{
+				Properties: &sessionhost.SessionHostPatchProperties{
+					AllowNewSession: pointer.To(!model.DrainModeEnabled),
+				},
+			}
+
+			if model.AssignedUser != "" {
+				payload.Properties.AssignedUser = pointer.To(model.AssignedUser)
+			}
+
+			if model.FriendlyName != "" {
+				payload.Properties.FriendlyName = pointer.To(model.FriendlyName)
+			}
+
+			if _, err := client.Update(ctx, id, payload, sessionhost.DefaultUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualDesktopSessionHostResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.SessionHostsClient
+
+			id, err := sessionhost.ParseSessionHostID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualDesktopSessionHostModel{
+				Name:       id.SessionHostName,
+				HostPoolId: sessionhost.NewHostPoolID(id.SubscriptionId, id.ResourceGroupName, id.HostPoolName).ID(),
+				// this is a client-side only setting which only influences the behaviour during deletion
+				ForceDeleteEnabled: metadata.ResourceData.Get("force_delete_enabled").(bool),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.AgentVersion = pointer.From(props.AgentVersion)
+				state.AssignedUser = pointer.From(props.AssignedUser)
+				state.DrainModeEnabled = !pointer.From(props.AllowNewSession)
+				state.FriendlyName = pointer.From(props.FriendlyName)
+				state.HealthCheck = flattenVirtualDesktopSessionHostHealthChecks(props.SessionHostHealthCheckResults)
+				state.LastHeartbeat = pointer.From(props.LastHeartBeat)
+				state.OsVersion = pointer.From(props.OsVersion)
+				state.Sessions = pointer.From(props.Sessions)
+				state.Status = string(pointer.From(props.Status))
+				state.UpdateErrorMessage = pointer.From(props.UpdateErrorMessage)
+				state.UpdateState = string(pointer.From(props.UpdateState))
+				state.VirtualMachineId = pointer.From(props.VirtualMachineId)
+				state.VirtualMachineResourceId = pointer.From(props.ResourceId)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualDesktopSessionHostResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.SessionHostsClient
+
+			id, err := sessionhost.ParseSessionHostID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualDesktopSessionHostModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := sessionhost.SessionHostPatch{
+				Properties: &sessionhost.SessionHostPatchProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("drain_mode_enabled") {
+				payload.Properties.AllowNewSession = pointer.To(!model.DrainModeEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("assigned_user") {
+				payload.Properties.AssignedUser = pointer.To(model.AssignedUser)
+			}
+
+			if metadata.ResourceData.HasChange("friendly_name") {
+				payload.Properties.FriendlyName = pointer.To(model.FriendlyName)
+			}
+
+			if _, err := client.Update(ctx, *id, payload, sessionhost.DefaultUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualDesktopSessionHostResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DesktopVirtualization.SessionHostsClient
+
+			id, err := sessionhost.ParseSessionHostID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			forceDelete := metadata.ResourceData.Get("force_delete_enabled").(bool)
+
+			if !forceDelete {
+				// drain the Session Host so that no new sessions are started, then wait for the existing sessions to end
+				log.Printf("[DEBUG] Draining %s..", *id)
+				payload := sessionhost.SessionHostPatch{
+					Properties: &sessionhost.SessionHostPatchProperties{
+						AllowNewSession: pointer.To(false),
+					},
+				}
+				if _, err := client.Update(ctx, *id, payload, sessionhost.DefaultUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("draining %s: %+v", *id, err)
+				}
+
+				log.Printf("[DEBUG] Waiting for the sessions on %s to end..", *id)
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("internal-error: context has no deadline")
+				}
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending:    []string{"SessionsActive"},
+					Target:     []string{"Drained"},
+					Refresh:    virtualDesktopSessionHostSessionsRefreshFunc(ctx, client, *id),
+					MinTimeout: 30 * time.Second,
+					Timeout:    time.Until(deadline),
+				}
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for the sessions on %s to end: %+v", *id, err)
+				}
+			}
+
+			options := sessionhost.DeleteOperationOptions{
+				Force: pointer.To(forceDelete),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func virtualDesktopSessionHostRegistrationRefreshFunc(ctx context.Context, client *sessionhost.SessionHostClient, id sessionhost.SessionHostId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return resp, "NotRegistered", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return resp, "Registered", nil
+	}
+}
+
+func virtualDesktopSessionHostSessionsRefreshFunc(ctx context.Context, client *sessionhost.SessionHostClient, id sessionhost.SessionHostId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return resp, "Drained", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if model := resp.Model; model != nil && model.Properties != nil {
+			if sessions := pointer.From(model.Properties.Sessions); sessions > 0 {
+				log.Printf("[DEBUG] %s still has %d active sessions", id, sessions)
+				return resp, "SessionsActive", nil
+			}
+		}
+
+		return resp, "Drained", nil
+	}
+}
+
+func flattenVirtualDesktopSessionHostHealthChecks(input *[]sessionhost.SessionHostHealthCheckReport) []VirtualDesktopSessionHostHealthCheck {
+	results := make([]VirtualDesktopSessionHostHealthCheck, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		result := VirtualDesktopSessionHostHealthCheck{
+			Name:   string(pointer.From(v.HealthCheckName)),
+			Result: string(pointer.From(v.HealthCheckResult)),
+		}
+
+		if details := v.AdditionalFailureDetails; details != nil {
+			result.ErrorCode = pointer.From(details.ErrorCode)
+			result.Message = pointer.From(details.Message)
+			result.LastHealthCheckTime = pointer.From(details.LastHealthCheckDateTime)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
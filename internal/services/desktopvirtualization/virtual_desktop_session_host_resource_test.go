@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/sessionhost"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualDesktopSessionHostResource struct{}
+
+func TestAccVirtualDesktopSessionHost_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_session_host", "test")
+	r := VirtualDesktopSessionHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").IsNotEmpty(),
+				check.That(data.ResourceName).Key("virtual_machine_resource_id").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("force_delete_enabled"),
+	})
+}
+
+func TestAccVirtualDesktopSessionHost_drainMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_session_host", "test")
+	r := VirtualDesktopSessionHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_delete_enabled"),
+		{
+			Config: r.drainMode(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("drain_mode_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("force_delete_enabled"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("force_delete_enabled"),
+	})
+}
+
+func (VirtualDesktopSessionHostResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sessionhost.ParseSessionHostID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DesktopVirtualization.SessionHostsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VirtualDesktopSessionHostResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_session_host" "test" {
+  name                 = azurerm_windows_virtual_machine.test.name
+  host_pool_id         = azurerm_virtual_desktop_host_pool.test.id
+  force_delete_enabled = true
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, r.template(data))
+}
+
+func (r VirtualDesktopSessionHostResource) drainMode(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_session_host" "test" {
+  name                 = azurerm_windows_virtual_machine.test.name
+  host_pool_id         = azurerm_virtual_desktop_host_pool.test.id
+  drain_mode_enabled   = true
+  force_delete_enabled = true
+
+  depends_on = [azurerm_virtual_machine_extension.test]
+}
+`, r.template(data))
+}
+
+func (VirtualDesktopSessionHostResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktopsh-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                = "acctestHP%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Pooled"
+  load_balancer_type  = "BreadthFirst"
+}
+
+resource "azurerm_virtual_desktop_host_pool_registration_info" "test" {
+  hostpool_id     = azurerm_virtual_desktop_host_pool.test.id
+  expiration_date = timeadd(timestamp(), "48h")
+
+  lifecycle {
+    ignore_changes = [expiration_date]
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_windows_virtual_machine" "test" {
+  name                = "acctvm%[4]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  size                = "Standard_D2s_v3"
+  admin_username      = "adminuser"
+  admin_password      = "P@$$w0rd1234!"
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsDesktop"
+    offer     = "windows-11"
+    sku       = "win11-23h2-avd"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_virtual_machine_extension" "aad" {
+  name                       = "AADLoginForWindows"
+  virtual_machine_id         = azurerm_windows_virtual_machine.test.id
+  publisher                  = "Microsoft.Azure.ActiveDirectory"
+  type                       = "AADLoginForWindows"
+  type_handler_version       = "2.0"
+  auto_upgrade_minor_version = true
+}
+
+resource "azurerm_virtual_machine_extension" "test" {
+  name                       = "AddSessionHost"
+  virtual_machine_id         = azurerm_windows_virtual_machine.test.id
+  publisher                  = "Microsoft.Powershell"
+  type                       = "DSC"
+  type_handler_version       = "2.73"
+  auto_upgrade_minor_version = true
+
+  settings = <<SETTINGS
+{
+  "modulesUrl": "https://wvdportalstorageblob.blob.core.windows.net/galleryartifacts/Configuration_1.0.02714.342.zip",
+  "configurationFunction": "Configuration.ps1\\AddSessionHost",
+  "properties": {
+    "hostPoolName": "${azurerm_virtual_desktop_host_pool.test.name}",
+    "aadJoin": true
+  }
+}
+SETTINGS
+
+  protected_settings = <<PROTECTED_SETTINGS
+{
+  "properties": {
+    "registrationInfoToken": "${azurerm_virtual_desktop_host_pool_registration_info.test.token}"
+  }
+}
+PROTECTED_SETTINGS
+
+  depends_on = [azurerm_virtual_machine_extension.aad]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString[0:5])
+}
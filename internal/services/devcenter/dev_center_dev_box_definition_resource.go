@@ -0,0 +1,437 @@
+package devcenter
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/images"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/imageversions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = DevCenterDevBoxDefinitionResource{}
+	_ sdk.ResourceWithCustomizeDiff = DevCenterDevBoxDefinitionResource{}
+)
+
+type DevCenterDevBoxDefinitionResource struct{}
+
+func (r DevCenterDevBoxDefinitionResource) ModelObject() interface{} {
+	return &DevCenterDevBoxDefinitionResourceSchema{}
+}
+
+type DevCenterDevBoxDefinitionResourceSchema struct {
+	Name                        string            `tfschema:"name"`
+	Location                    string            `tfschema:"location"`
+	DevCenterId                 string            `tfschema:"dev_center_id"`
+	ImageReferenceId            string            `tfschema:"image_reference_id"`
+	SkuName                     string            `tfschema:"sku_name"`
+	OsStorageType               string            `tfschema:"os_storage_type"`
+	HibernateSupportEnabled     bool              `tfschema:"hibernate_support_enabled"`
+	Tags                        map[string]string `tfschema:"tags"`
+	ActiveImageReferenceId      string            `tfschema:"active_image_reference_id"`
+	ActiveImageVersion          string            `tfschema:"active_image_version"`
+	ImageValidationStatus       string            `tfschema:"image_validation_status"`
+	ImageValidationErrorCode    string            `tfschema:"image_validation_error_code"`
+	ImageValidationErrorMessage string            `tfschema:"image_validation_error_message"`
+}
+
+func (r DevCenterDevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return devboxdefinitions.ValidateDevCenterDevBoxDefinitionID
+}
+
+func (r DevCenterDevBoxDefinitionResource) ResourceType() string {
+	return "azurerm_dev_center_dev_box_definition"
+}
+
+func (r DevCenterDevBoxDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devboxdefinitions.ValidateDevCenterID,
+		},
+
+		"image_reference_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_storage_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"ssd_256gb",
+				"ssd_512gb",
+				"ssd_1024gb",
+				"ssd_2048gb",
+			}, false),
+		},
+
+		"hibernate_support_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"active_image_reference_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"active_image_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image_validation_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image_validation_error_code": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"image_validation_error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			var config DevCenterDevBoxDefinitionResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := devboxdefinitions.ParseDevCenterID(config.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := devboxdefinitions.NewDevCenterDevBoxDefinitionID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinition{
+				Location: location.Normalize(config.Location),
+				Properties: &devboxdefinitions.DevBoxDefinitionProperties{
+					HibernateSupport: expandDevBoxDefinitionHibernateSupport(config.HibernateSupportEnabled),
+					ImageReference: &devboxdefinitions.ImageReference{
+						Id: pointer.To(config.ImageReferenceId),
+					},
+					Sku: &devboxdefinitions.Sku{
+						Name: config.SkuName,
+					},
+				},
+				Tags: pointer.To(config.Tags),
+			}
+
+			if config.OsStorageType != "" {
+				payload.Properties.OsStorageType = pointer.To(config.OsStorageType)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			schema := DevCenterDevBoxDefinitionResourceSchema{
+				Name:        id.DevBoxDefinitionName,
+				DevCenterId: devboxdefinitions.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				schema.Location = location.Normalize(model.Location)
+				schema.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					schema.HibernateSupportEnabled = pointer.From(props.HibernateSupport) == devboxdefinitions.HibernateSupportEnabled
+					schema.OsStorageType = pointer.From(props.OsStorageType)
+					schema.ImageValidationStatus = string(pointer.From(props.ImageValidationStatus))
+
+					if v := props.ImageReference; v != nil {
+						schema.ImageReferenceId = pointer.From(v.Id)
+					}
+
+					if v := props.ActiveImageReference; v != nil {
+						schema.ActiveImageReferenceId = pointer.From(v.Id)
+						schema.ActiveImageVersion = pointer.From(v.ExactVersion)
+					}
+
+					if v := props.ImageValidationErrorDetails; v != nil {
+						schema.ImageValidationErrorCode = pointer.From(v.Code)
+						schema.ImageValidationErrorMessage = pointer.From(v.Message)
+					}
+
+					if v := props.Sku; v != nil {
+						schema.SkuName = v.Name
+					}
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config DevCenterDevBoxDefinitionResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinitionUpdate{
+				Properties: &devboxdefinitions.DevBoxDefinitionUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("image_reference_id") {
+				payload.Properties.ImageReference = &devboxdefinitions.ImageReference{
+					Id: pointer.To(config.ImageReferenceId),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("sku_name") {
+				payload.Properties.Sku = &devboxdefinitions.Sku{
+					Name: config.SkuName,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("os_storage_type") {
+				payload.Properties.OsStorageType = pointer.To(config.OsStorageType)
+			}
+
+			if metadata.ResourceData.HasChange("hibernate_support_enabled") {
+				payload.Properties.HibernateSupport = expandDevBoxDefinitionHibernateSupport(config.HibernateSupportEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.V20230401.DevBoxDefinitions
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// the supported SKUs and the capabilities of the image can only be checked once these values are known
+			skuName := diff.Get("sku_name").(string)
+			loc := diff.Get("location").(string)
+			if skuName != "" && loc != "" && diff.NewValueKnown("sku_name") && diff.NewValueKnown("location") && diff.HasChanges("sku_name", "location") {
+				subscriptionId := commonids.NewSubscriptionID(metadata.Client.Account.SubscriptionId)
+				if err := validateDevBoxDefinitionSku(ctx, metadata.Client.DevCenter.V20230401.SKUs, subscriptionId, skuName, loc); err != nil {
+					return err
+				}
+			}
+
+			imageReferenceId := diff.Get("image_reference_id").(string)
+			hibernateSupportEnabled := diff.Get("hibernate_support_enabled").(bool)
+			if imageReferenceId != "" && hibernateSupportEnabled && diff.NewValueKnown("image_reference_id") && diff.HasChanges("image_reference_id", "hibernate_support_enabled") {
+				if err := validateDevBoxDefinitionImageSupportsHibernation(ctx, metadata.Client.DevCenter.V20230401.Images, imageReferenceId); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func validateDevBoxDefinitionSku(ctx context.Context, client *skus.SKUsClient, subscriptionId commonids.SubscriptionId, skuName string, loc string) error {
+	resp, err := client.ListBySubscriptionComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("retrieving the Dev Center SKUs available within %s: %+v", subscriptionId, err)
+	}
+
+	available := make([]string, 0)
+	for _, sku := range resp.Items {
+		if sku.Locations != nil && !devBoxDefinitionSkuSupportsLocation(*sku.Locations, loc) {
+			continue
+		}
+
+		if strings.EqualFold(sku.Name, skuName) {
+			return nil
+		}
+		available = append(available, sku.Name)
+	}
+
+	sort.Strings(available)
+	return fmt.Errorf("the SKU %q is not available for Dev Box Definitions in %q - supported SKUs are: %s", skuName, loc, strings.Join(available, ", "))
+}
+
+func devBoxDefinitionSkuSupportsLocation(locations []string, loc string) bool {
+	for _, v := range locations {
+		if location.Normalize(v) == location.Normalize(loc) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateDevBoxDefinitionImageSupportsHibernation(ctx context.Context, client *images.ImagesClient, imageReferenceId string) error {
+	imageId, err := parseDevBoxDefinitionImageReferenceId(imageReferenceId)
+	if err != nil {
+		// the image reference isn't a Dev Center Gallery Image, as such it's validated by the API during apply
+		return nil
+	}
+
+	resp, err := client.Get(ctx, *imageId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("the image referenced by `image_reference_id` (%s) was not found", *imageId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *imageId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if pointer.From(model.Properties.HibernateSupport) != images.HibernateSupportEnabled {
+			return fmt.Errorf("`hibernate_support_enabled` cannot be set to `true` since %s doesn't support hibernation", *imageId)
+		}
+	}
+
+	return nil
+}
+
+// parseDevBoxDefinitionImageReferenceId parses either a Dev Center Gallery Image ID or a Dev Center Gallery Image Version ID
+// into the ID of the Dev Center Gallery Image
+func parseDevBoxDefinitionImageReferenceId(input string) (*images.ImageId, error) {
+	if imageId, err := images.ParseImageIDInsensitively(input); err == nil {
+		return imageId, nil
+	}
+
+	versionId, err := imageversions.ParseVersionIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	imageId := images.NewImageID(versionId.SubscriptionId, versionId.ResourceGroupName, versionId.DevCenterName, versionId.GalleryName, versionId.ImageName)
+	return &imageId, nil
+}
+
+func expandDevBoxDefinitionHibernateSupport(input bool) *devboxdefinitions.HibernateSupport {
+	if input {
+		return pointer.To(devboxdefinitions.HibernateSupportEnabled)
+	}
+
+	return pointer.To(devboxdefinitions.HibernateSupportDisabled)
+}
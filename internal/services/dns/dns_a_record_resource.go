@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
@@ -91,6 +92,11 @@ func resourceDnsARecord() *pluginsdk.Resource {
 				// TODO: switch ConflictsWith for ExactlyOneOf when the Provider SDK's updated
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -144,7 +150,7 @@ func resourceDnsARecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("One of either `records` or `target_resource_id` must be specified")
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id, parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+	if err := createOrUpdateDnsRecordSet(ctx, client, id, parameters, d); err != nil {
 		return fmt.Errorf("creating/updating DNS A Record %q (Zone %q / Resource Group %q): %s", name, zoneName, resGroup, err)
 	}
 
@@ -177,6 +183,8 @@ func resourceDnsARecordRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("zone_name", id.DnsZoneName)
 
 	if model := resp.Model; model != nil {
+		d.Set("etag", pointer.From(model.Etag))
+
 		if props := model.Properties; props != nil {
 			d.Set("fqdn", props.Fqdn)
 			d.Set("ttl", props.TTL)
@@ -5,12 +5,15 @@ package dns
 
 import (
 	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
@@ -81,6 +84,11 @@ func resourceDnsNsRecord() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -123,7 +131,7 @@ func resourceDnsNsRecordCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		},
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id, parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+	if err := createOrUpdateDnsRecordSet(ctx, client, id, parameters, d); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -167,7 +175,16 @@ func resourceDnsNsRecordUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		existing.Model.Properties.TTL = utils.Int64(int64(d.Get("ttl").(int)))
 	}
 
-	if _, err := client.Update(ctx, *id, *existing.Model, recordsets.DefaultUpdateOperationOptions()); err != nil {
+	// the ETag from the state (rather than the one just retrieved) is used, so that any changes made outside of
+	// Terraform since the last refresh aren't overwritten
+	err = azure.WriteWithETag(id, false, d.Get("etag").(string), func(ifMatch *string, _ *string) (*http.Response, error) {
+		options := recordsets.UpdateOperationOptions{
+			IfMatch: ifMatch,
+		}
+		resp, err := client.Update(ctx, *id, *existing.Model, options)
+		return resp.HttpResponse, err
+	})
+	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
@@ -198,6 +215,8 @@ func resourceDnsNsRecordRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("zone_name", id.DnsZoneName)
 
 	if model := resp.Model; model != nil {
+		d.Set("etag", pointer.From(model.Etag))
+
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.TTL)
 			d.Set("fqdn", props.Fqdn)
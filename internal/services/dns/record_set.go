@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// createOrUpdateDnsRecordSet creates or updates the DNS Record Set, only updating an existing Record Set when it hasn't been
+// modified since the `etag` in the state was read
+func createOrUpdateDnsRecordSet(ctx context.Context, client *recordsets.RecordSetsClient, id recordsets.RecordTypeId, parameters recordsets.RecordSet, d *pluginsdk.ResourceData) error {
+	return azure.WriteWithETag(id, d.IsNewResource(), d.Get("etag").(string), func(ifMatch *string, ifNoneMatch *string) (*http.Response, error) {
+		options := recordsets.CreateOrUpdateOperationOptions{
+			IfMatch:     ifMatch,
+			IfNoneMatch: ifNoneMatch,
+		}
+		resp, err := client.CreateOrUpdate(ctx, id, parameters, options)
+		return resp.HttpResponse, err
+	})
+}
@@ -351,6 +351,9 @@ func expandEventSubscriptionIdentity(input []interface{}) (*eventsubscriptions.E
 
 	userAssignedIdentity := identity["user_assigned_identity"].(string)
 	if identityType == eventsubscriptions.EventSubscriptionIdentityTypeUserAssigned {
+		if userAssignedIdentity == "" {
+			return nil, fmt.Errorf("`user_assigned_identity` must be specified when `type` is `UserAssigned`")
+		}
 		eventgridIdentity.UserAssignedIdentity = pointer.To(userAssignedIdentity)
 	} else if len(userAssignedIdentity) > 0 {
 		return nil, fmt.Errorf("`user_assigned_identity` can only be specified when `type` is `UserAssigned`; but `type` is currently %q", identityType)
@@ -4,15 +4,17 @@
 package eventhub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
@@ -36,6 +38,8 @@ func resourceEventHub() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceEventHubCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -137,6 +141,28 @@ func resourceEventHub() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: commonids.ValidateStorageAccountID,
 									},
+									"identity": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"type": {
+													Type:     pluginsdk.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice(
+														eventhubs.PossibleValuesForCaptureIdentityType(),
+														false,
+													),
+												},
+												"user_assigned_identity_id": {
+													Type:         pluginsdk.TypeString,
+													Optional:     true,
+													ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -360,7 +386,8 @@ func expandEventHubCaptureDescription(d *pluginsdk.ResourceData) *eventhubs.Capt
 			storageAccountId := destination["storage_account_id"].(string)
 
 			captureDescription.Destination = &eventhubs.Destination{
-				Name: utils.String(destinationName),
+				Identity: expandEventHubCaptureIdentity(destination["identity"].([]interface{})),
+				Name:     utils.String(destinationName),
 				Properties: &eventhubs.DestinationProperties{
 					ArchiveNameFormat:        utils.String(archiveNameFormat),
 					BlobContainer:            utils.String(blobContainerName),
@@ -420,6 +447,8 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 				}
 			}
 
+			destinationOutput["identity"] = flattenEventHubCaptureIdentity(destination.Identity)
+
 			output["destination"] = []interface{}{destinationOutput}
 		}
 
@@ -428,3 +457,55 @@ func flattenEventHubCaptureDescription(description *eventhubs.CaptureDescription
 
 	return results
 }
+
+func expandEventHubCaptureIdentity(input []interface{}) *eventhubs.CaptureIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	identity := eventhubs.CaptureIdentity{
+		Type: pointer.To(eventhubs.CaptureIdentityType(v["type"].(string))),
+	}
+
+	if userAssignedIdentityId := v["user_assigned_identity_id"].(string); userAssignedIdentityId != "" {
+		identity.UserAssignedIdentity = pointer.To(userAssignedIdentityId)
+	}
+
+	return &identity
+}
+
+func flattenEventHubCaptureIdentity(input *eventhubs.CaptureIdentity) []interface{} {
+	if input == nil || input.Type == nil {
+		return []interface{}{}
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentity != nil {
+		if id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.UserAssignedIdentity); err == nil {
+			userAssignedIdentityId = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      string(*input.Type),
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
+
+func resourceEventHubCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	identityType := d.Get("capture_description.0.destination.0.identity.0.type").(string)
+	userAssignedIdentityId := d.Get("capture_description.0.destination.0.identity.0.user_assigned_identity_id").(string)
+
+	if identityType == string(eventhubs.CaptureIdentityTypeUserAssigned) && userAssignedIdentityId == "" && d.NewValueKnown("capture_description.0.destination.0.identity.0.user_assigned_identity_id") {
+		return fmt.Errorf("`user_assigned_identity_id` must be specified when the capture `identity` type is `UserAssigned`")
+	}
+
+	if identityType == string(eventhubs.CaptureIdentityTypeSystemAssigned) && userAssignedIdentityId != "" {
+		return fmt.Errorf("`user_assigned_identity_id` cannot be specified when the capture `identity` type is `SystemAssigned`")
+	}
+
+	return nil
+}
@@ -123,6 +123,16 @@ func firewallDataSource() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"fat_flow_logging_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"flow_trace_logging_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"virtual_hub": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -206,6 +216,10 @@ func firewallDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				return fmt.Errorf("setting `dns_servers`: %+v", err)
 			}
 
+			fatFlowLoggingEnabled, flowTraceLoggingEnabled := flattenFirewallAdditionalLogs(props.AdditionalProperties)
+			d.Set("fat_flow_logging_enabled", fatFlowLoggingEnabled)
+			d.Set("flow_trace_logging_enabled", flowTraceLoggingEnabled)
+
 			if policy := props.FirewallPolicy; policy != nil {
 				d.Set("firewall_policy_id", policy.Id)
 			}
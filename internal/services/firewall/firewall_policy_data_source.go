@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func FirewallDataSourcePolicy() *pluginsdk.Resource {
@@ -119,6 +120,52 @@ func FirewallDataSourcePolicy() *pluginsdk.Resource {
 				},
 			},
 
+			"private_ip_ranges": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"auto_learn_private_ranges_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"explicit_proxy": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+						"http_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+						"https_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+						"enable_pac_file": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+						"pac_file_port": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+						"pac_file": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -171,6 +218,20 @@ func FirewallDataSourcePolicyRead(d *pluginsdk.ResourceData, meta interface{}) e
 			if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(props.ThreatIntelWhitelist)); err != nil {
 				return fmt.Errorf(`setting "threat_intelligence_allowlist": %+v`, err)
 			}
+
+			var privateIPRanges []interface{}
+			var isAutoLearnPrivateRangeEnabled bool
+			if props.Snat != nil {
+				privateIPRanges = utils.FlattenStringSlice(props.Snat.PrivateRanges)
+				isAutoLearnPrivateRangeEnabled = pointer.From(props.Snat.AutoLearnPrivateRanges) == firewallpolicies.AutoLearnPrivateRangesModeEnabled
+			}
+			if err := d.Set("private_ip_ranges", privateIPRanges); err != nil {
+				return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
+			}
+			d.Set("auto_learn_private_ranges_enabled", isAutoLearnPrivateRangeEnabled)
+			if err := d.Set("explicit_proxy", flattenFirewallPolicyExplicitProxy(props.ExplicitProxy)); err != nil {
+				return fmt.Errorf("setting `explicit_proxy`: %+v", err)
+			}
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
@@ -39,6 +39,25 @@ func TestAccFirewallPolicyDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyDataSource_explicitProxy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_firewall_policy", "test")
+	r := FirewallPolicyDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.explicitProxy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("auto_learn_private_ranges_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("explicit_proxy.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("explicit_proxy.0.http_port").HasValue("8087"),
+				check.That(data.ResourceName).Key("explicit_proxy.0.https_port").HasValue("8088"),
+				check.That(data.ResourceName).Key("explicit_proxy.0.enable_pac_file").HasValue("true"),
+				check.That(data.ResourceName).Key("explicit_proxy.0.pac_file_port").HasValue("8089"),
+			),
+		},
+	})
+}
+
 func (FirewallPolicyDataSource) basic(data acceptance.TestData) string {
 	// We deliberately set add a dependency between "data.azurerm_firewall_policy.test-parent"
 	// and "azurerm_firewall_policy.test" so that we can test "data.azurerm_firewall_policy.test-parent.child_policies"
@@ -56,3 +75,14 @@ data "azurerm_firewall_policy" "test" {
 }
 `, FirewallPolicyResource{}.inherit(data))
 }
+
+func (FirewallPolicyDataSource) explicitProxy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_firewall_policy" "test" {
+  name                = azurerm_firewall_policy.test.name
+  resource_group_name = azurerm_firewall_policy.test.resource_group_name
+}
+`, FirewallPolicyResource{}.complete(data))
+}
@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -50,6 +51,8 @@ func resourceFirewallPolicy() *pluginsdk.Resource {
 		},
 
 		Schema: resourceFirewallPolicySchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallPolicyExplicitProxyCustomizeDiff),
 	}
 }
 
@@ -402,6 +405,44 @@ func expandFirewallPolicyExplicitProxy(input []interface{}) *firewallpolicies.Ex
 	return output
 }
 
+func resourceFirewallPolicyExplicitProxyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	raw := d.Get("explicit_proxy").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	proxy := raw[0].(map[string]interface{})
+
+	if !proxy["enabled"].(bool) {
+		if proxy["enable_pac_file"].(bool) {
+			return fmt.Errorf("`explicit_proxy.0.enable_pac_file` can only be set to `true` when `explicit_proxy.0.enabled` is set to `true`")
+		}
+		return nil
+	}
+
+	// the ports are only validated once known, since they may be interpolated from other resources
+	if d.NewValueKnown("explicit_proxy.0.http_port") && d.NewValueKnown("explicit_proxy.0.https_port") {
+		httpPort := proxy["http_port"].(int)
+		httpsPort := proxy["https_port"].(int)
+		if httpPort == 0 && httpsPort == 0 {
+			return fmt.Errorf("at least one of `explicit_proxy.0.http_port` and `explicit_proxy.0.https_port` must be specified when `explicit_proxy.0.enabled` is set to `true`")
+		}
+		if httpPort != 0 && httpPort == httpsPort {
+			return fmt.Errorf("`explicit_proxy.0.http_port` and `explicit_proxy.0.https_port` must not be the same port")
+		}
+	}
+
+	if proxy["enable_pac_file"].(bool) {
+		if d.NewValueKnown("explicit_proxy.0.pac_file") && proxy["pac_file"].(string) == "" {
+			return fmt.Errorf("`explicit_proxy.0.pac_file` must be specified when `explicit_proxy.0.enable_pac_file` is set to `true`")
+		}
+		if d.NewValueKnown("explicit_proxy.0.pac_file_port") && proxy["pac_file_port"].(int) == 0 {
+			return fmt.Errorf("`explicit_proxy.0.pac_file_port` must be specified when `explicit_proxy.0.enable_pac_file` is set to `true`")
+		}
+	}
+
+	return nil
+}
+
 func expandFirewallPolicyLogAnalyticsResources(defaultWorkspaceId string, workspaces []interface{}) *firewallpolicies.FirewallPolicyLogAnalyticsResources {
 	output := &firewallpolicies.FirewallPolicyLogAnalyticsResources{
 		DefaultWorkspaceId: &firewallpolicies.SubResource{
@@ -913,12 +954,12 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 					"http_port": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
-						ValidateFunc: validation.IntBetween(0, 35536),
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"https_port": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
-						ValidateFunc: validation.IntBetween(0, 35536),
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"enable_pac_file": {
 						Type:     pluginsdk.TypeBool,
@@ -927,7 +968,7 @@ func resourceFirewallPolicySchema() map[string]*pluginsdk.Schema {
 					"pac_file_port": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
-						ValidateFunc: validation.IntBetween(0, 35536),
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"pac_file": {
 						Type:         pluginsdk.TypeString,
@@ -6,6 +6,7 @@ package firewall_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -194,6 +195,18 @@ func TestAccFirewallPolicy_insights(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicy_explicitProxyPacFileMissing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy", "test")
+	r := FirewallPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.explicitProxyPacFileMissing(data),
+			ExpectError: regexp.MustCompile("`explicit_proxy.0.pac_file` must be specified"),
+		},
+	})
+}
+
 func (FirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := firewallpolicies.ParseFirewallPolicyID(state.ID)
 	if err != nil {
@@ -238,6 +251,27 @@ resource "azurerm_firewall_policy" "test" {
 `, template, data.RandomInteger)
 }
 
+func (FirewallPolicyResource) explicitProxyPacFileMissing(data acceptance.TestData) string {
+	r := FirewallPolicyResource{}
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-networkfw-Policy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  explicit_proxy {
+    enabled         = true
+    http_port       = 8087
+    https_port      = 8088
+    enable_pac_file = true
+    pac_file_port   = 8089
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (FirewallPolicyResource) pacFile(data acceptance.TestData) string {
 	utcNow := time.Now().UTC()
 	startDate := utcNow.Format(time.RFC3339)
@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -33,6 +34,25 @@ import (
 
 var AzureFirewallResourceName = "azurerm_firewall"
 
+const (
+	firewallAdditionalPropertyFatFlowLogging   = "Network.AdditionalLogs.EnableFatFlowLogging"
+	firewallAdditionalPropertyFlowTraceLogging = "Network.AdditionalLogs.EnableTcpConnectionLogging"
+)
+
+// firewallSkuTierUpgradePaths contains the in-place changes of `sku_tier` supported by the API, a Basic Firewall can
+// only be upgraded to Standard, and a Standard Firewall can be upgraded to (or downgraded from) Premium.
+var firewallSkuTierUpgradePaths = map[azurefirewalls.AzureFirewallSkuTier][]azurefirewalls.AzureFirewallSkuTier{
+	azurefirewalls.AzureFirewallSkuTierBasic: {
+		azurefirewalls.AzureFirewallSkuTierStandard,
+	},
+	azurefirewalls.AzureFirewallSkuTierStandard: {
+		azurefirewalls.AzureFirewallSkuTierPremium,
+	},
+	azurefirewalls.AzureFirewallSkuTierPremium: {
+		azurefirewalls.AzureFirewallSkuTierStandard,
+	},
+}
+
 func resourceFirewall() *pluginsdk.Resource {
 	resource := pluginsdk.Resource{
 		Create: resourceFirewallCreateUpdate,
@@ -178,6 +198,18 @@ func resourceFirewall() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"fat_flow_logging_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"flow_trace_logging_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"private_ip_ranges": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -225,6 +257,8 @@ func resourceFirewall() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceFirewallSkuTierCustomizeDiff),
 	}
 
 	return &resource
@@ -347,6 +381,11 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	for k, v := range expandFirewallAdditionalLogs(d) {
+		attrs := *parameters.Properties.AdditionalProperties
+		attrs[k] = v
+	}
+
 	if policyId, ok := d.GetOk("firewall_policy_id"); ok {
 		id, _ := firewallpolicies.ParseFirewallPolicyID(policyId.(string))
 		locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
@@ -447,6 +486,10 @@ func resourceFirewallRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				return fmt.Errorf("setting `private_ip_ranges`: %+v", err)
 			}
 
+			fatFlowLoggingEnabled, flowTraceLoggingEnabled := flattenFirewallAdditionalLogs(props.AdditionalProperties)
+			d.Set("fat_flow_logging_enabled", fatFlowLoggingEnabled)
+			d.Set("flow_trace_logging_enabled", flowTraceLoggingEnabled)
+
 			firewallPolicyId := ""
 			if props.FirewallPolicy != nil && props.FirewallPolicy.Id != nil {
 				firewallPolicyId = *props.FirewallPolicy.Id
@@ -719,6 +762,27 @@ func flattenFirewallPrivateIpRange(input *map[string]string) []interface{} {
 	return utils.FlattenStringSlice(&rangeSlice)
 }
 
+func expandFirewallAdditionalLogs(d *pluginsdk.ResourceData) map[string]string {
+	// these are only surfaced via the `additionalProperties` of the Firewall, omitting them disables the logs
+	res := map[string]string{}
+	if d.Get("fat_flow_logging_enabled").(bool) {
+		res[firewallAdditionalPropertyFatFlowLogging] = "True"
+	}
+	if d.Get("flow_trace_logging_enabled").(bool) {
+		res[firewallAdditionalPropertyFlowTraceLogging] = "True"
+	}
+	return res
+}
+
+func flattenFirewallAdditionalLogs(input *map[string]string) (fatFlowLoggingEnabled bool, flowTraceLoggingEnabled bool) {
+	if input == nil {
+		return false, false
+	}
+
+	attrs := *input
+	return strings.EqualFold(attrs[firewallAdditionalPropertyFatFlowLogging], "true"), strings.EqualFold(attrs[firewallAdditionalPropertyFlowTraceLogging], "true")
+}
+
 func expandFirewallVirtualHubSetting(existing *azurefirewalls.AzureFirewall, input []interface{}) (vhub *azurefirewalls.SubResource, ipAddresses *azurefirewalls.HubIPAddresses, ok bool) {
 	if len(input) == 0 {
 		return nil, nil, false
@@ -811,6 +875,29 @@ func flattenFirewallVirtualHubSetting(props *azurefirewalls.AzureFirewallPropert
 	}
 }
 
+func resourceFirewallSkuTierCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("sku_tier") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("sku_tier")
+	return validateFirewallSkuTierChange(oldRaw.(string), newRaw.(string))
+}
+
+func validateFirewallSkuTierChange(oldTier, newTier string) error {
+	if oldTier == "" || newTier == "" || oldTier == newTier {
+		return nil
+	}
+
+	for _, allowed := range firewallSkuTierUpgradePaths[azurefirewalls.AzureFirewallSkuTier(oldTier)] {
+		if string(allowed) == newTier {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`sku_tier` cannot be changed from %q to %q, the supported upgrade path is `Basic` -> `Standard` -> `Premium` (and `Premium` -> `Standard`)", oldTier, newTier)
+}
+
 func validateFirewallIPConfigurationSettings(configs []interface{}) error {
 	if len(configs) == 0 {
 		return nil
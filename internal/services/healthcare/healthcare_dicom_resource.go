@@ -11,17 +11,19 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/dicomservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2024-03-31/dicomservices"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
@@ -115,6 +117,37 @@ func resourceHealthcareApisDicomService() *pluginsdk.Resource {
 				Default:  true,
 			},
 
+			"data_partitions_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"storage": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_account_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: commonids.ValidateStorageAccountID,
+						},
+
+						"file_system_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -156,7 +189,9 @@ func resourceHealthcareApisDicomServiceCreate(d *pluginsdk.ResourceData, meta in
 	parameters := dicomservices.DicomService{
 		Identity: i,
 		Properties: &dicomservices.DicomServiceProperties{
-			PublicNetworkAccess: pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			EnableDataPartitions: pointer.To(d.Get("data_partitions_enabled").(bool)),
+			PublicNetworkAccess:  pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			StorageConfiguration: expandDicomStorageConfiguration(d.Get("storage").([]interface{})),
 		},
 		Location: pointer.To(location.Normalize(d.Get("location").(string))),
 		Tags:     tags.Expand(t),
@@ -205,6 +240,15 @@ func resourceHealthcareApisDicomServiceRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("authentication", flattenDicomAuthentication(props.AuthenticationConfiguration))
 			d.Set("private_endpoint", flattenDicomServicePrivateEndpoint(props.PrivateEndpointConnections))
 			d.Set("service_url", props.ServiceUrl)
+			d.Set("data_partitions_enabled", pointer.From(props.EnableDataPartitions))
+
+			storage, err := flattenDicomStorageConfiguration(props.StorageConfiguration)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("storage", storage); err != nil {
+				return fmt.Errorf("setting `storage`: %+v", err)
+			}
 
 			if pna := pointer.From(props.PublicNetworkAccess); pna != "" {
 				d.Set("public_network_access_enabled", pointer.From(props.PublicNetworkAccess) == dicomservices.PublicNetworkAccessEnabled)
@@ -242,7 +286,9 @@ func resourceHealthcareApisDicomServiceUpdate(d *pluginsdk.ResourceData, meta in
 	parameters := dicomservices.DicomService{
 		Location: pointer.To(location.Normalize(d.Get("location").(string))),
 		Properties: &dicomservices.DicomServiceProperties{
-			PublicNetworkAccess: pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			EnableDataPartitions: pointer.To(d.Get("data_partitions_enabled").(bool)),
+			PublicNetworkAccess:  pointer.To(dicomservices.PublicNetworkAccessEnabled),
+			StorageConfiguration: expandDicomStorageConfiguration(d.Get("storage").([]interface{})),
 		},
 		Identity: i,
 	}
@@ -373,3 +419,34 @@ func flattenDicomServicePrivateEndpoint(input *[]dicomservices.PrivateEndpointCo
 	}
 	return results
 }
+
+func expandDicomStorageConfiguration(input []interface{}) *dicomservices.StorageConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &dicomservices.StorageConfiguration{
+		FileSystemName:    pointer.To(v["file_system_name"].(string)),
+		StorageResourceId: pointer.To(v["storage_account_id"].(string)),
+	}
+}
+
+func flattenDicomStorageConfiguration(input *dicomservices.StorageConfiguration) ([]interface{}, error) {
+	if input == nil || input.StorageResourceId == nil {
+		return []interface{}{}, nil
+	}
+
+	storageAccountId, err := commonids.ParseStorageAccountIDInsensitively(*input.StorageResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"file_system_name":   pointer.From(input.FileSystemName),
+			"storage_account_id": storageAccountId.ID(),
+		},
+	}, nil
+}
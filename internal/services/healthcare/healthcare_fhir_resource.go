@@ -219,6 +219,33 @@ func resourceHealthcareApisFhirService() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"import_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"integration_data_store_storage_account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"initial_import_mode_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -282,6 +309,8 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	parameters.Properties.ImportConfiguration = expandFhirImportConfiguration(d.Get("import_configuration").([]interface{}))
+
 	acrConfig := fhirservices.FhirServiceAcrConfiguration{}
 	ociArtifactsRaw, hasValues := d.GetOk("oci_artifact")
 	if hasValues {
@@ -366,6 +395,7 @@ func resourceHealthcareApisFhirServiceRead(d *pluginsdk.ResourceData, meta inter
 			if props.ExportConfiguration != nil && props.ExportConfiguration.StorageAccountName != nil {
 				d.Set("configuration_export_storage_account_name", props.ExportConfiguration.StorageAccountName)
 			}
+			d.Set("import_configuration", flattenFhirImportConfiguration(props.ImportConfiguration))
 			if props.PublicNetworkAccess != nil {
 				d.Set("public_network_access_enabled", pointer.From(props.PublicNetworkAccess) == fhirservices.PublicNetworkAccessEnabled)
 			}
@@ -436,6 +466,8 @@ func resourceHealthcareApisFhirServiceUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	parameters.Properties.ImportConfiguration = expandFhirImportConfiguration(d.Get("import_configuration").([]interface{}))
+
 	acrConfig := fhirservices.FhirServiceAcrConfiguration{}
 	ociArtifactsRaw, hasValues := d.GetOk("oci_artifact")
 	if hasValues {
@@ -567,6 +599,36 @@ func expandFhirCorsConfiguration(input []interface{}) *fhirservices.FhirServiceC
 	return cors
 }
 
+func expandFhirImportConfiguration(input []interface{}) *fhirservices.FhirServiceImportConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return &fhirservices.FhirServiceImportConfiguration{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &fhirservices.FhirServiceImportConfiguration{
+		Enabled:              pointer.To(v["enabled"].(bool)),
+		InitialImportMode:    pointer.To(v["initial_import_mode_enabled"].(bool)),
+		IntegrationDataStore: pointer.To(v["integration_data_store_storage_account_name"].(string)),
+	}
+}
+
+func flattenFhirImportConfiguration(input *fhirservices.FhirServiceImportConfiguration) []interface{} {
+	if input == nil || pointer.From(input.IntegrationDataStore) == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                     pointer.From(input.Enabled),
+			"initial_import_mode_enabled": pointer.From(input.InitialImportMode),
+			"integration_data_store_storage_account_name": pointer.From(input.IntegrationDataStore),
+		},
+	}
+}
+
 func expandFhirAcrLoginServer(input []interface{}) *[]string {
 	acrLoginServers := make([]string, 0)
 
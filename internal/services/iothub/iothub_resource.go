@@ -4,6 +4,7 @@
 package iothub
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -30,6 +31,7 @@ import (
 	servicebusValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/readmode"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -661,6 +663,10 @@ func resourceIotHub() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
+			"read_mode": readmode.Schema(),
+
+			"etag": readmode.EtagSchema(),
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -1210,7 +1216,27 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	hub, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	// the IoT Hub and its Shared Access Policies are retrieved concurrently, to reduce the time taken to refresh - unless
+	// using the `shallow` read mode, where the Shared Access Policies are only retrieved once the IoT Hub has changed
+	shallow := d.Get("read_mode").(string) == readmode.Shallow
+
+	var hub devices.IotHubDescription
+	var keysResp devices.SharedAccessSignatureAuthorizationRuleListResultPage
+	var keysErr error
+	reads := []func(ctx context.Context) error{
+		func(ctx context.Context) error {
+			result, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			hub = result
+			return err
+		},
+	}
+	if !shallow {
+		reads = append(reads, func(ctx context.Context) error {
+			keysResp, keysErr = client.ListKeys(ctx, id.ResourceGroup, id.Name)
+			return nil
+		})
+	}
+	err = meta.(*clients.Client).ReadLimiter.Run(ctx, reads...)
 	if err != nil {
 		if utils.ResponseWasNotFound(hub.Response) {
 			log.Printf("[DEBUG] %s was not found!", id)
@@ -1221,7 +1247,12 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	if keysResp, err := client.ListKeys(ctx, id.ResourceGroup, id.Name); err == nil {
+	unchanged := readmode.Track(d, hub.Etag)
+	if shallow && !unchanged {
+		keysResp, keysErr = client.ListKeys(ctx, id.ResourceGroup, id.Name)
+	}
+
+	if !unchanged && keysErr == nil {
 		keyList := keysResp.Response()
 		keys := flattenIoTHubSharedAccessPolicy(keyList.Value)
 
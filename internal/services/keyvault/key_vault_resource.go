@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -18,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/servicetags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -37,7 +41,7 @@ import (
 var keyVaultResourceName = "azurerm_key_vault"
 
 func resourceKeyVault() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceKeyVaultCreate,
 		Read:   resourceKeyVaultRead,
 		Update: resourceKeyVaultUpdate,
@@ -212,6 +216,20 @@ func resourceKeyVault() *pluginsdk.Resource {
 							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
 							Set:      set.HashStringIgnoreCase,
 						},
+						"service_tags": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+						"service_tag_ip_rules": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+							Set:      set.HashIPv4AddressOrCIDR,
+						},
 					},
 				},
 			},
@@ -243,6 +261,14 @@ func resourceKeyVault() *pluginsdk.Resource {
 			},
 		},
 	}
+
+	// the schema is captured here, rather than being built each time a plan is computed
+	resource.CustomizeDiff = pluginsdk.CustomDiffWithAll(
+		keyVaultNetworkAclsServiceTagsCustomizeDiff,
+		keyVaultSoftDeletedNameConflictCustomizeDiff(resource.Schema),
+	)
+
+	return resource
 }
 
 func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -321,6 +347,9 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	networkAclsRaw := d.Get("network_acls").([]interface{})
 	networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
+	if err := appendKeyVaultServiceTagIPRules(ctx, meta.(*clients.Client).Network.ServiceTags, subscriptionId, location, networkAclsRaw, networkAcls); err != nil {
+		return err
+	}
 
 	sku := vaults.Sku{
 		Family: vaults.SkuFamilyA,
@@ -534,6 +563,9 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 		networkAclsRaw := d.Get("network_acls").([]interface{})
 		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
+		if err := appendKeyVaultServiceTagIPRules(ctx, meta.(*clients.Client).Network.ServiceTags, id.SubscriptionId, d.Get("location").(string), networkAclsRaw, networkAcls); err != nil {
+			return err
+		}
 
 		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 		virtualNetworkNames := make([]string, 0)
@@ -751,7 +783,11 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 		d.Set("sku_name", skuName)
 
-		if err := d.Set("network_acls", flattenKeyVaultNetworkAcls(model.Properties.NetworkAcls)); err != nil {
+		networkAcls, err := splitKeyVaultServiceTagIPRules(ctx, meta.(*clients.Client).Network.ServiceTags, id.SubscriptionId, location.NormalizeNilable(model.Location), d.Get("network_acls").([]interface{}), flattenKeyVaultNetworkAcls(model.Properties.NetworkAcls))
+		if err != nil {
+			return err
+		}
+		if err := d.Set("network_acls", networkAcls); err != nil {
 			return fmt.Errorf("setting `network_acls`: %+v", err)
 		}
 
@@ -945,6 +981,250 @@ func expandKeyVaultNetworkAcls(input []interface{}) (*vaults.NetworkRuleSet, []s
 	return &ruleSet, subnetIds
 }
 
+// keyVaultSoftDeletedNameConflictCustomizeDiff fails the plan when the Key Vault would be replaced whilst keeping its name,
+// since the deleted Key Vault can't be purged (and as such its name can't be reused) - which otherwise fails during apply,
+// after the Key Vault has already been deleted
+func keyVaultSoftDeletedNameConflictCustomizeDiff(s map[string]*pluginsdk.Schema) pluginsdk.CustomizeDiffFunc {
+	return func(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		features := meta.(*clients.Client).Features
+		// when `recover_soft_deleted_key_vaults` is enabled the deleted Key Vault is recovered when it's recreated
+		if !features.ResourceReplacement.PreventSoftDeletedNameConflicts || features.KeyVault.RecoverSoftDeletedKeyVaults || d.HasChange("name") {
+			return nil
+		}
+
+		changedKeys := pluginsdk.ChangedForceNewKeys(d, s)
+		if len(changedKeys) == 0 {
+			return nil
+		}
+
+		reason := ""
+		if purgeProtectionEnabled, _ := d.GetChange("purge_protection_enabled"); purgeProtectionEnabled.(bool) {
+			reason = "Purge Protection is enabled, as such the deleted Key Vault can't be purged until the `soft_delete_retention_days` have passed"
+		} else if !features.KeyVault.PurgeSoftDeleteOnDestroy {
+			reason = "`purge_soft_delete_on_destroy` is disabled in the `key_vault` block of the provider `features` block, as such the deleted Key Vault won't be purged"
+		}
+		if reason == "" {
+			return nil
+		}
+
+		return fmt.Errorf("changing `%s` requires the Key Vault %q to be deleted and recreated with the same name, which will fail since %s. Either change the `name` of the Key Vault, revert the changes to `%s`, enable `recover_soft_deleted_key_vaults` in the `key_vault` block of the provider `features` block, or set `prevent_soft_deleted_name_conflicts` to `false` in the `resource_replacement` block of the provider `features` block to skip this check", strings.Join(changedKeys, "`, `"), d.Get("name").(string), reason, strings.Join(changedKeys, "`, `"))
+	}
+}
+
+// keyVaultMaximumIPRules is the maximum number of IPv4 rules which can be specified for a Key Vault
+const keyVaultMaximumIPRules = 1000
+
+var (
+	// the service tags are needed during every plan and refresh of a Key Vault using `service_tags`, but are only
+	// updated weekly - as such they're retrieved once per location rather than once per Key Vault
+	keyVaultServiceTagsCache = map[string][]servicetags.ServiceTagInformation{}
+	keyVaultServiceTagsLock  = &sync.Mutex{}
+)
+
+// keyVaultNetworkAclsServiceTagsCustomizeDiff resolves the `service_tags` within `network_acls` so that changes to the
+// address prefixes of a service tag are planned (and then applied) as changes to `service_tag_ip_rules`
+func keyVaultNetworkAclsServiceTagsCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	networkAclsRaw := d.Get("network_acls").([]interface{})
+	if len(networkAclsRaw) == 0 || networkAclsRaw[0] == nil {
+		return nil
+	}
+	if !d.NewValueKnown("location") || !d.NewValueKnown("network_acls.0.service_tags") {
+		return nil
+	}
+
+	networkAcls := networkAclsRaw[0].(map[string]interface{})
+	serviceTags := utils.ExpandStringSlice(networkAcls["service_tags"].(*pluginsdk.Set).List())
+	existing := networkAcls["service_tag_ip_rules"].(*pluginsdk.Set)
+	if len(*serviceTags) == 0 && existing.Len() == 0 {
+		return nil
+	}
+
+	client := meta.(*clients.Client).Network.ServiceTags
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ipRules, err := resolveKeyVaultServiceTagIPRules(ctx, client, subscriptionId, d.Get("location").(string), *serviceTags)
+	if err != nil {
+		return err
+	}
+
+	// the service tags are expanded into IP Rules, so check that these fit alongside the `ip_rules` during plan rather than apply
+	if d.NewValueKnown("network_acls.0.ip_rules") {
+		total := make(map[string]struct{})
+		for _, v := range networkAcls["ip_rules"].(*pluginsdk.Set).List() {
+			total[normalizeKeyVaultIPRule(v.(string))] = struct{}{}
+		}
+		for _, v := range ipRules {
+			total[normalizeKeyVaultIPRule(v)] = struct{}{}
+		}
+		if len(total) > keyVaultMaximumIPRules {
+			return fmt.Errorf("the %d address prefixes resolved from `network_acls.0.service_tags` and the `network_acls.0.ip_rules` add up to %d IP Rules, but a Key Vault supports at most %d", len(ipRules), len(total), keyVaultMaximumIPRules)
+		}
+	}
+
+	resolved := pluginsdk.NewSet(set.HashIPv4AddressOrCIDR, utils.FlattenStringSlice(&ipRules))
+	if resolved.Difference(existing).Len() == 0 && existing.Difference(resolved).Len() == 0 {
+		return nil
+	}
+
+	networkAcls["service_tag_ip_rules"] = resolved
+	return d.SetNew("network_acls", []interface{}{networkAcls})
+}
+
+// resolveKeyVaultServiceTagIPRules returns the IPv4 address prefixes of the specified service tags, since the Key Vault
+// firewall doesn't support service tags (nor IPv6 address ranges)
+func resolveKeyVaultServiceTagIPRules(ctx context.Context, client *servicetags.ServiceTagsClient, subscriptionId, loc string, serviceTags []string) ([]string, error) {
+	ipRules := make([]string, 0)
+	if len(serviceTags) == 0 {
+		return ipRules, nil
+	}
+
+	locationId := servicetags.NewLocationID(subscriptionId, location.Normalize(loc))
+	values, err := listKeyVaultServiceTags(ctx, client, locationId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serviceTag := range serviceTags {
+		found := false
+		for _, value := range values {
+			if value.Name == nil || !strings.EqualFold(*value.Name, serviceTag) {
+				continue
+			}
+
+			found = true
+			if value.Properties == nil {
+				continue
+			}
+			for _, prefix := range pointer.From(value.Properties.AddressPrefixes) {
+				ip, _, err := net.ParseCIDR(prefix)
+				if err != nil || ip.To4() == nil {
+					continue
+				}
+				if !utils.SliceContainsValue(ipRules, prefix) {
+					ipRules = append(ipRules, prefix)
+				}
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("the service tag %q specified in `network_acls.0.service_tags` was not found in %q", serviceTag, locationId.LocationName)
+		}
+	}
+
+	sort.Strings(ipRules)
+	return ipRules, nil
+}
+
+// listKeyVaultServiceTags returns the service tags available in the specified location, using the cached values when present
+func listKeyVaultServiceTags(ctx context.Context, client *servicetags.ServiceTagsClient, locationId servicetags.LocationId) ([]servicetags.ServiceTagInformation, error) {
+	keyVaultServiceTagsLock.Lock()
+	defer keyVaultServiceTagsLock.Unlock()
+
+	cacheKey := strings.ToLower(locationId.ID())
+	if v, ok := keyVaultServiceTagsCache[cacheKey]; ok {
+		return v, nil
+	}
+
+	resp, err := client.ServiceTagsList(ctx, locationId)
+	if err != nil {
+		return nil, fmt.Errorf("listing network service tags in %q: %+v", locationId.LocationName, err)
+	}
+	if resp.Model == nil || resp.Model.Values == nil {
+		return nil, fmt.Errorf("listing network service tags in %q: `model.Values` was nil", locationId.LocationName)
+	}
+
+	keyVaultServiceTagsCache[cacheKey] = *resp.Model.Values
+	return *resp.Model.Values, nil
+}
+
+// appendKeyVaultServiceTagIPRules adds the address prefixes of the service tags specified in `network_acls` to the IP Rules
+func appendKeyVaultServiceTagIPRules(ctx context.Context, client *servicetags.ServiceTagsClient, subscriptionId, loc string, input []interface{}, ruleSet *vaults.NetworkRuleSet) error {
+	if len(input) == 0 || input[0] == nil || ruleSet == nil {
+		return nil
+	}
+
+	serviceTags := utils.ExpandStringSlice(input[0].(map[string]interface{})["service_tags"].(*pluginsdk.Set).List())
+	serviceTagIPRules, err := resolveKeyVaultServiceTagIPRules(ctx, client, subscriptionId, loc, *serviceTags)
+	if err != nil {
+		return err
+	}
+
+	ipRules := pointer.From(ruleSet.IPRules)
+	for _, v := range serviceTagIPRules {
+		exists := false
+		for _, rule := range ipRules {
+			if normalizeKeyVaultIPRule(rule.Value) == normalizeKeyVaultIPRule(v) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			ipRules = append(ipRules, vaults.IPRule{
+				Value: v,
+			})
+		}
+	}
+	ruleSet.IPRules = &ipRules
+
+	return nil
+}
+
+// splitKeyVaultServiceTagIPRules moves the IP Rules which originate from the service tags specified in `network_acls`
+// from `ip_rules` into `service_tag_ip_rules`, unless they're also specified in `ip_rules`
+func splitKeyVaultServiceTagIPRules(ctx context.Context, client *servicetags.ServiceTagsClient, subscriptionId, loc string, existing []interface{}, flattened []interface{}) ([]interface{}, error) {
+	serviceTags := make([]string, 0)
+	configuredIPRules := make([]string, 0)
+	if len(existing) > 0 && existing[0] != nil {
+		networkAcls := existing[0].(map[string]interface{})
+		if v, ok := networkAcls["service_tags"].(*pluginsdk.Set); ok {
+			serviceTags = *utils.ExpandStringSlice(v.List())
+		}
+		if v, ok := networkAcls["ip_rules"].(*pluginsdk.Set); ok {
+			for _, rule := range v.List() {
+				configuredIPRules = append(configuredIPRules, normalizeKeyVaultIPRule(rule.(string)))
+			}
+		}
+	}
+
+	networkAcls := flattened[0].(map[string]interface{})
+	networkAcls["service_tags"] = serviceTags
+	networkAcls["service_tag_ip_rules"] = make([]interface{}, 0)
+	if len(serviceTags) == 0 {
+		return flattened, nil
+	}
+
+	serviceTagIPRules, err := resolveKeyVaultServiceTagIPRules(ctx, client, subscriptionId, loc, serviceTags)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[string]struct{})
+	for _, v := range serviceTagIPRules {
+		resolved[normalizeKeyVaultIPRule(v)] = struct{}{}
+	}
+
+	ipRules := make([]interface{}, 0)
+	tagIPRules := make([]interface{}, 0)
+	for _, v := range networkAcls["ip_rules"].(*pluginsdk.Set).List() {
+		rule := v.(string)
+		normalized := normalizeKeyVaultIPRule(rule)
+		if _, ok := resolved[normalized]; ok {
+			tagIPRules = append(tagIPRules, rule)
+			if !utils.SliceContainsValue(configuredIPRules, normalized) {
+				continue
+			}
+		}
+		ipRules = append(ipRules, rule)
+	}
+	networkAcls["ip_rules"] = pluginsdk.NewSet(pluginsdk.HashString, ipRules)
+	networkAcls["service_tag_ip_rules"] = tagIPRules
+
+	return []interface{}{networkAcls}, nil
+}
+
+// normalizeKeyVaultIPRule removes the `/32` suffix which the API adds to single IPv4 addresses
+func normalizeKeyVaultIPRule(input string) string {
+	return strings.TrimSuffix(input, "/32")
+}
+
 // TODO: Remove in 4.0
 func expandKeyVaultCertificateContactList(input []interface{}) *[]dataplane.Contact {
 	results := make([]dataplane.Contact, 0)
@@ -76,6 +76,41 @@ func TestAccKeyVault_networkAcls(t *testing.T) {
 	})
 }
 
+func TestAccKeyVault_networkAclsServiceTags(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	// the address prefixes of service tags are added to the IP Rules, as such they're imported into `ip_rules`
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkAclsServiceTags(data, "ApiManagement"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_acls.0.ip_rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_acls.0.service_tag_ip_rules.#").IsSet(),
+			),
+		},
+		data.ImportStep("network_acls.0.ip_rules", "network_acls.0.service_tags", "network_acls.0.service_tag_ip_rules"),
+		{
+			Config: r.networkAclsServiceTags(data, "AzureConnectors"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_acls.0.ip_rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_acls.0.service_tag_ip_rules.#").IsSet(),
+			),
+		},
+		data.ImportStep("network_acls.0.ip_rules", "network_acls.0.service_tags", "network_acls.0.service_tag_ip_rules"),
+		{
+			Config: r.networkAclsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_acls.0.service_tag_ip_rules.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKeyVault_networkAclsAllowed(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
 	r := KeyVaultResource{}
@@ -667,6 +702,42 @@ resource "azurerm_key_vault" "test" {
 `, template, data.RandomInteger)
 }
 
+func (KeyVaultResource) networkAclsServiceTags(data acceptance.TestData, service string) string {
+	template := KeyVaultResource{}.networkAclsTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                       = "vault%d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    key_permissions = [
+      "Create",
+    ]
+
+    secret_permissions = [
+      "Set",
+    ]
+  }
+
+  network_acls {
+    default_action = "Deny"
+    bypass         = "None"
+    ip_rules       = ["123.0.0.101"]
+    service_tags   = ["%s.%s"]
+  }
+}
+`, template, data.RandomInteger, service, data.Locations.Primary)
+}
+
 func (r KeyVaultResource) networkAclsUpdated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -154,6 +154,11 @@ func resourceKustoEventGridDataConnection() *pluginsdk.Resource {
 					commonids.ValidateUserAssignedIdentityID,
 				),
 			},
+
+			"managed_identity_object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -284,6 +289,7 @@ func resourceKustoEventGridDataConnectionRead(d *pluginsdk.ResourceData, meta in
 				}
 			}
 			d.Set("managed_identity_resource_id", managedIdentityResourceId)
+			d.Set("managed_identity_object_id", pointer.From(props.ManagedIdentityObjectId))
 		}
 	}
 
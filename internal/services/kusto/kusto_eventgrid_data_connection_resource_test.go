@@ -87,6 +87,7 @@ func TestAccKustoEventGridDataConnection_userAssignedIdentity(t *testing.T) {
 			Config: r.userAssignedIdentity(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_identity_object_id").IsSet(),
 			),
 		},
 		data.ImportStep(),
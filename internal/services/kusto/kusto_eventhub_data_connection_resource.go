@@ -144,6 +144,17 @@ func resourceKustoEventHubDataConnection() *pluginsdk.Resource {
 				Default:      string(dataconnections.DatabaseRoutingSingle),
 				ValidateFunc: validation.StringInSlice(dataconnections.PossibleValuesForDatabaseRouting(), false),
 			},
+
+			"retrieval_start_date": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"managed_identity_object_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -252,6 +263,8 @@ func resourceKustoEventHubDataConnectionRead(d *pluginsdk.ResourceData, meta int
 					}
 				}
 				d.Set("identity_id", identityId)
+				d.Set("managed_identity_object_id", pointer.From(props.ManagedIdentityObjectId))
+				d.Set("retrieval_start_date", pointer.From(props.RetrievalStartDate))
 			}
 		}
 	}
@@ -318,5 +331,9 @@ func expandKustoEventHubDataConnectionProperties(d *pluginsdk.ResourceData) *dat
 		eventHubConnectionProperties.ManagedIdentityResourceId = utils.String(identityId.(string))
 	}
 
+	if retrievalStartDate, ok := d.GetOk("retrieval_start_date"); ok {
+		eventHubConnectionProperties.RetrievalStartDate = utils.String(retrievalStartDate.(string))
+	}
+
 	return eventHubConnectionProperties
 }
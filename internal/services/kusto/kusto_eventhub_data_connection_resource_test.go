@@ -101,6 +101,7 @@ func TestAccKustoEventHubDataConnection_userAssignedIdentity(t *testing.T) {
 			Config: r.userAssignedIdentity(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_identity_object_id").IsSet(),
 			),
 		},
 		data.ImportStep(),
@@ -123,6 +124,21 @@ func TestAccKustoEventHubDataConnection_databaseRoutingType(t *testing.T) {
 	})
 }
 
+func TestAccKustoEventHubDataConnection_retrievalStartDate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_eventhub_data_connection", "test")
+	r := KustoEventHubDataConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.retrievalStartDate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("retrieval_start_date"),
+	})
+}
+
 func (KustoEventHubDataConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dataconnections.ParseDataConnectionID(state.ID)
 	if err != nil {
@@ -297,6 +313,28 @@ resource "azurerm_kusto_eventhub_data_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r KustoEventHubDataConnectionResource) retrievalStartDate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_eventhub_data_connection" "test" {
+  name                = "acctestkedc-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+  database_name       = azurerm_kusto_database.test.name
+
+  eventhub_id          = azurerm_eventhub.test.id
+  consumer_group       = azurerm_eventhub_consumer_group.test.name
+  retrieval_start_date = timeadd(timestamp(), "-1h")
+
+  lifecycle {
+    ignore_changes = [retrieval_start_date]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (KustoEventHubDataConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
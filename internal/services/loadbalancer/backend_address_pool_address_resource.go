@@ -32,6 +32,7 @@ type BackendAddressPoolAddressModel struct {
 	VirtualNetworkId        string                      `tfschema:"virtual_network_id"`
 	IPAddress               string                      `tfschema:"ip_address"`
 	FrontendIPConfiguration string                      `tfschema:"backend_address_ip_configuration_id"`
+	AdminState              string                      `tfschema:"admin_state"`
 	PortMapping             []inboundNATRulePortMapping `tfschema:"inbound_nat_rule_port_mapping"`
 }
 
@@ -100,6 +101,13 @@ func (r BackendAddressPoolAddressResource) Arguments() map[string]*pluginsdk.Sch
 			ValidateFunc:  loadbalancers.ValidateFrontendIPConfigurationID,
 			Description:   "For global load balancer, user needs to specify the `backend_address_ip_configuration_id` of the added regional load balancers",
 		},
+
+		"admin_state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(loadbalancers.LoadBalancerBackendAddressAdminStateNone),
+			ValidateFunc: validation.StringInSlice(loadbalancers.PossibleValuesForLoadBalancerBackendAddressAdminState(), false),
+		},
 	}
 }
 
@@ -197,6 +205,7 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 					addresses = append(addresses, loadbalancers.LoadBalancerBackendAddress{
 						Name: pointer.To(model.Name),
 						Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{
+							AdminState: pointer.To(loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)),
 							LoadBalancerFrontendIPConfiguration: &loadbalancers.SubResource{
 								Id: pointer.To(model.FrontendIPConfiguration),
 							},
@@ -204,8 +213,10 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 					})
 				} else {
 					address := loadbalancers.LoadBalancerBackendAddress{
-						Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{},
-						Name:       pointer.To(model.Name),
+						Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{
+							AdminState: pointer.To(loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)),
+						},
+						Name: pointer.To(model.Name),
 					}
 					if model.IPAddress != "" {
 						address.Properties.IPAddress = pointer.To(model.IPAddress)
@@ -290,6 +301,11 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 			}
 
 			if props := backendAddress.Properties; props != nil {
+				model.AdminState = string(loadbalancers.LoadBalancerBackendAddressAdminStateNone)
+				if props.AdminState != nil {
+					model.AdminState = string(*props.AdminState)
+				}
+
 				if lb.Model != nil && pointer.From(lb.Model.Sku.Tier) == loadbalancers.LoadBalancerSkuTierGlobal {
 					if props.LoadBalancerFrontendIPConfiguration != nil && props.LoadBalancerFrontendIPConfiguration.Id != nil {
 						model.FrontendIPConfiguration = *props.LoadBalancerFrontendIPConfiguration.Id
@@ -461,6 +477,7 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 				addresses[index] = loadbalancers.LoadBalancerBackendAddress{
 					Name: pointer.To(model.Name),
 					Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{
+						AdminState: pointer.To(loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)),
 						LoadBalancerFrontendIPConfiguration: &loadbalancers.SubResource{
 							Id: pointer.To(model.FrontendIPConfiguration),
 						},
@@ -469,7 +486,8 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 			} else {
 				addresses[index] = loadbalancers.LoadBalancerBackendAddress{
 					Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{
-						IPAddress: pointer.To(model.IPAddress),
+						AdminState: pointer.To(loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)),
+						IPAddress:  pointer.To(model.IPAddress),
 						VirtualNetwork: &loadbalancers.SubResource{
 							Id: pointer.To(model.VirtualNetworkId),
 						},
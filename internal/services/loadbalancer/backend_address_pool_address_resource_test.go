@@ -82,6 +82,43 @@ func TestAccBackendAddressPoolAddress_regionalLbUpdate(t *testing.T) {
 	})
 }
 
+func TestAccBackendAddressPoolAddress_regionalLbAdminState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test")
+	r := BackendAddressPoolAddressResourceTests{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.adminState(data, "Down"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_state").HasValue("Down"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.adminState(data, "Up"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("admin_state").HasValue("Up"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccBackendAddressPoolAddress_globalLbUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test1")
 	r := BackendAddressPoolAddressResourceTests{}
@@ -275,6 +312,22 @@ resource "azurerm_lb_backend_address_pool_address" "test" {
 `, template)
 }
 
+func (t BackendAddressPoolAddressResourceTests) adminState(data acceptance.TestData, adminState string) string {
+	template := t.templateRegionalLB(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lb_backend_address_pool_address" "test" {
+  name                    = "address"
+  backend_address_pool_id = azurerm_lb_backend_address_pool.test.id
+  virtual_network_id      = azurerm_virtual_network.test.id
+  ip_address              = "191.168.0.1"
+  admin_state             = "%s"
+  depends_on              = [azurerm_lb_backend_address_pool.test]
+}
+`, template, adminState)
+}
+
 func (t BackendAddressPoolAddressResourceTests) crossRegionLoadBalancer(data acceptance.TestData) string {
 	template := t.templateGlobalLB(data)
 	return fmt.Sprintf(`
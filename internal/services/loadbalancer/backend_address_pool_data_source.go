@@ -56,6 +56,11 @@ func dataSourceArmLoadBalancerBackendAddressPool() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"admin_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"inbound_nat_rule_port_mapping": {
 							Type:     pluginsdk.TypeList,
 							Computed: true,
@@ -220,13 +225,15 @@ func flattenArmLoadBalancerBackendAddresses(input *[]loadbalancers.LoadBalancerB
 		}
 
 		var (
-			ipAddress string
-			vnetId    string
+			adminState string
+			ipAddress  string
+			vnetId     string
 		)
 		var inboundNATRulePortMappingList []interface{}
 		if prop := e.Properties; prop != nil {
 
 			ipAddress = pointer.From(prop.IPAddress)
+			adminState = string(pointer.From(prop.AdminState))
 
 			if prop.VirtualNetwork != nil {
 				vnetId = pointer.From(prop.VirtualNetwork.Id)
@@ -255,6 +262,7 @@ func flattenArmLoadBalancerBackendAddresses(input *[]loadbalancers.LoadBalancerB
 			"name":                          name,
 			"virtual_network_id":            vnetId,
 			"ip_address":                    ipAddress,
+			"admin_state":                   adminState,
 			"inbound_nat_rule_port_mapping": inboundNATRulePortMappingList,
 		}
 		output = append(output, v)
@@ -79,6 +79,11 @@ func dataSourceArmLoadBalancer() *pluginsdk.Resource {
 							Computed: true,
 						},
 
+						"gateway_load_balancer_frontend_ip_configuration_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
 						"zones": commonschema.ZonesMultipleComputed(),
 
 						"id": {
@@ -188,6 +193,7 @@ func flattenLoadBalancerDataSourceFrontendIpConfiguration(ipConfigs *[]loadbalan
 		privateIpAddressVersion := ""
 		publicIpAddressId := ""
 		subnetId := ""
+		gatewayLoadBalancerId := ""
 		if props := config.Properties; props != nil {
 			privateIpAddressAllocation = string(pointer.From(props.PrivateIPAllocationMethod))
 
@@ -201,9 +207,14 @@ func flattenLoadBalancerDataSourceFrontendIpConfiguration(ipConfigs *[]loadbalan
 			if pip := props.PublicIPAddress; pip != nil {
 				publicIpAddressId = pointer.From(pip.Id)
 			}
+
+			if glb := props.GatewayLoadBalancer; glb != nil {
+				gatewayLoadBalancerId = pointer.From(glb.Id)
+			}
 		}
 
 		result = append(result, map[string]interface{}{
+			"gateway_load_balancer_frontend_ip_configuration_id": gatewayLoadBalancerId,
 			"id":                            id,
 			"name":                          name,
 			"private_ip_address":            privateIpAddress,
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedapplications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applicationdefinitions"
+)
+
+// managedApplicationTemplateParameter is a parameter declared within the `mainTemplate` of a Managed Application Definition
+type managedApplicationTemplateParameter struct {
+	Name       string
+	Type       string
+	HasDefault bool
+}
+
+// managedApplicationDefinitionParameters are the parameters a Managed Application Definition accepts, keyed by
+// their lower-cased name since ARM Template parameter names are case-insensitive
+type managedApplicationDefinitionParameters struct {
+	// TemplateParameters are the parameters declared within the `mainTemplate`, if it's available
+	TemplateParameters map[string]managedApplicationTemplateParameter
+
+	// UiOutputs are the names of the values output from the `createUiDefinition`, if any are defined
+	UiOutputs map[string]string
+}
+
+func retrieveManagedApplicationDefinitionParameters(ctx context.Context, client *applicationdefinitions.ApplicationDefinitionsClient, definitionId string) (*managedApplicationDefinitionParameters, error) {
+	id, err := applicationdefinitions.ParseApplicationDefinitionID(definitionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			// the Application Definition may be being (re)created in the same apply
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Model == nil {
+		return nil, nil
+	}
+
+	createUiDefinition, err := decodeManagedApplicationDefinitionDocument(resp.Model.Properties.CreateUiDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("decoding `createUiDefinition` of %s: %+v", id, err)
+	}
+
+	mainTemplate, err := decodeManagedApplicationDefinitionDocument(resp.Model.Properties.MainTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("decoding `mainTemplate` of %s: %+v", id, err)
+	}
+
+	return newManagedApplicationDefinitionParameters(createUiDefinition, mainTemplate), nil
+}
+
+// decodeManagedApplicationDefinitionDocument returns the `createUiDefinition` or `mainTemplate` of an Application Definition
+// as an object, since these are returned either as an object or as a JSON encoded string depending on how they were defined
+func decodeManagedApplicationDefinitionDocument(input *interface{}) (map[string]interface{}, error) {
+	if input == nil || *input == nil {
+		return nil, nil
+	}
+
+	switch v := (*input).(type) {
+	case map[string]interface{}:
+		return v, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		result := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v), &result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+// newManagedApplicationDefinitionParameters builds the accepted parameters from the decoded `createUiDefinition` and
+// `mainTemplate`, returning nil when neither declares any parameters and so there's nothing to validate against
+func newManagedApplicationDefinitionParameters(createUiDefinition map[string]interface{}, mainTemplate map[string]interface{}) *managedApplicationDefinitionParameters {
+	result := managedApplicationDefinitionParameters{}
+
+	if raw, ok := mainTemplate["parameters"].(map[string]interface{}); ok {
+		result.TemplateParameters = make(map[string]managedApplicationTemplateParameter)
+		for name, v := range raw {
+			parameter := managedApplicationTemplateParameter{
+				Name: name,
+			}
+			if definition, ok := v.(map[string]interface{}); ok {
+				if t, ok := definition["type"].(string); ok {
+					parameter.Type = strings.ToLower(t)
+				}
+				_, parameter.HasDefault = definition["defaultValue"]
+			}
+			result.TemplateParameters[strings.ToLower(name)] = parameter
+		}
+	}
+
+	if parameters, ok := createUiDefinition["parameters"].(map[string]interface{}); ok {
+		if outputs, ok := parameters["outputs"].(map[string]interface{}); ok && len(outputs) > 0 {
+			result.UiOutputs = make(map[string]string)
+			for name := range outputs {
+				result.UiOutputs[strings.ToLower(name)] = name
+			}
+		}
+	}
+
+	if result.TemplateParameters == nil && result.UiOutputs == nil {
+		return nil
+	}
+
+	return &result
+}
+
+// typeOf returns the `mainTemplate` type of the parameter with the specified name, or an empty string when it's unknown
+func (p *managedApplicationDefinitionParameters) typeOf(name string) string {
+	if p == nil {
+		return ""
+	}
+
+	return p.TemplateParameters[strings.ToLower(name)].Type
+}
+
+// validate checks the specified parameter values, keyed by name, against the parameters accepted by the Application Definition.
+// Values which are nil (e.g. Key Vault references) are only checked for their presence.
+func (p *managedApplicationDefinitionParameters) validate(values map[string]interface{}) []error {
+	errs := make([]error, 0)
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	specified := make(map[string]struct{}, len(values))
+	for _, name := range names {
+		key := strings.ToLower(name)
+		specified[key] = struct{}{}
+
+		_, inUiOutputs := p.UiOutputs[key]
+		parameter, inTemplate := p.TemplateParameters[key]
+		if !inUiOutputs && !inTemplate {
+			errs = append(errs, fmt.Errorf("the parameter %q isn't defined within the `createUiDefinition` or `mainTemplate` of the Application Definition", name))
+			continue
+		}
+
+		if value := values[name]; inTemplate && value != nil && !managedApplicationParameterValueMatchesType(parameter.Type, value) {
+			errs = append(errs, fmt.Errorf("the parameter %q must be of type %q", name, parameter.Type))
+		}
+	}
+
+	required := make([]string, 0)
+	for key, parameter := range p.TemplateParameters {
+		if _, ok := specified[key]; !ok && !parameter.HasDefault {
+			required = append(required, parameter.Name)
+		}
+	}
+	sort.Strings(required)
+	for _, name := range required {
+		errs = append(errs, fmt.Errorf("the parameter %q is required by the `mainTemplate` of the Application Definition but wasn't specified", name))
+	}
+
+	return errs
+}
+
+func managedApplicationParameterValueMatchesType(parameterType string, value interface{}) bool {
+	switch parameterType {
+	case "string", "securestring":
+		_, ok := value.(string)
+		return ok
+	case "int":
+		v, ok := value.(float64)
+		return ok && v == float64(int64(v))
+	case "bool":
+		_, ok := value.(bool)
+		return ok
+	case "object", "secureobject":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	}
+
+	// unknown (or unspecified) types are left for the API to validate
+	return true
+}
+
+// convertManagedApplicationParameterValue converts a value specified within the `parameter_values` map to the type of
+// the `mainTemplate` parameter, where objects and arrays are expected to be JSON encoded
+func convertManagedApplicationParameterValue(parameterType string, value string) (interface{}, error) {
+	switch parameterType {
+	case "int":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid integer", value)
+		}
+		return float64(v), nil
+	case "bool":
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", value)
+		}
+		return v, nil
+	case "object", "secureobject", "array":
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("expected a JSON encoded %s: %+v", parameterType, err)
+		}
+		return v, nil
+	}
+
+	return value, nil
+}
+
+// managedApplicationParameterValues returns the values of the specified parameters keyed by name, either from the JSON
+// encoded object (where each value is wrapped in a `value` key) or from a map of strings converted to the types in the
+// `mainTemplate`. Values which aren't specified inline (e.g. Key Vault references) are returned as nil.
+func managedApplicationParameterValues(input interface{}, parameters *managedApplicationDefinitionParameters) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+
+	switch v := input.(type) {
+	case string:
+		if v == "" {
+			return result, nil
+		}
+		raw := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(v), &raw); err != nil {
+			return nil, fmt.Errorf("unmarshalling: %+v", err)
+		}
+		for name, val := range raw {
+			result[name] = nil
+			if wrapped, ok := val.(map[string]interface{}); ok {
+				result[name] = wrapped["value"]
+			}
+		}
+	case map[string]interface{}:
+		for name, val := range v {
+			converted, err := convertManagedApplicationParameterValue(parameters.typeOf(name), val.(string))
+			if err != nil {
+				return nil, fmt.Errorf("converting the value for %q: %+v", name, err)
+			}
+			result[name] = converted
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+
+	return result, nil
+}
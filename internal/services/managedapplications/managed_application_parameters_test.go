@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedapplications
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testManagedApplicationDefinitionParameters() *managedApplicationDefinitionParameters {
+	createUiDefinition := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"basics": []interface{}{},
+			"steps":  []interface{}{},
+			"outputs": map[string]interface{}{
+				"location": "[location()]",
+			},
+		},
+	}
+	mainTemplate := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"stringParameter": map[string]interface{}{
+				"type": "string",
+			},
+			"intParameter": map[string]interface{}{
+				"type": "int",
+			},
+			"boolParameter": map[string]interface{}{
+				"type":         "bool",
+				"defaultValue": false,
+			},
+			"objectParameter": map[string]interface{}{
+				"type":         "object",
+				"defaultValue": map[string]interface{}{},
+			},
+		},
+	}
+
+	return newManagedApplicationDefinitionParameters(createUiDefinition, mainTemplate)
+}
+
+func TestManagedApplicationDefinitionParametersEmpty(t *testing.T) {
+	empty := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"outputs": map[string]interface{}{},
+		},
+	}
+	if actual := newManagedApplicationDefinitionParameters(empty, nil); actual != nil {
+		t.Fatalf("expected no parameters but got %+v", actual)
+	}
+}
+
+func TestManagedApplicationDefinitionParametersValidate(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    string
+		expected int
+	}{
+		{
+			name:     "required parameters",
+			input:    `{"stringParameter": {"value": "a"}, "intParameter": {"value": 1}}`,
+			expected: 0,
+		},
+		{
+			name:     "all parameters",
+			input:    `{"stringParameter": {"value": "a"}, "intParameter": {"value": 1}, "boolParameter": {"value": true}, "objectParameter": {"value": {"a": 1}}, "location": {"value": "westeurope"}}`,
+			expected: 0,
+		},
+		{
+			name:     "case insensitive names",
+			input:    `{"StringParameter": {"value": "a"}, "INTPARAMETER": {"value": 1}}`,
+			expected: 0,
+		},
+		{
+			name:     "key vault reference",
+			input:    `{"stringParameter": {"reference": {"keyVault": {"id": "example"}, "secretName": "example"}}, "intParameter": {"value": 1}}`,
+			expected: 0,
+		},
+		{
+			name:     "missing required parameter",
+			input:    `{"stringParameter": {"value": "a"}}`,
+			expected: 1,
+		},
+		{
+			name:     "undefined parameter",
+			input:    `{"stringParameter": {"value": "a"}, "intParameter": {"value": 1}, "otherParameter": {"value": "b"}}`,
+			expected: 1,
+		},
+		{
+			name:     "wrong types",
+			input:    `{"stringParameter": {"value": 1}, "intParameter": {"value": 1.5}, "objectParameter": {"value": []}}`,
+			expected: 3,
+		},
+	}
+
+	parameters := testManagedApplicationDefinitionParameters()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		values, err := managedApplicationParameterValues(v.input, parameters)
+		if err != nil {
+			t.Fatalf("parsing values: %+v", err)
+		}
+
+		if errs := parameters.validate(values); len(errs) != v.expected {
+			t.Fatalf("expected %d errors but got %d: %+v", v.expected, len(errs), errs)
+		}
+	}
+}
+
+func TestManagedApplicationParameterValuesFromMap(t *testing.T) {
+	parameters := testManagedApplicationDefinitionParameters()
+
+	input := map[string]interface{}{
+		"stringParameter": "100",
+		"intParameter":    "100",
+		"boolParameter":   "true",
+		"objectParameter": `{"nested":["a","b"]}`,
+		"location":        "westeurope",
+	}
+	expected := map[string]interface{}{
+		"stringParameter": "100",
+		"intParameter":    float64(100),
+		"boolParameter":   true,
+		"objectParameter": map[string]interface{}{
+			"nested": []interface{}{"a", "b"},
+		},
+		"location": "westeurope",
+	}
+
+	actual, err := managedApplicationParameterValues(input, parameters)
+	if err != nil {
+		t.Fatalf("converting values: %+v", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+	if errs := parameters.validate(actual); len(errs) != 0 {
+		t.Fatalf("expected no errors but got %+v", errs)
+	}
+
+	if _, err := managedApplicationParameterValues(map[string]interface{}{"intParameter": "one"}, parameters); err == nil {
+		t.Fatalf("expected an error converting an invalid integer")
+	}
+}
@@ -5,9 +5,11 @@ package managedapplications
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applicationdefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applications"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helperValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedapplications/validate"
@@ -48,6 +51,8 @@ func resourceManagedApplication() *pluginsdk.Resource {
 		},
 
 		Schema: resourceManagedApplicationSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceManagedApplicationCustomizeDiff),
 	}
 }
 
@@ -82,18 +87,79 @@ func resourceManagedApplicationSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: applicationdefinitions.ValidateApplicationDefinitionID,
 		},
 
-		"parameter_values": {
-			Type:             pluginsdk.TypeString,
-			Optional:         true,
-			Computed:         true,
-			ValidateFunc:     validation.StringIsJSON,
-			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
-			ConflictsWith: func() []string {
-				if !features.FourPointOhBeta() {
-					return []string{"parameters"}
+		"parameter_values": func() *pluginsdk.Schema {
+			if !features.FourPointOhBeta() {
+				return &pluginsdk.Schema{
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+					ConflictsWith:    []string{"parameters"},
 				}
-				return []string{}
-			}(),
+			}
+
+			return &pluginsdk.Schema{
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			}
+		}(),
+
+		"jit_access_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"approval_mode": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						Default:  string(applications.JitApprovalModeManualApprove),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(applications.JitApprovalModeAutoApprove),
+							string(applications.JitApprovalModeManualApprove),
+						}, false),
+					},
+
+					"approver": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"object_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"type": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(applications.JitApproverTypeUser),
+									ValidateFunc: validation.StringInSlice(applications.PossibleValuesForJitApproverType(), false),
+								},
+
+								"display_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"maximum_access_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT8H",
+						ValidateFunc: helperValidate.ISO8601Duration,
+					},
+				},
+			},
 		},
 
 		"plan": {
@@ -205,7 +271,16 @@ func resourceManagedApplicationCreate(d *pluginsdk.ResourceData, meta interface{
 		parameters.Plan = expandManagedApplicationPlan(v.([]interface{}))
 	}
 
-	params, err := expandManagedApplicationParameters(d)
+	if v, ok := d.GetOk("jit_access_policy"); ok {
+		parameters.Properties.JitAccessPolicy = expandManagedApplicationJitAccessPolicy(v.([]interface{}))
+	}
+
+	definitionParameters, err := managedApplicationDefinitionParametersForExpand(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	params, err := expandManagedApplicationParameters(d, definitionParameters)
 	if err != nil {
 		if !features.FourPointOhBeta() {
 			return fmt.Errorf("expanding `parameters` or `parameter_values`: %+v", err)
@@ -249,7 +324,16 @@ func resourceManagedApplicationUpdate(d *pluginsdk.ResourceData, meta interface{
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	params, err := expandManagedApplicationParameters(d)
+	if d.HasChange("jit_access_policy") {
+		payload.Properties.JitAccessPolicy = expandManagedApplicationJitAccessPolicy(d.Get("jit_access_policy").([]interface{}))
+	}
+
+	definitionParameters, err := managedApplicationDefinitionParametersForExpand(ctx, d, meta)
+	if err != nil {
+		return err
+	}
+
+	params, err := expandManagedApplicationParameters(d, definitionParameters)
 	if err != nil {
 		if !features.FourPointOhBeta() {
 			return fmt.Errorf("expanding `parameters` or `parameter_values`: %+v", err)
@@ -306,7 +390,12 @@ func resourceManagedApplicationRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("managed_resource_group_name", id.ResourceGroup)
 		d.Set("application_definition_id", p.ApplicationDefinitionId)
 
-		expendedParams, err := expandManagedApplicationParameters(d)
+		if err := d.Set("jit_access_policy", flattenManagedApplicationJitAccessPolicy(p.JitAccessPolicy)); err != nil {
+			return fmt.Errorf("setting `jit_access_policy`: %+v", err)
+		}
+
+		// the types of the values aren't needed here, since these are only used to retain the values of secure parameters
+		expendedParams, err := expandManagedApplicationParameters(d, nil)
 		if err != nil {
 			if !features.FourPointOhBeta() {
 				return fmt.Errorf("expanding `parameters` or `parameter_values`: %+v", err)
@@ -314,11 +403,21 @@ func resourceManagedApplicationRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("expanding `parameter_values`: %+v", err)
 		}
 
-		parameterValues, err := flattenManagedApplicationParameterValuesValueToString(p.Parameters, *expendedParams)
-		if err != nil {
-			return fmt.Errorf("serializing JSON from `parameter_values`: %+v", err)
+		if !features.FourPointOhBeta() {
+			parameterValues, err := flattenManagedApplicationParameterValuesValueToString(p.Parameters, *expendedParams)
+			if err != nil {
+				return fmt.Errorf("serializing JSON from `parameter_values`: %+v", err)
+			}
+			d.Set("parameter_values", parameterValues)
+		} else {
+			parameterValues, err := flattenManagedApplicationParameters(p.Parameters, *expendedParams)
+			if err != nil {
+				return err
+			}
+			if err = d.Set("parameter_values", parameterValues); err != nil {
+				return fmt.Errorf("setting `parameter_values`: %+v", err)
+			}
 		}
-		d.Set("parameter_values", parameterValues)
 
 		if !features.FourPointOhBeta() {
 			parameters, err := flattenManagedApplicationParameters(p.Parameters, *expendedParams)
@@ -378,12 +477,80 @@ func expandManagedApplicationPlan(input []interface{}) *applications.Plan {
 	}
 }
 
-func expandManagedApplicationParameters(d *pluginsdk.ResourceData) (*map[string]interface{}, error) {
+func resourceManagedApplicationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// `parameter_values` is Computed, so the values are only validated when they're specified in the configuration
+	if d.GetRawConfig().GetAttr("parameter_values").IsNull() {
+		return nil
+	}
+
+	if !d.NewValueKnown("parameter_values") || !d.NewValueKnown("application_definition_id") {
+		return nil
+	}
+
+	definitionId := d.Get("application_definition_id").(string)
+	if definitionId == "" {
+		return nil
+	}
+
+	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	definitionParameters, err := retrieveManagedApplicationDefinitionParameters(ctx, client, definitionId)
+	if err != nil {
+		return err
+	}
+	if definitionParameters == nil {
+		return nil
+	}
+
+	values, err := managedApplicationParameterValues(d.Get("parameter_values"), definitionParameters)
+	if err != nil {
+		return fmt.Errorf("parsing `parameter_values`: %+v", err)
+	}
+
+	if errs := definitionParameters.validate(values); len(errs) > 0 {
+		messages := make([]string, 0, len(errs))
+		for _, e := range errs {
+			messages = append(messages, fmt.Sprintf("  - %s", e))
+		}
+		return fmt.Errorf("`parameter_values` doesn't match the Application Definition %q:\n%s", definitionId, strings.Join(messages, "\n"))
+	}
+
+	return nil
+}
+
+// managedApplicationDefinitionParametersForExpand retrieves the parameters of the Application Definition which are
+// needed to convert the values within `parameter_values` to their types, when these are specified as a map
+func managedApplicationDefinitionParametersForExpand(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (*managedApplicationDefinitionParameters, error) {
+	if !features.FourPointOhBeta() {
+		return nil, nil
+	}
+
+	definitionId, ok := d.GetOk("application_definition_id")
+	if !ok {
+		return nil, nil
+	}
+
+	client := meta.(*clients.Client).ManagedApplication.ApplicationDefinitionClient
+	return retrieveManagedApplicationDefinitionParameters(ctx, client, definitionId.(string))
+}
+
+func expandManagedApplicationParameters(d *pluginsdk.ResourceData, definitionParameters *managedApplicationDefinitionParameters) (*map[string]interface{}, error) {
 	newParams := make(map[string]interface{})
 
-	if v, ok := d.GetOk("parameter_values"); ok {
-		if err := json.Unmarshal([]byte(v.(string)), &newParams); err != nil {
-			return nil, fmt.Errorf("unmarshalling `parameter_values`: %+v", err)
+	if !features.FourPointOhBeta() {
+		if v, ok := d.GetOk("parameter_values"); ok {
+			if err := json.Unmarshal([]byte(v.(string)), &newParams); err != nil {
+				return nil, fmt.Errorf("unmarshalling `parameter_values`: %+v", err)
+			}
+		}
+	} else if v, ok := d.GetOk("parameter_values"); ok {
+		values, err := managedApplicationParameterValues(v.(map[string]interface{}), definitionParameters)
+		if err != nil {
+			return nil, err
+		}
+		for key, val := range values {
+			newParams[key] = map[string]interface{}{
+				"value": val,
+			}
 		}
 	}
 
@@ -405,6 +572,65 @@ func expandManagedApplicationParameters(d *pluginsdk.ResourceData) (*map[string]
 	return &newParams, nil
 }
 
+func expandManagedApplicationJitAccessPolicy(input []interface{}) *applications.ApplicationJitAccessPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return &applications.ApplicationJitAccessPolicy{
+			JitAccessEnabled: false,
+		}
+	}
+	policy := input[0].(map[string]interface{})
+
+	approvers := make([]applications.JitApproverDefinition, 0)
+	for _, item := range policy["approver"].([]interface{}) {
+		if item == nil {
+			continue
+		}
+		approver := item.(map[string]interface{})
+
+		definition := applications.JitApproverDefinition{
+			Id:   approver["object_id"].(string),
+			Type: pointer.To(applications.JitApproverType(approver["type"].(string))),
+		}
+		if v := approver["display_name"].(string); v != "" {
+			definition.DisplayName = pointer.To(v)
+		}
+		approvers = append(approvers, definition)
+	}
+
+	return &applications.ApplicationJitAccessPolicy{
+		JitAccessEnabled:         true,
+		JitApprovalMode:          pointer.To(applications.JitApprovalMode(policy["approval_mode"].(string))),
+		JitApprovers:             &approvers,
+		MaximumJitAccessDuration: pointer.To(policy["maximum_access_duration"].(string)),
+	}
+}
+
+func flattenManagedApplicationJitAccessPolicy(input *applications.ApplicationJitAccessPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || !input.JitAccessEnabled {
+		return results
+	}
+
+	approvers := make([]interface{}, 0)
+	if input.JitApprovers != nil {
+		for _, approver := range *input.JitApprovers {
+			approvers = append(approvers, map[string]interface{}{
+				"object_id":    approver.Id,
+				"type":         string(pointer.From(approver.Type)),
+				"display_name": pointer.From(approver.DisplayName),
+			})
+		}
+	}
+
+	results = append(results, map[string]interface{}{
+		"approval_mode":           string(pointer.From(input.JitApprovalMode)),
+		"approver":                approvers,
+		"maximum_access_duration": pointer.From(input.MaximumJitAccessDuration),
+	})
+
+	return results
+}
+
 func flattenManagedApplicationPlan(input *applications.Plan) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
@@ -477,6 +703,10 @@ func flattenManagedApplicationOutputs(input *interface{}) (map[string]interface{
 			if mapVal != nil {
 				v, ok := mapVal["value"]
 				if !ok {
+					// the values of secure outputs (e.g. `secureString`) aren't returned
+					if t, typeOK := mapVal["type"].(string); typeOK && strings.HasPrefix(strings.ToLower(t), "secure") {
+						continue
+					}
 					return nil, fmt.Errorf("missing key 'value' in output map %+v", mapVal)
 				}
 
@@ -6,6 +6,7 @@ package managedapplications_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -186,6 +187,76 @@ func TestAccManagedApplication_parameterValuesSecureString(t *testing.T) {
 	})
 }
 
+func TestAccManagedApplication_parameterValuesMap(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping because `parameter_values` is only a map in 4.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_managed_application", "test")
+	r := ManagedApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.parameterValuesMap(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameter_values.intParameter").HasValue("100"),
+				check.That(data.ResourceName).Key("parameter_values.arrayParameter").HasValue("[\"value_1\",\"value_2\"]"),
+			),
+		},
+		data.ImportStep("parameter_values.secureStringParameter"),
+	})
+}
+
+func TestAccManagedApplication_parameterValuesNotInDefinition(t *testing.T) {
+	if features.FourPointOhBeta() {
+		t.Skipf("skipping because `parameter_values` is a map in 4.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_managed_application", "test")
+	r := ManagedApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateStringParameter(data),
+		},
+		{
+			Config:      r.parameterValuesNotInDefinition(data),
+			ExpectError: regexp.MustCompile("isn't defined within the `createUiDefinition` or `mainTemplate`"),
+		},
+	})
+}
+
+func TestAccManagedApplication_jitAccessPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_application", "test")
+	r := ManagedApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.jitAccessPolicy(data, "ManualApprove", "PT8H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.jitAccessPolicy(data, "AutoApprove", "PT4H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("jit_access_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccManagedApplication_plan(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_application", "test")
 	r := ManagedApplicationResource{}
@@ -460,6 +531,97 @@ resource "azurerm_managed_application" "test" {
 `, r.templateAllSupportedParametersTypes(data), data.RandomInteger)
 }
 
+func (r ManagedApplicationResource) parameterValuesMap(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_managed_application" "test" {
+  name                        = "acctestManagedApp%[2]d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  kind                        = "ServiceCatalog"
+  managed_resource_group_name = "infraGroup%[2]d"
+  application_definition_id   = azurerm_managed_application_definition.test.id
+
+  parameter_values = {
+    boolParameter         = true
+    intParameter          = 100
+    stringParameter       = "value_1"
+    secureStringParameter = "secure_value_1"
+    objectParameter = jsonencode({
+      nested_bool  = true
+      nested_array = ["value_1", "value_2"]
+      nested_object = {
+        key_0 = 0
+      }
+    })
+    arrayParameter = jsonencode(["value_1", "value_2"])
+  }
+}
+`, r.templateAllSupportedParametersTypes(data), data.RandomInteger)
+}
+
+func (r ManagedApplicationResource) parameterValuesNotInDefinition(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_managed_application" "test" {
+  name                        = "acctestManagedApp%[2]d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  kind                        = "ServiceCatalog"
+  managed_resource_group_name = "infraGroup%[2]d"
+  application_definition_id   = azurerm_managed_application_definition.test.id
+
+  parameter_values = jsonencode({
+    stringParameter = {
+      value = "value_1"
+    },
+    secureStringParameter = {
+      value = ""
+    },
+    undefinedParameter = {
+      value = "value_2"
+    }
+  })
+}
+`, r.templateStringParameter(data), data.RandomInteger)
+}
+
+func (r ManagedApplicationResource) jitAccessPolicy(data acceptance.TestData, approvalMode string, maximumAccessDuration string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_managed_application" "test" {
+  name                        = "acctestManagedApp%[2]d"
+  location                    = azurerm_resource_group.test.location
+  resource_group_name         = azurerm_resource_group.test.name
+  kind                        = "ServiceCatalog"
+  managed_resource_group_name = "infraGroup%[2]d"
+  application_definition_id   = azurerm_managed_application_definition.test.id
+
+  parameter_values = jsonencode({
+    stringParameter = {
+      value = "value_1_from_parameter_values"
+    },
+    secureStringParameter = {
+      value = ""
+    }
+  })
+
+  jit_access_policy {
+    approval_mode           = "%[3]s"
+    maximum_access_duration = "%[4]s"
+
+    approver {
+      object_id = data.azurerm_client_config.test.object_id
+      type      = "user"
+    }
+  }
+}
+`, r.templateStringParameter(data), data.RandomInteger, approvalMode, maximumAccessDuration)
+}
+
 func (r ManagedApplicationResource) parametersSecureString(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -35,7 +37,7 @@ import (
 )
 
 func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceArmKeyVaultManagedHardwareSecurityModuleCreate,
 		Read:   resourceArmKeyVaultManagedHardwareSecurityModuleRead,
 		Delete: resourceArmKeyVaultManagedHardwareSecurityModuleDelete,
@@ -53,8 +55,6 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(keyVaultHSMCustomizeDiff),
-
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -174,6 +174,14 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 		},
 	}
+
+	// the schema is captured here, rather than being built each time a plan is computed
+	resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(
+		keyVaultHSMCustomizeDiff,
+		keyVaultHSMSoftDeletedNameConflictCustomizeDiff(resource.Schema),
+	)
+
+	return resource
 }
 
 func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -539,18 +547,55 @@ func securityDomainDownload(ctx context.Context, sdClient *kv74.HSMSecurityDomai
 	return encData.Value, err
 }
 
-func keyVaultHSMCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
-	if oldVal, newVal := d.GetChange("security_domain_key_vault_certificate_ids"); len(oldVal.([]interface{})) != 0 && len(newVal.([]interface{})) == 0 {
-		if err := d.ForceNew("security_domain_key_vault_certificate_ids"); err != nil {
-			return err
+// keyVaultHSMSoftDeletedNameConflictCustomizeDiff fails the plan when the Managed HSM would be replaced whilst keeping its
+// name, since the deleted Managed HSM can't be purged (and as such its name can't be reused) - which otherwise fails
+// during apply, after the Managed HSM has already been deleted
+func keyVaultHSMSoftDeletedNameConflictCustomizeDiff(s map[string]*pluginsdk.Schema) pluginsdk.CustomizeDiffFunc {
+	return func(_ context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if !meta.(*clients.Client).Features.ResourceReplacement.PreventSoftDeletedNameConflicts || d.HasChange("name") || d.Id() == "" {
+			return nil
 		}
+
+		changedKeys := append(pluginsdk.ChangedForceNewKeys(d, s), keyVaultHSMConditionallyForceNewKeys(d)...)
+		if len(changedKeys) == 0 {
+			return nil
+		}
+		sort.Strings(changedKeys)
+
+		reason := ""
+		if purgeProtectionEnabled, _ := d.GetChange("purge_protection_enabled"); purgeProtectionEnabled.(bool) {
+			reason = "Purge Protection is enabled, as such the deleted Managed HSM can't be purged until the `soft_delete_retention_days` have passed"
+		} else if !meta.(*clients.Client).Features.KeyVault.PurgeSoftDeletedHSMsOnDestroy {
+			reason = "`purge_soft_deleted_hardware_security_modules_on_destroy` is disabled in the `key_vault` block of the provider `features` block, as such the deleted Managed HSM won't be purged"
+		}
+		if reason == "" {
+			return nil
+		}
+
+		return fmt.Errorf("changing `%s` requires the Managed HSM %q to be deleted and recreated with the same name, which will fail since %s. Either change the `name` of the Managed HSM, revert the changes to `%s`, or set `prevent_soft_deleted_name_conflicts` to `false` in the `resource_replacement` block of the provider `features` block to skip this check", strings.Join(changedKeys, "`, `"), d.Get("name").(string), reason, strings.Join(changedKeys, "`, `"))
 	}
+}
 
-	if oldVal, newVal := d.GetChange("security_domain_quorum"); oldVal.(int) != 0 && newVal.(int) == 0 {
-		if err := d.ForceNew("security_domain_quorum"); err != nil {
+func keyVaultHSMCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, key := range keyVaultHSMConditionallyForceNewKeys(d) {
+		if err := d.ForceNew(key); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+// keyVaultHSMConditionallyForceNewKeys returns the keys which require the Managed HSM to be recreated, since the
+// security domain can't be removed once it's been activated
+func keyVaultHSMConditionallyForceNewKeys(d *pluginsdk.ResourceDiff) []string {
+	keys := make([]string, 0)
+	if oldVal, newVal := d.GetChange("security_domain_key_vault_certificate_ids"); len(oldVal.([]interface{})) != 0 && len(newVal.([]interface{})) == 0 {
+		keys = append(keys, "security_domain_key_vault_certificate_ids")
+	}
+	if oldVal, newVal := d.GetChange("security_domain_quorum"); oldVal.(int) != 0 && newVal.(int) == 0 {
+		keys = append(keys, "security_domain_quorum")
+	}
+
+	return keys
+}
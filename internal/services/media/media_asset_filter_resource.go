@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/assetsandassetfilters"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/media/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -248,7 +249,13 @@ func resourceMediaAssetFilterRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 	resp, err := client.AssetFiltersGet(ctx, *id)
 	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
+		retired := resourceproviders.WasRetired(resp.HttpResponse, resourceproviders.RetiredNamespaceMedia)
+		if retired && meta.(*clients.Client).Features.RetiredServices.RemoveFromStateWhenUnavailable {
+			log.Printf("[INFO] the Resource Provider for %s has been retired - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		if !retired && response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
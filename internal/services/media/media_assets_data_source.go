@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package media
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/assetsandassetfilters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = AssetsDataSource{}
+
+type AssetsDataSource struct{}
+
+type AssetsDataSourceModel struct {
+	ResourceGroupName        string                `tfschema:"resource_group_name"`
+	MediaServicesAccountName string                `tfschema:"media_services_account_name"`
+	Assets                   []AssetDataSourceItem `tfschema:"assets"`
+}
+
+type AssetDataSourceItem struct {
+	Name                    string `tfschema:"name"`
+	AlternateId             string `tfschema:"alternate_id"`
+	AssetId                 string `tfschema:"asset_id"`
+	Container               string `tfschema:"container"`
+	Description             string `tfschema:"description"`
+	StorageAccountName      string `tfschema:"storage_account_name"`
+	StorageEncryptionFormat string `tfschema:"storage_encryption_format"`
+}
+
+func (r AssetsDataSource) ResourceType() string {
+	return "azurerm_media_assets"
+}
+
+func (r AssetsDataSource) ModelObject() interface{} {
+	return &AssetsDataSourceModel{}
+}
+
+func (r AssetsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+		"media_services_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r AssetsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assets": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"alternate_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"asset_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"container": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"storage_account_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"storage_encryption_format": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r AssetsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Media.V20220801Client.AssetsAndAssetFilters
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state AssetsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := assetsandassetfilters.NewMediaServiceID(subscriptionId, state.ResourceGroupName, state.MediaServicesAccountName)
+
+			resp, err := client.AssetsListComplete(ctx, id, assetsandassetfilters.DefaultAssetsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Assets within %s: %+v", id, err)
+			}
+
+			state.Assets = make([]AssetDataSourceItem, 0)
+			for _, item := range resp.Items {
+				asset := AssetDataSourceItem{
+					Name: pointer.From(item.Name),
+				}
+
+				if props := item.Properties; props != nil {
+					asset.AlternateId = pointer.From(props.AlternateId)
+					asset.AssetId = pointer.From(props.AssetId)
+					asset.Container = pointer.From(props.Container)
+					asset.Description = pointer.From(props.Description)
+					asset.StorageAccountName = pointer.From(props.StorageAccountName)
+					asset.StorageEncryptionFormat = string(pointer.From(props.StorageEncryptionFormat))
+				}
+
+				state.Assets = append(state.Assets, asset)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
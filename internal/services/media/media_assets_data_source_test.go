@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package media_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MediaAssetsDataSource struct{}
+
+func TestAccMediaAssetsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_media_assets", "test")
+	d := MediaAssetsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("assets.#").HasValue("1"),
+				check.That(data.ResourceName).Key("assets.0.name").HasValue("Asset-Content1"),
+				check.That(data.ResourceName).Key("assets.0.container").Exists(),
+				check.That(data.ResourceName).Key("assets.0.storage_account_name").Exists(),
+			),
+		},
+	})
+}
+
+func (MediaAssetsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_media_assets" "test" {
+  resource_group_name         = azurerm_media_asset.test.resource_group_name
+  media_services_account_name = azurerm_media_asset.test.media_services_account_name
+}
+`, MediaAssetResource{}.basic(data))
+}
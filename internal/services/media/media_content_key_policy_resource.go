@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/contentkeypolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/media/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/media/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -533,7 +534,13 @@ func resourceMediaContentKeyPolicyRead(d *pluginsdk.ResourceData, meta interface
 
 	resp, err := client.GetPolicyPropertiesWithSecrets(ctx, *id)
 	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
+		retired := resourceproviders.WasRetired(resp.HttpResponse, resourceproviders.RetiredNamespaceMedia)
+		if retired && meta.(*clients.Client).Features.RetiredServices.RemoveFromStateWhenUnavailable {
+			log.Printf("[INFO] the Resource Provider for %s has been retired - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		if !retired && response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", id)
 			d.SetId("")
 			return nil
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-07-01/encodings"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/media/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -217,7 +218,13 @@ func resourceMediaJobRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	resp, err := client.JobsGet(ctx, *id)
 	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
+		retired := resourceproviders.WasRetired(resp.HttpResponse, resourceproviders.RetiredNamespaceMedia)
+		if retired && meta.(*clients.Client).Features.RetiredServices.RemoveFromStateWhenUnavailable {
+			log.Printf("[INFO] the Resource Provider for %s has been retired - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		if !retired && response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", id)
 			d.SetId("")
 			return nil
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package media
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/streamingpoliciesandstreaminglocators"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = StreamingLocatorsDataSource{}
+
+type StreamingLocatorsDataSource struct{}
+
+type StreamingLocatorsDataSourceModel struct {
+	ResourceGroupName        string                           `tfschema:"resource_group_name"`
+	MediaServicesAccountName string                           `tfschema:"media_services_account_name"`
+	StreamingLocators        []StreamingLocatorDataSourceItem `tfschema:"streaming_locators"`
+}
+
+type StreamingLocatorDataSourceItem struct {
+	Name                string                         `tfschema:"name"`
+	AssetName           string                         `tfschema:"asset_name"`
+	StreamingLocatorId  string                         `tfschema:"streaming_locator_id"`
+	StreamingPolicyName string                         `tfschema:"streaming_policy_name"`
+	DownloadPaths       []string                       `tfschema:"download_paths"`
+	StreamingPaths      []StreamingPathDataSourceModel `tfschema:"streaming_path"`
+}
+
+type StreamingPathDataSourceModel struct {
+	EncryptionScheme  string   `tfschema:"encryption_scheme"`
+	Paths             []string `tfschema:"paths"`
+	StreamingProtocol string   `tfschema:"streaming_protocol"`
+}
+
+func (r StreamingLocatorsDataSource) ResourceType() string {
+	return "azurerm_media_streaming_locators"
+}
+
+func (r StreamingLocatorsDataSource) ModelObject() interface{} {
+	return &StreamingLocatorsDataSourceModel{}
+}
+
+func (r StreamingLocatorsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+		"media_services_account_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StreamingLocatorsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"streaming_locators": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"asset_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"streaming_locator_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"streaming_policy_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"download_paths": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"streaming_path": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"encryption_scheme": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"paths": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"streaming_protocol": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StreamingLocatorsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Media.V20220801Client.StreamingPoliciesAndStreamingLocators
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state StreamingLocatorsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := streamingpoliciesandstreaminglocators.NewMediaServiceID(subscriptionId, state.ResourceGroupName, state.MediaServicesAccountName)
+
+			resp, err := client.StreamingLocatorsListComplete(ctx, id, streamingpoliciesandstreaminglocators.DefaultStreamingLocatorsListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Streaming Locators within %s: %+v", id, err)
+			}
+
+			state.StreamingLocators = make([]StreamingLocatorDataSourceItem, 0)
+			for _, item := range resp.Items {
+				if item.Name == nil {
+					continue
+				}
+
+				locator := StreamingLocatorDataSourceItem{
+					Name:           *item.Name,
+					DownloadPaths:  make([]string, 0),
+					StreamingPaths: make([]StreamingPathDataSourceModel, 0),
+				}
+
+				if props := item.Properties; props != nil {
+					locator.AssetName = props.AssetName
+					locator.StreamingLocatorId = pointer.From(props.StreamingLocatorId)
+					locator.StreamingPolicyName = props.StreamingPolicyName
+				}
+
+				locatorId := streamingpoliciesandstreaminglocators.NewStreamingLocatorID(id.SubscriptionId, id.ResourceGroupName, id.MediaServiceName, *item.Name)
+				paths, err := client.StreamingLocatorsListPaths(ctx, locatorId)
+				if err != nil {
+					return fmt.Errorf("listing paths for %s: %+v", locatorId, err)
+				}
+
+				if model := paths.Model; model != nil {
+					locator.DownloadPaths = pointer.From(model.DownloadPaths)
+
+					if model.StreamingPaths != nil {
+						for _, path := range *model.StreamingPaths {
+							locator.StreamingPaths = append(locator.StreamingPaths, StreamingPathDataSourceModel{
+								EncryptionScheme:  string(path.EncryptionScheme),
+								Paths:             pointer.From(path.Paths),
+								StreamingProtocol: string(path.StreamingProtocol),
+							})
+						}
+					}
+				}
+
+				state.StreamingLocators = append(state.StreamingLocators, locator)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package media_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MediaStreamingLocatorsDataSource struct{}
+
+func TestAccMediaStreamingLocatorsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_media_streaming_locators", "test")
+	d := MediaStreamingLocatorsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("streaming_locators.#").HasValue("1"),
+				check.That(data.ResourceName).Key("streaming_locators.0.name").HasValue("Locator-1"),
+				check.That(data.ResourceName).Key("streaming_locators.0.asset_name").HasValue("test"),
+			),
+		},
+	})
+}
+
+func (MediaStreamingLocatorsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_media_streaming_locators" "test" {
+  resource_group_name         = azurerm_media_streaming_locator.test.resource_group_name
+  media_services_account_name = azurerm_media_streaming_locator.test.media_services_account_name
+}
+`, StreamingLocatorResource{}.basic(data))
+}
@@ -63,6 +63,13 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
+	if !features.FourPointOhBeta() {
+		return []sdk.DataSource{
+			AssetsDataSource{},
+			StreamingLocatorsDataSource{},
+		}
+	}
+
 	return []sdk.DataSource{}
 }
 
@@ -0,0 +1,499 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	alertProcessingRuleScheduleTimeLayout    = "2006-01-02T15:04:05"
+	alertProcessingRuleScheduleDayTimeLayout = "15:04:05"
+
+	// alertProcessingRuleSchedulePreviewCount is the number of upcoming windows exposed in `schedule_preview`
+	alertProcessingRuleSchedulePreviewCount = 5
+
+	// alertProcessingRuleSchedulePreviewDays is how far ahead windows are looked for, since a monthly recurrence
+	// on e.g. the 31st may not occur for a couple of months
+	alertProcessingRuleSchedulePreviewDays = 366
+)
+
+// alertProcessingRuleTimeZoneLocations maps the Windows time zones supported by Alert Processing Rules to the
+// equivalent IANA time zone, following the mappings used by the Unicode CLDR
+var alertProcessingRuleTimeZoneLocations = map[string]string{
+	"Afghanistan Standard Time":       "Asia/Kabul",
+	"Alaskan Standard Time":           "America/Anchorage",
+	"Aleutian Standard Time":          "America/Adak",
+	"Altai Standard Time":             "Asia/Barnaul",
+	"Arab Standard Time":              "Asia/Riyadh",
+	"Arabian Standard Time":           "Asia/Dubai",
+	"Arabic Standard Time":            "Asia/Baghdad",
+	"Argentina Standard Time":         "America/Argentina/Buenos_Aires",
+	"Astrakhan Standard Time":         "Europe/Astrakhan",
+	"Atlantic Standard Time":          "America/Halifax",
+	"AUS Central Standard Time":       "Australia/Darwin",
+	"Aus Central W. Standard Time":    "Australia/Eucla",
+	"AUS Eastern Standard Time":       "Australia/Sydney",
+	"Azerbaijan Standard Time":        "Asia/Baku",
+	"Azores Standard Time":            "Atlantic/Azores",
+	"Bahia Standard Time":             "America/Bahia",
+	"Bangladesh Standard Time":        "Asia/Dhaka",
+	"Belarus Standard Time":           "Europe/Minsk",
+	"Bougainville Standard Time":      "Pacific/Bougainville",
+	"Canada Central Standard Time":    "America/Regina",
+	"Cape Verde Standard Time":        "Atlantic/Cape_Verde",
+	"Caucasus Standard Time":          "Asia/Yerevan",
+	"Cen. Australia Standard Time":    "Australia/Adelaide",
+	"Central America Standard Time":   "America/Guatemala",
+	"Central Asia Standard Time":      "Asia/Bishkek",
+	"Central Brazilian Standard Time": "America/Cuiaba",
+	"Central Europe Standard Time":    "Europe/Budapest",
+	"Central European Standard Time":  "Europe/Warsaw",
+	"Central Pacific Standard Time":   "Pacific/Guadalcanal",
+	"Central Standard Time":           "America/Chicago",
+	"Central Standard Time (Mexico)":  "America/Mexico_City",
+	"Chatham Islands Standard Time":   "Pacific/Chatham",
+	"China Standard Time":             "Asia/Shanghai",
+	"Cuba Standard Time":              "America/Havana",
+	"Dateline Standard Time":          "Etc/GMT+12",
+	"E. Africa Standard Time":         "Africa/Nairobi",
+	"E. Australia Standard Time":      "Australia/Brisbane",
+	"E. Europe Standard Time":         "Europe/Chisinau",
+	"E. South America Standard Time":  "America/Sao_Paulo",
+	"Easter Island Standard Time":     "Pacific/Easter",
+	"Eastern Standard Time":           "America/New_York",
+	"Eastern Standard Time (Mexico)":  "America/Cancun",
+	"Egypt Standard Time":             "Africa/Cairo",
+	"Ekaterinburg Standard Time":      "Asia/Yekaterinburg",
+	"Fiji Standard Time":              "Pacific/Fiji",
+	"FLE Standard Time":               "Europe/Kiev",
+	"Georgian Standard Time":          "Asia/Tbilisi",
+	"GMT Standard Time":               "Europe/London",
+	"Greenland Standard Time":         "America/Nuuk",
+	"Greenwich Standard Time":         "Atlantic/Reykjavik",
+	"GTB Standard Time":               "Europe/Bucharest",
+	"Haiti Standard Time":             "America/Port-au-Prince",
+	"Hawaiian Standard Time":          "Pacific/Honolulu",
+	"India Standard Time":             "Asia/Kolkata",
+	"Iran Standard Time":              "Asia/Tehran",
+	"Israel Standard Time":            "Asia/Jerusalem",
+	"Jordan Standard Time":            "Asia/Amman",
+	"Kaliningrad Standard Time":       "Europe/Kaliningrad",
+	"Kamchatka Standard Time":         "Asia/Kamchatka",
+	"Korea Standard Time":             "Asia/Seoul",
+	"Libya Standard Time":             "Africa/Tripoli",
+	"Line Islands Standard Time":      "Pacific/Kiritimati",
+	"Lord Howe Standard Time":         "Australia/Lord_Howe",
+	"Magadan Standard Time":           "Asia/Magadan",
+	"Magallanes Standard Time":        "America/Punta_Arenas",
+	"Marquesas Standard Time":         "Pacific/Marquesas",
+	"Mauritius Standard Time":         "Indian/Mauritius",
+	"Mid-Atlantic Standard Time":      "Etc/GMT+2",
+	"Middle East Standard Time":       "Asia/Beirut",
+	"Montevideo Standard Time":        "America/Montevideo",
+	"Morocco Standard Time":           "Africa/Casablanca",
+	"Mountain Standard Time":          "America/Denver",
+	"Mountain Standard Time (Mexico)": "America/Mazatlan",
+	"Myanmar Standard Time":           "Asia/Yangon",
+	"N. Central Asia Standard Time":   "Asia/Novosibirsk",
+	"Namibia Standard Time":           "Africa/Windhoek",
+	"Nepal Standard Time":             "Asia/Kathmandu",
+	"New Zealand Standard Time":       "Pacific/Auckland",
+	"Newfoundland Standard Time":      "America/St_Johns",
+	"Norfolk Standard Time":           "Pacific/Norfolk",
+	"North Asia East Standard Time":   "Asia/Irkutsk",
+	"North Asia Standard Time":        "Asia/Krasnoyarsk",
+	"North Korea Standard Time":       "Asia/Pyongyang",
+	"Omsk Standard Time":              "Asia/Omsk",
+	"Pacific SA Standard Time":        "America/Santiago",
+	"Pacific Standard Time":           "America/Los_Angeles",
+	"Pacific Standard Time (Mexico)":  "America/Tijuana",
+	"Pakistan Standard Time":          "Asia/Karachi",
+	"Paraguay Standard Time":          "America/Asuncion",
+	"Qyzylorda Standard Time":         "Asia/Qyzylorda",
+	"Romance Standard Time":           "Europe/Paris",
+	"Russia Time Zone 10":             "Asia/Srednekolymsk",
+	"Russia Time Zone 11":             "Asia/Kamchatka",
+	"Russia Time Zone 3":              "Europe/Samara",
+	"Russian Standard Time":           "Europe/Moscow",
+	"SA Eastern Standard Time":        "America/Cayenne",
+	"SA Pacific Standard Time":        "America/Bogota",
+	"SA Western Standard Time":        "America/La_Paz",
+	"Saint Pierre Standard Time":      "America/Miquelon",
+	"Sakhalin Standard Time":          "Asia/Sakhalin",
+	"Samoa Standard Time":             "Pacific/Apia",
+	"Sao Tome Standard Time":          "Africa/Sao_Tome",
+	"Saratov Standard Time":           "Europe/Saratov",
+	"SE Asia Standard Time":           "Asia/Bangkok",
+	"Singapore Standard Time":         "Asia/Singapore",
+	"South Africa Standard Time":      "Africa/Johannesburg",
+	"South Sudan Standard Time":       "Africa/Juba",
+	"Sri Lanka Standard Time":         "Asia/Colombo",
+	"Sudan Standard Time":             "Africa/Khartoum",
+	"Syria Standard Time":             "Asia/Damascus",
+	"Taipei Standard Time":            "Asia/Taipei",
+	"Tasmania Standard Time":          "Australia/Hobart",
+	"Tocantins Standard Time":         "America/Araguaina",
+	"Tokyo Standard Time":             "Asia/Tokyo",
+	"Tomsk Standard Time":             "Asia/Tomsk",
+	"Tonga Standard Time":             "Pacific/Tongatapu",
+	"Transbaikal Standard Time":       "Asia/Chita",
+	"Turkey Standard Time":            "Europe/Istanbul",
+	"Turks And Caicos Standard Time":  "America/Grand_Turk",
+	"Ulaanbaatar Standard Time":       "Asia/Ulaanbaatar",
+	"US Eastern Standard Time":        "America/Indiana/Indianapolis",
+	"US Mountain Standard Time":       "America/Phoenix",
+	"UTC":                             "UTC",
+	"UTC-02":                          "Etc/GMT+2",
+	"UTC-08":                          "Etc/GMT+8",
+	"UTC-09":                          "Etc/GMT+9",
+	"UTC-11":                          "Etc/GMT+11",
+	"UTC+12":                          "Etc/GMT-12",
+	"UTC+13":                          "Etc/GMT-13",
+	"Venezuela Standard Time":         "America/Caracas",
+	"Vladivostok Standard Time":       "Asia/Vladivostok",
+	"Volgograd Standard Time":         "Europe/Volgograd",
+	"W. Australia Standard Time":      "Australia/Perth",
+	"W. Central Africa Standard Time": "Africa/Lagos",
+	"W. Europe Standard Time":         "Europe/Berlin",
+	"W. Mongolia Standard Time":       "Asia/Hovd",
+	"West Asia Standard Time":         "Asia/Tashkent",
+	"West Bank Standard Time":         "Asia/Hebron",
+	"West Pacific Standard Time":      "Pacific/Port_Moresby",
+	"Yakutsk Standard Time":           "Asia/Yakutsk",
+	"Yukon Standard Time":             "America/Whitehorse",
+}
+
+type AlertProcessingRuleScheduleWindowModel struct {
+	StartTime string `tfschema:"start_time"`
+	EndTime   string `tfschema:"end_time"`
+}
+
+// alertProcessingRuleScheduleWindow is a period during which an Alert Processing Rule applies, where a zero
+// Start or End means the window is open-ended in that direction
+type alertProcessingRuleScheduleWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (w alertProcessingRuleScheduleWindow) contains(t time.Time) bool {
+	return (w.Start.IsZero() || !t.Before(w.Start)) && (w.End.IsZero() || t.Before(w.End))
+}
+
+func schemaAlertProcessingRuleSchedulePreview() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"start_time": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"end_time": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func alertProcessingRuleScheduleLocation(timeZone string) (*time.Location, error) {
+	if timeZone == "" {
+		return time.UTC, nil
+	}
+
+	name, ok := alertProcessingRuleTimeZoneLocations[timeZone]
+	if !ok {
+		return nil, fmt.Errorf("the time zone %q is not supported", timeZone)
+	}
+
+	return time.LoadLocation(name)
+}
+
+// validateAlertProcessingRuleSchedule checks the parts of a schedule which can't be validated by the schema alone
+func validateAlertProcessingRuleSchedule(input []AlertProcessingRuleScheduleModel) error {
+	if len(input) == 0 {
+		return nil
+	}
+	schedule := input[0]
+
+	loc, err := alertProcessingRuleScheduleLocation(schedule.TimeZone)
+	if err != nil {
+		return fmt.Errorf("`schedule.0.time_zone`: %+v", err)
+	}
+
+	var effectiveFrom, effectiveUntil time.Time
+	if schedule.EffectiveFrom != "" {
+		if effectiveFrom, err = time.ParseInLocation(alertProcessingRuleScheduleTimeLayout, schedule.EffectiveFrom, loc); err != nil {
+			return fmt.Errorf("parsing `schedule.0.effective_from`: %+v", err)
+		}
+	}
+	if schedule.EffectiveUntil != "" {
+		if effectiveUntil, err = time.ParseInLocation(alertProcessingRuleScheduleTimeLayout, schedule.EffectiveUntil, loc); err != nil {
+			return fmt.Errorf("parsing `schedule.0.effective_until`: %+v", err)
+		}
+	}
+	if !effectiveFrom.IsZero() && !effectiveUntil.IsZero() && !effectiveUntil.After(effectiveFrom) {
+		return fmt.Errorf("`schedule.0.effective_until` must be later than `schedule.0.effective_from`")
+	}
+
+	if len(schedule.Recurrence) == 0 {
+		return nil
+	}
+	recurrence := schedule.Recurrence[0]
+	if len(recurrence.Daily) == 0 && len(recurrence.Weekly) == 0 && len(recurrence.Monthly) == 0 {
+		return fmt.Errorf("`schedule.0.recurrence` must contain at least one `daily`, `weekly` or `monthly` block")
+	}
+
+	for i, v := range recurrence.Daily {
+		if err := validateAlertProcessingRuleRecurrenceTimes(fmt.Sprintf("schedule.0.recurrence.0.daily.%d", i), v.StartTime, v.EndTime); err != nil {
+			return err
+		}
+	}
+
+	for i, v := range recurrence.Weekly {
+		key := fmt.Sprintf("schedule.0.recurrence.0.weekly.%d", i)
+		if err := validateAlertProcessingRuleRecurrenceTimes(key, v.StartTime, v.EndTime); err != nil {
+			return err
+		}
+
+		days := make(map[string]struct{})
+		for _, day := range v.DaysOfWeek {
+			if _, ok := days[day]; ok {
+				return fmt.Errorf("`%s.days_of_week` contains %q more than once", key, day)
+			}
+			days[day] = struct{}{}
+		}
+	}
+
+	for i, v := range recurrence.Monthly {
+		key := fmt.Sprintf("schedule.0.recurrence.0.monthly.%d", i)
+		if err := validateAlertProcessingRuleRecurrenceTimes(key, v.StartTime, v.EndTime); err != nil {
+			return err
+		}
+
+		days := make(map[int64]struct{})
+		for _, day := range v.DaysOfMonth {
+			if _, ok := days[day]; ok {
+				return fmt.Errorf("`%s.days_of_month` contains %d more than once", key, day)
+			}
+			days[day] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func validateAlertProcessingRuleRecurrenceTimes(key, startTime, endTime string) error {
+	if (startTime == "") != (endTime == "") {
+		return fmt.Errorf("`%[1]s.start_time` and `%[1]s.end_time` must be specified together", key)
+	}
+
+	if startTime == "" {
+		return nil
+	}
+
+	start, err := time.Parse(alertProcessingRuleScheduleDayTimeLayout, startTime)
+	if err != nil {
+		return fmt.Errorf("parsing `%s.start_time`: %+v", key, err)
+	}
+	end, err := time.Parse(alertProcessingRuleScheduleDayTimeLayout, endTime)
+	if err != nil {
+		return fmt.Errorf("parsing `%s.end_time`: %+v", key, err)
+	}
+	if start.Equal(end) {
+		return fmt.Errorf("`%[1]s.start_time` and `%[1]s.end_time` must be different, omit both to apply for the whole day", key)
+	}
+
+	return nil
+}
+
+// alertProcessingRuleScheduleWindows returns up to `limit` windows during which the schedule applies, in order,
+// which end after `from` - an empty schedule applies at all times and so returns no windows.
+func alertProcessingRuleScheduleWindows(input []AlertProcessingRuleScheduleModel, from time.Time, limit int) ([]alertProcessingRuleScheduleWindow, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	schedule := input[0]
+
+	loc, err := alertProcessingRuleScheduleLocation(schedule.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := alertProcessingRuleScheduleWindow{}
+	if schedule.EffectiveFrom != "" {
+		if effective.Start, err = time.ParseInLocation(alertProcessingRuleScheduleTimeLayout, schedule.EffectiveFrom, loc); err != nil {
+			return nil, fmt.Errorf("parsing `effective_from`: %+v", err)
+		}
+	}
+	if schedule.EffectiveUntil != "" {
+		if effective.End, err = time.ParseInLocation(alertProcessingRuleScheduleTimeLayout, schedule.EffectiveUntil, loc); err != nil {
+			return nil, fmt.Errorf("parsing `effective_until`: %+v", err)
+		}
+	}
+
+	if !effective.End.IsZero() && !effective.End.After(from) {
+		return []alertProcessingRuleScheduleWindow{}, nil
+	}
+
+	if len(schedule.Recurrence) == 0 {
+		return []alertProcessingRuleScheduleWindow{effective}, nil
+	}
+	recurrence := schedule.Recurrence[0]
+
+	// start from the day before, since a window which started then may span midnight
+	start := from
+	if effective.Start.After(start) {
+		start = effective.Start
+	}
+	start = start.In(loc)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, -1)
+
+	windows := make([]alertProcessingRuleScheduleWindow, 0)
+	for i := 0; i <= alertProcessingRuleSchedulePreviewDays && len(windows) < limit; i++ {
+		if !effective.End.IsZero() && !day.Before(effective.End) {
+			break
+		}
+
+		dayWindows := make([]alertProcessingRuleScheduleWindow, 0)
+		for _, v := range recurrence.Daily {
+			dayWindows = append(dayWindows, alertProcessingRuleRecurrenceWindow(day, v.StartTime, v.EndTime))
+		}
+		for _, v := range recurrence.Weekly {
+			for _, d := range v.DaysOfWeek {
+				if d == day.Weekday().String() {
+					dayWindows = append(dayWindows, alertProcessingRuleRecurrenceWindow(day, v.StartTime, v.EndTime))
+				}
+			}
+		}
+		for _, v := range recurrence.Monthly {
+			for _, d := range v.DaysOfMonth {
+				if d == int64(day.Day()) {
+					dayWindows = append(dayWindows, alertProcessingRuleRecurrenceWindow(day, v.StartTime, v.EndTime))
+				}
+			}
+		}
+
+		sort.Slice(dayWindows, func(a, b int) bool {
+			return dayWindows[a].Start.Before(dayWindows[b].Start)
+		})
+
+		for _, w := range dayWindows {
+			// clip the window to the period the schedule is effective for
+			if !effective.Start.IsZero() && w.Start.Before(effective.Start) {
+				w.Start = effective.Start
+			}
+			if !effective.End.IsZero() && w.End.After(effective.End) {
+				w.End = effective.End
+			}
+			if !w.End.After(w.Start) || !w.End.After(from) {
+				continue
+			}
+
+			// merge overlapping windows, e.g. from a daily and a weekly recurrence
+			if n := len(windows); n > 0 && !w.Start.After(windows[n-1].End) {
+				if w.End.After(windows[n-1].End) {
+					windows[n-1].End = w.End
+				}
+				continue
+			}
+
+			if len(windows) == limit {
+				break
+			}
+			windows = append(windows, w)
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return windows, nil
+}
+
+// alertProcessingRuleRecurrenceWindow returns the window for a recurrence on the specified day, where a recurrence
+// without times applies for the whole day and one which ends before it starts applies until the next day
+func alertProcessingRuleRecurrenceWindow(day time.Time, startTime, endTime string) alertProcessingRuleScheduleWindow {
+	window := alertProcessingRuleScheduleWindow{
+		Start: day,
+		End:   day.AddDate(0, 0, 1),
+	}
+
+	if startTime == "" || endTime == "" {
+		return window
+	}
+
+	start, errStart := time.Parse(alertProcessingRuleScheduleDayTimeLayout, startTime)
+	end, errEnd := time.Parse(alertProcessingRuleScheduleDayTimeLayout, endTime)
+	if errStart != nil || errEnd != nil {
+		return window
+	}
+
+	window.Start = time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, day.Location())
+	window.End = time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), end.Second(), 0, day.Location())
+	if !window.End.After(window.Start) {
+		window.End = window.End.AddDate(0, 0, 1)
+	}
+
+	return window
+}
+
+func flattenAlertProcessingRuleSchedulePreview(input []alertProcessingRuleScheduleWindow) []AlertProcessingRuleScheduleWindowModel {
+	result := make([]AlertProcessingRuleScheduleWindowModel, 0, len(input))
+	for _, w := range input {
+		window := AlertProcessingRuleScheduleWindowModel{}
+		if !w.Start.IsZero() {
+			window.StartTime = w.Start.UTC().Format(time.RFC3339)
+		}
+		if !w.End.IsZero() {
+			window.EndTime = w.End.UTC().Format(time.RFC3339)
+		}
+		result = append(result, window)
+	}
+
+	return result
+}
+
+// customizeDiffAlertProcessingRuleSchedule validates the `schedule` of an Alert Processing Rule and, when `preview` is
+// set, plans the upcoming windows in `schedule_preview` so that the effect of a change (e.g. to the `time_zone`) is shown
+func customizeDiffAlertProcessingRuleSchedule(metadata sdk.ResourceMetaData, schedule []AlertProcessingRuleScheduleModel, preview bool) error {
+	// values which aren't known yet are decoded as empty, so these can only be checked once they're known
+	if !metadata.ResourceDiff.GetRawConfig().GetAttr("schedule").IsWhollyKnown() {
+		if preview {
+			return metadata.ResourceDiff.SetNewComputed("schedule_preview")
+		}
+		return nil
+	}
+
+	if err := validateAlertProcessingRuleSchedule(schedule); err != nil {
+		return err
+	}
+
+	if !preview || (metadata.ResourceDiff.Id() != "" && !metadata.ResourceDiff.HasChange("schedule")) {
+		return nil
+	}
+
+	windows, err := alertProcessingRuleScheduleWindows(schedule, time.Now(), alertProcessingRuleSchedulePreviewCount)
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0)
+	for _, w := range flattenAlertProcessingRuleSchedulePreview(windows) {
+		result = append(result, map[string]interface{}{
+			"start_time": w.StartTime,
+			"end_time":   w.EndTime,
+		})
+	}
+
+	return metadata.ResourceDiff.SetNew("schedule_preview", result)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlertProcessingRuleTimeZoneLocations(t *testing.T) {
+	for windowsName, ianaName := range alertProcessingRuleTimeZoneLocations {
+		if _, err := time.LoadLocation(ianaName); err != nil {
+			t.Fatalf("loading the location %q for the time zone %q: %+v", ianaName, windowsName, err)
+		}
+	}
+}
+
+func TestValidateAlertProcessingRuleSchedule(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    AlertProcessingRuleScheduleModel
+		expected bool
+	}{
+		{
+			name: "effective period",
+			input: AlertProcessingRuleScheduleModel{
+				EffectiveFrom:  "2022-01-01T01:02:03",
+				EffectiveUntil: "2022-02-02T01:02:03",
+				TimeZone:       "Pacific Standard Time",
+			},
+			expected: true,
+		},
+		{
+			name: "effective until before effective from",
+			input: AlertProcessingRuleScheduleModel{
+				EffectiveFrom:  "2022-02-02T01:02:03",
+				EffectiveUntil: "2022-01-01T01:02:03",
+				TimeZone:       "UTC",
+			},
+			expected: false,
+		},
+		{
+			name: "empty recurrence",
+			input: AlertProcessingRuleScheduleModel{
+				TimeZone:   "UTC",
+				Recurrence: []AlertProcessingRuleRecurrenceModel{{}},
+			},
+			expected: false,
+		},
+		{
+			name: "overnight daily recurrence",
+			input: AlertProcessingRuleScheduleModel{
+				TimeZone: "UTC",
+				Recurrence: []AlertProcessingRuleRecurrenceModel{{
+					Daily: []AlertProcessingRuleDailyModel{{StartTime: "22:00:00", EndTime: "06:00:00"}},
+				}},
+			},
+			expected: true,
+		},
+		{
+			name: "daily recurrence without a duration",
+			input: AlertProcessingRuleScheduleModel{
+				TimeZone: "UTC",
+				Recurrence: []AlertProcessingRuleRecurrenceModel{{
+					Daily: []AlertProcessingRuleDailyModel{{StartTime: "06:00:00", EndTime: "06:00:00"}},
+				}},
+			},
+			expected: false,
+		},
+		{
+			name: "weekly recurrence with only a start time",
+			input: AlertProcessingRuleScheduleModel{
+				TimeZone: "UTC",
+				Recurrence: []AlertProcessingRuleRecurrenceModel{{
+					Weekly: []AlertProcessingRuleWeeklyModel{{StartTime: "06:00:00", DaysOfWeek: []string{"Monday"}}},
+				}},
+			},
+			expected: false,
+		},
+		{
+			name: "weekly recurrence with duplicate days",
+			input: AlertProcessingRuleScheduleModel{
+				TimeZone: "UTC",
+				Recurrence: []AlertProcessingRuleRecurrenceModel{{
+					Weekly: []AlertProcessingRuleWeeklyModel{{DaysOfWeek: []string{"Monday", "Monday"}}},
+				}},
+			},
+			expected: false,
+		},
+		{
+			name: "monthly recurrence with duplicate days",
+			input: AlertProcessingRuleScheduleModel{
+				TimeZone: "UTC",
+				Recurrence: []AlertProcessingRuleRecurrenceModel{{
+					Monthly: []AlertProcessingRuleMonthlyModel{{DaysOfMonth: []int64{1, 15, 1}}},
+				}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		err := validateAlertProcessingRuleSchedule([]AlertProcessingRuleScheduleModel{v.input})
+		if (err == nil) != v.expected {
+			t.Fatalf("expected valid to be %t but got error: %+v", v.expected, err)
+		}
+	}
+}
+
+func TestAlertProcessingRuleScheduleWindows(t *testing.T) {
+	schedule := []AlertProcessingRuleScheduleModel{{
+		EffectiveFrom: "2023-03-10T00:00:00",
+		TimeZone:      "Pacific Standard Time",
+		Recurrence: []AlertProcessingRuleRecurrenceModel{{
+			Daily: []AlertProcessingRuleDailyModel{{StartTime: "22:00:00", EndTime: "06:00:00"}},
+		}},
+	}}
+
+	// Daylight Saving Time starts in the Pacific time zone on 2023-03-12, so the UTC times shift by an hour
+	expected := []AlertProcessingRuleScheduleWindowModel{
+		{StartTime: "2023-03-10T08:00:00Z", EndTime: "2023-03-10T14:00:00Z"},
+		{StartTime: "2023-03-11T06:00:00Z", EndTime: "2023-03-11T14:00:00Z"},
+		{StartTime: "2023-03-12T06:00:00Z", EndTime: "2023-03-12T13:00:00Z"},
+	}
+
+	from := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	windows, err := alertProcessingRuleScheduleWindows(schedule, from, len(expected))
+	if err != nil {
+		t.Fatalf("calculating windows: %+v", err)
+	}
+
+	actual := flattenAlertProcessingRuleSchedulePreview(windows)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d windows but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected window %d to be %+v but got %+v", i, expected[i], actual[i])
+		}
+	}
+
+	if !windows[1].contains(time.Date(2023, 3, 11, 7, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected the schedule to apply at 2023-03-10T23:00:00 Pacific Standard Time")
+	}
+}
+
+func TestAlertProcessingRuleAppliesToScope(t *testing.T) {
+	ruleScopes := []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"}
+
+	testData := []struct {
+		scope    string
+		expected bool
+	}{
+		{
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			expected: true,
+		},
+		{
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/EXAMPLE/providers/Microsoft.Compute/virtualMachines/vm1",
+			expected: true,
+		},
+		{
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example2",
+			expected: false,
+		},
+		{
+			scope:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		if actual := alertProcessingRuleAppliesToScope(ruleScopes, v.scope); actual != v.expected {
+			t.Fatalf("expected %t for %q but got %t", v.expected, v.scope, actual)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -39,6 +41,8 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceMonitorActivityLogAlertCustomizeDiff),
+
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
 			0: migration.ActivityLogAlertUpgradeV0ToV1{},
@@ -353,7 +357,7 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 										Optional: true,
 										Elem: &pluginsdk.Schema{
 											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
+											ValidateFunc: validate.ServiceHealthRegionName,
 										},
 										Set: pluginsdk.HashString,
 									},
@@ -411,6 +415,40 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 	}
 }
 
+func resourceMonitorActivityLogAlertCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("criteria.0.category") {
+		return nil
+	}
+
+	// the health conditions are only evaluated for events in the matching category, any other category silently never fires
+	category := d.Get("criteria.0.category").(string)
+	if category != "ResourceHealth" && monitorActivityLogAlertCriteriaBlockConfigured(d, "resource_health") {
+		return fmt.Errorf("`criteria.0.resource_health` can only be specified when `criteria.0.category` is `ResourceHealth`")
+	}
+	if category != "ServiceHealth" && monitorActivityLogAlertCriteriaBlockConfigured(d, "service_health") {
+		return fmt.Errorf("`criteria.0.service_health` can only be specified when `criteria.0.category` is `ServiceHealth`")
+	}
+
+	return nil
+}
+
+// monitorActivityLogAlertCriteriaBlockConfigured checks the raw config since the health blocks are Computed and
+// retain their previous value in the diff once removed from the config
+func monitorActivityLogAlertCriteriaBlockConfigured(d *pluginsdk.ResourceDiff, name string) bool {
+	criteria := d.GetRawConfig().GetAttr("criteria")
+	if !criteria.IsKnown() || criteria.IsNull() {
+		return false
+	}
+
+	items := criteria.AsValueSlice()
+	if len(items) == 0 || !items[0].IsKnown() || items[0].IsNull() {
+		return false
+	}
+
+	block := items[0].GetAttr(name)
+	return block.IsKnown() && !block.IsNull() && block.LengthInt() > 0
+}
+
 func resourceMonitorActivityLogAlertCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.ActivityLogAlertsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2020-10-01/activitylogalertsapis"
@@ -242,6 +243,22 @@ func TestAccMonitorActivityLogAlert_ResourceHealth_basicAndUpdate(t *testing.T)
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ResourceHealth_subscriptionScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.resourceHealth_subscriptionScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("criteria.0.resource_types.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMonitorActivityLogAlert_ResourceHealth_basicAndDelete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
 	r := MonitorActivityLogAlertResource{}
@@ -330,6 +347,18 @@ func TestAccMonitorActivityLogAlert_location(t *testing.T) {
 	})
 }
 
+func TestAccMonitorActivityLogAlert_ServiceHealth_categoryMismatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_activity_log_alert", "test")
+	r := MonitorActivityLogAlertResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.serviceHealthCategoryMismatch(data),
+			ExpectError: regexp.MustCompile("`criteria.0.service_health` can only be specified when `criteria.0.category` is `ServiceHealth`"),
+		},
+	})
+}
+
 func (MonitorActivityLogAlertResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -353,6 +382,34 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) serviceHealthCategoryMismatch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  criteria {
+    category = "Administrative"
+
+    service_health {
+      events    = ["Incident"]
+      locations = ["West Europe"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r MonitorActivityLogAlertResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -1096,6 +1153,50 @@ resource "azurerm_monitor_activity_log_alert" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomInteger)
 }
 
+func (MonitorActivityLogAlertResource) resourceHealth_subscriptionScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_activity_log_alert" "test" {
+  name                = "acctestActivityLogAlert-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [data.azurerm_subscription.current.id]
+
+  criteria {
+    category = "ResourceHealth"
+    resource_types = [
+      "Microsoft.Compute/virtualMachines",
+      "Microsoft.Storage/storageAccounts",
+    ]
+
+    resource_health {
+      current  = ["Degraded", "Unavailable"]
+      previous = ["Available"]
+    }
+  }
+
+  action {
+    action_group_id = azurerm_monitor_action_group.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
 func (MonitorActivityLogAlertResource) resourceHealth_delete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -30,7 +30,10 @@ type AlertProcessingRuleActionGroupModel struct {
 
 type AlertProcessingRuleActionGroupResource struct{}
 
-var _ sdk.ResourceWithUpdate = AlertProcessingRuleActionGroupResource{}
+var (
+	_ sdk.ResourceWithUpdate        = AlertProcessingRuleActionGroupResource{}
+	_ sdk.ResourceWithCustomizeDiff = AlertProcessingRuleActionGroupResource{}
+)
 
 func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_action_group"
@@ -61,6 +64,20 @@ func (r AlertProcessingRuleActionGroupResource) Attributes() map[string]*plugins
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r AlertProcessingRuleActionGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertProcessingRuleActionGroupModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return customizeDiffAlertProcessingRuleSchedule(metadata, model.Schedule, false)
+		},
+	}
+}
+
 func (r AlertProcessingRuleActionGroupResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
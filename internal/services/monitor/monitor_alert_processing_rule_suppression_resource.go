@@ -24,11 +24,16 @@ type AlertProcessingRuleSuppressionModel struct {
 	Condition         []AlertProcessingRuleConditionModel `tfschema:"condition"`
 	Schedule          []AlertProcessingRuleScheduleModel  `tfschema:"schedule"`
 	Tags              map[string]string                   `tfschema:"tags"`
+
+	SchedulePreview []AlertProcessingRuleScheduleWindowModel `tfschema:"schedule_preview"`
 }
 
 type AlertProcessingRuleSuppressionResource struct{}
 
-var _ sdk.ResourceWithUpdate = AlertProcessingRuleSuppressionResource{}
+var (
+	_ sdk.ResourceWithUpdate        = AlertProcessingRuleSuppressionResource{}
+	_ sdk.ResourceWithCustomizeDiff = AlertProcessingRuleSuppressionResource{}
+)
 
 func (r AlertProcessingRuleSuppressionResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_suppression"
@@ -48,7 +53,23 @@ func (r AlertProcessingRuleSuppressionResource) Arguments() map[string]*pluginsd
 }
 
 func (r AlertProcessingRuleSuppressionResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"schedule_preview": schemaAlertProcessingRuleSchedulePreview(),
+	}
+}
+
+func (r AlertProcessingRuleSuppressionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertProcessingRuleSuppressionModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return customizeDiffAlertProcessingRuleSchedule(metadata, model.Schedule, true)
+		},
+	}
 }
 
 func (r AlertProcessingRuleSuppressionResource) Create() sdk.ResourceFunc {
@@ -213,6 +234,12 @@ func (r AlertProcessingRuleSuppressionResource) Read() sdk.ResourceFunc {
 			state.Condition = flattenAlertProcessingRuleConditions(properties.Conditions)
 			state.Schedule = flattenAlertProcessingRuleSchedule(properties.Schedule)
 
+			windows, err := alertProcessingRuleScheduleWindows(state.Schedule, time.Now(), alertProcessingRuleSchedulePreviewCount)
+			if err != nil {
+				return fmt.Errorf("calculating `schedule_preview`: %+v", err)
+			}
+			state.SchedulePreview = flattenAlertProcessingRuleSchedulePreview(windows)
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -6,6 +6,7 @@ package monitor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
@@ -98,6 +99,42 @@ func TestAccMonitorAlertProcessingRuleSuppression_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorAlertProcessingRuleSuppression_schedulePreview(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := MonitorAlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.schedulePreview(data, "Pacific Standard Time"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule_preview.#").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.schedulePreview(data, "W. Europe Standard Time"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule_preview.#").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertProcessingRuleSuppression_invalidRecurrence(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_processing_rule_suppression", "test")
+	r := MonitorAlertProcessingRuleSuppressionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidRecurrence(data),
+			ExpectError: regexp.MustCompile("must be specified together"),
+		},
+	})
+}
+
 func (r MonitorAlertProcessingRuleSuppressionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := alertprocessingrules.ParseActionRuleIDInsensitively(state.ID)
 	if err != nil {
@@ -273,6 +310,49 @@ resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorAlertProcessingRuleSuppressionResource) schedulePreview(data acceptance.TestData, timeZone string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
+  name                = "acctest-moniter-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  schedule {
+    time_zone = "%s"
+    recurrence {
+      daily {
+        start_time = "22:00:00"
+        end_time   = "06:00:00"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, timeZone)
+}
+
+func (r MonitorAlertProcessingRuleSuppressionResource) invalidRecurrence(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "test" {
+  name                = "acctest-moniter-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+
+  schedule {
+    recurrence {
+      weekly {
+        start_time   = "17:00:00"
+        days_of_week = ["Monday"]
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (MonitorAlertProcessingRuleSuppressionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
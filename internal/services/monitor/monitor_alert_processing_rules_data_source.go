@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	alertProcessingRuleTypeActionGroup = "ActionGroup"
+	alertProcessingRuleTypeSuppression = "Suppression"
+)
+
+type AlertProcessingRulesDataSource struct{}
+
+var _ sdk.DataSource = AlertProcessingRulesDataSource{}
+
+type AlertProcessingRulesDataSourceModel struct {
+	Scope           string                               `tfschema:"scope"`
+	IncludeDisabled bool                                 `tfschema:"include_disabled"`
+	Rules           []AlertProcessingRulesDataSourceRule `tfschema:"rules"`
+}
+
+type AlertProcessingRulesDataSourceRule struct {
+	Id                string                                   `tfschema:"id"`
+	Name              string                                   `tfschema:"name"`
+	ResourceGroupName string                                   `tfschema:"resource_group_name"`
+	Type              string                                   `tfschema:"type"`
+	Description       string                                   `tfschema:"description"`
+	Enabled           bool                                     `tfschema:"enabled"`
+	Scopes            []string                                 `tfschema:"scopes"`
+	AddActionGroupIds []string                                 `tfschema:"add_action_group_ids"`
+	Active            bool                                     `tfschema:"active"`
+	SchedulePreview   []AlertProcessingRuleScheduleWindowModel `tfschema:"schedule_preview"`
+}
+
+func (d AlertProcessingRulesDataSource) ModelObject() interface{} {
+	return &AlertProcessingRulesDataSourceModel{}
+}
+
+func (d AlertProcessingRulesDataSource) ResourceType() string {
+	return "azurerm_monitor_alert_processing_rules"
+}
+
+func (d AlertProcessingRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"include_disabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (d AlertProcessingRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"rules": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"resource_group_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"scopes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"add_action_group_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"active": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"schedule_preview": schemaAlertProcessingRuleSchedulePreview(),
+				},
+			},
+		},
+	}
+}
+
+func (d AlertProcessingRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertProcessingRulesClient
+
+			var state AlertProcessingRulesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// Alert Processing Rules can only target scopes within the Subscription they're created in
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			if segments := strings.Split(strings.TrimPrefix(state.Scope, "/"), "/"); len(segments) > 1 && strings.EqualFold(segments[0], "subscriptions") {
+				subscriptionId = segments[1]
+			}
+			id := commonids.NewSubscriptionID(subscriptionId)
+
+			resp, err := client.ListBySubscriptionComplete(ctx, id)
+			if err != nil {
+				return fmt.Errorf("listing Alert Processing Rules within %s: %+v", id, err)
+			}
+
+			now := time.Now()
+			state.Rules = make([]AlertProcessingRulesDataSourceRule, 0)
+			for _, item := range resp.Items {
+				if item.Id == nil || item.Properties == nil {
+					continue
+				}
+				props := item.Properties
+
+				if !alertProcessingRuleAppliesToScope(props.Scopes, state.Scope) {
+					continue
+				}
+
+				enabled := pointer.From(props.Enabled)
+				if !enabled && !state.IncludeDisabled {
+					continue
+				}
+
+				ruleId, err := alertprocessingrules.ParseActionRuleIDInsensitively(*item.Id)
+				if err != nil {
+					return err
+				}
+
+				rule := AlertProcessingRulesDataSourceRule{
+					Id:                ruleId.ID(),
+					Name:              ruleId.ActionRuleName,
+					ResourceGroupName: ruleId.ResourceGroupName,
+					Description:       pointer.From(props.Description),
+					Enabled:           enabled,
+					Scopes:            props.Scopes,
+					AddActionGroupIds: make([]string, 0),
+				}
+
+				for _, action := range props.Actions {
+					switch v := action.(type) {
+					case alertprocessingrules.AddActionGroups:
+						rule.Type = alertProcessingRuleTypeActionGroup
+						rule.AddActionGroupIds = append(rule.AddActionGroupIds, v.ActionGroupIds...)
+					case alertprocessingrules.RemoveAllActionGroups:
+						rule.Type = alertProcessingRuleTypeSuppression
+					}
+				}
+
+				schedule := flattenAlertProcessingRuleSchedule(props.Schedule)
+				windows, err := alertProcessingRuleScheduleWindows(schedule, now, alertProcessingRuleSchedulePreviewCount)
+				if err != nil {
+					return fmt.Errorf("calculating the schedule of %s: %+v", ruleId, err)
+				}
+				rule.SchedulePreview = flattenAlertProcessingRuleSchedulePreview(windows)
+
+				// a rule without a schedule always applies
+				rule.Active = enabled && (len(schedule) == 0 || (len(windows) > 0 && windows[0].contains(now)))
+
+				state.Rules = append(state.Rules, rule)
+			}
+
+			sort.Slice(state.Rules, func(i, j int) bool {
+				return state.Rules[i].Id < state.Rules[j].Id
+			})
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.AlertsManagement/actionRules", strings.TrimSuffix(state.Scope, "/")))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// alertProcessingRuleAppliesToScope returns whether any of the scopes of an Alert Processing Rule are the specified
+// scope or one of its parents, e.g. a Resource Group containing the specified Resource
+func alertProcessingRuleAppliesToScope(ruleScopes []string, scope string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	for _, v := range ruleScopes {
+		ruleScope := strings.ToLower(strings.TrimSuffix(v, "/"))
+		if scope == ruleScope || strings.HasPrefix(scope, ruleScope+"/") {
+			return true
+		}
+	}
+
+	return false
+}
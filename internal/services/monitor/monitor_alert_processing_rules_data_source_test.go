@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MonitorAlertProcessingRulesDataSource struct{}
+
+func TestAccMonitorAlertProcessingRulesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_alert_processing_rules", "test")
+	d := MonitorAlertProcessingRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rules.0.type").HasValue("Suppression"),
+				check.That(data.ResourceName).Key("rules.0.active").HasValue("true"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorAlertProcessingRulesDataSource_includeDisabled(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_monitor_alert_processing_rules", "test")
+	d := MonitorAlertProcessingRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.includeDisabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rules.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (d MonitorAlertProcessingRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "disabled" {
+  name                = "acctest-moniter-disabled-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+  enabled             = false
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+data "azurerm_monitor_alert_processing_rules" "test" {
+  scope = azurerm_storage_account.test.id
+
+  depends_on = [
+    azurerm_monitor_alert_processing_rule_suppression.test,
+    azurerm_monitor_alert_processing_rule_suppression.disabled,
+  ]
+}
+`, MonitorAlertProcessingRuleSuppressionResource{}.basic(data), data.RandomInteger, data.RandomString)
+}
+
+func (d MonitorAlertProcessingRulesDataSource) includeDisabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_processing_rule_suppression" "disabled" {
+  name                = "acctest-moniter-disabled-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_resource_group.test.id]
+  enabled             = false
+}
+
+data "azurerm_monitor_alert_processing_rules" "test" {
+  scope            = azurerm_resource_group.test.id
+  include_disabled = true
+
+  depends_on = [
+    azurerm_monitor_alert_processing_rule_suppression.test,
+    azurerm_monitor_alert_processing_rule_suppression.disabled,
+  ]
+}
+`, MonitorAlertProcessingRuleSuppressionResource{}.basic(data), data.RandomInteger)
+}
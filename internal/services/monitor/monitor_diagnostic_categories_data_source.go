@@ -46,6 +46,25 @@ func dataSourceMonitorDiagnosticCategories() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"log_categories": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"category_groups": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+					},
+				},
+			},
+
 			"metrics": {
 				Type:     pluginsdk.TypeSet,
 				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
@@ -97,6 +116,7 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 	metrics := make([]string, 0)
 	logs := make([]string, 0)
 	categoryGroups := make([]string, 0)
+	logCategories := make([]interface{}, 0)
 
 	for _, v := range val {
 		if v.Name == nil {
@@ -111,6 +131,15 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 				switch *category.CategoryType {
 				case diagnosticsettingscategories.CategoryTypeLogs:
 					logs = append(logs, *v.Name)
+
+					groups := make([]string, 0)
+					if category.CategoryGroups != nil {
+						groups = *category.CategoryGroups
+					}
+					logCategories = append(logCategories, map[string]interface{}{
+						"name":            *v.Name,
+						"category_groups": groups,
+					})
 				case diagnosticsettingscategories.CategoryTypeMetrics:
 					metrics = append(metrics, *v.Name)
 				default:
@@ -124,6 +153,10 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("setting `log_category_types`: %+v", err)
 	}
 
+	if err := d.Set("log_categories", logCategories); err != nil {
+		return fmt.Errorf("setting `log_categories`: %+v", err)
+	}
+
 	if !features.FourPointOhBeta() {
 		if err := d.Set("logs", logs); err != nil {
 			return fmt.Errorf("setting `log`: %+v", err)
@@ -25,6 +25,7 @@ func TestAccDataSourceMonitorDiagnosticCategories_appService(t *testing.T) {
 				check.That(data.ResourceName).Key("logs.#").Exists(),
 				check.That(data.ResourceName).Key("log_category_types.#").Exists(),
 				check.That(data.ResourceName).Key("log_category_groups.#").Exists(),
+				check.That(data.ResourceName).Key("log_categories.#").Exists(),
 			),
 		},
 	})
@@ -42,6 +43,7 @@ func TestAccDataSourceMonitorDiagnosticCategories_storageAccount(t *testing.T) {
 				check.That(data.ResourceName).Key("logs.#").Exists(),
 				check.That(data.ResourceName).Key("log_category_types.#").Exists(),
 				check.That(data.ResourceName).Key("log_category_groups.#").Exists(),
+				check.That(data.ResourceName).Key("log_categories.#").Exists(),
 			),
 		},
 	})
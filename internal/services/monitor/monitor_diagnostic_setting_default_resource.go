@@ -0,0 +1,661 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	monitorDiagnosticSettingDefaultCategoryGroupAllLogs = "allLogs"
+	monitorDiagnosticSettingDefaultCategoryGroupAudit   = "audit"
+	monitorDiagnosticSettingDefaultAllMetrics           = "AllMetrics"
+)
+
+type MonitorDiagnosticSettingDefaultModel struct {
+	Name                        string   `tfschema:"name"`
+	ResourceGroupId             string   `tfschema:"resource_group_id"`
+	LogAnalyticsWorkspaceId     string   `tfschema:"log_analytics_workspace_id"`
+	LogAnalyticsDestinationType string   `tfschema:"log_analytics_destination_type"`
+	EnabledLogCategoryGroups    []string `tfschema:"enabled_log_category_groups"`
+	MetricsEnabled              bool     `tfschema:"metrics_enabled"`
+	ResourceTypes               []string `tfschema:"resource_types"`
+	ExcludedResourceIds         []string `tfschema:"excluded_resource_ids"`
+	TargetResourceIds           []string `tfschema:"target_resource_ids"`
+}
+
+type MonitorDiagnosticSettingDefaultResource struct{}
+
+var _ sdk.ResourceWithUpdate = MonitorDiagnosticSettingDefaultResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = MonitorDiagnosticSettingDefaultResource{}
+
+func (r MonitorDiagnosticSettingDefaultResource) ResourceType() string {
+	return "azurerm_monitor_diagnostic_setting_default"
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) ModelObject() interface{} {
+	return &MonitorDiagnosticSettingDefaultModel{}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		if _, _, err := parseMonitorDiagnosticSettingDefaultId(v); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MonitorDiagnosticSettingName,
+		},
+
+		"resource_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateResourceGroupID,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"log_analytics_destination_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"AzureDiagnostics",
+				"Dedicated",
+			}, false),
+		},
+
+		"enabled_log_category_groups": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					monitorDiagnosticSettingDefaultCategoryGroupAllLogs,
+					monitorDiagnosticSettingDefaultCategoryGroupAudit,
+				}, false),
+			},
+			AtLeastOneOf: []string{"enabled_log_category_groups", "metrics_enabled"},
+		},
+
+		"metrics_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			AtLeastOneOf: []string{"enabled_log_category_groups", "metrics_enabled"},
+		},
+
+		"resource_types": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"excluded_resource_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MonitorDiagnosticSettingDefaultModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resourceGroupId, err := commonids.ParseResourceGroupID(model.ResourceGroupId)
+			if err != nil {
+				return err
+			}
+
+			// the ID is in the same format as the `azurerm_monitor_diagnostic_setting` resource, scoped to the Resource Group
+			id := fmt.Sprintf("%s|%s", resourceGroupId.ID(), model.Name)
+
+			targets, err := r.listTargetResources(ctx, metadata, *resourceGroupId, model, nil)
+			if err != nil {
+				return err
+			}
+
+			// a Diagnostic Setting with the same name on any of the Resources in scope isn't managed by this resource
+			for _, target := range targets {
+				exists, err := r.existsOnResource(ctx, metadata, target.resourceId, model.Name)
+				if err != nil {
+					return err
+				}
+				if exists {
+					return tf.ImportAsExistsError(r.ResourceType(), id)
+				}
+			}
+
+			// the ID is set first so that the Resources which the Diagnostic Setting was applied to are tracked even if
+			// applying it to another Resource fails, rather than being left with a Diagnostic Setting nothing manages
+			metadata.ResourceData.SetId(id)
+
+			targetResourceIds, applyErr := r.applyToResources(ctx, metadata, targets, model)
+			model.TargetResourceIds = targetResourceIds
+			if err := metadata.Encode(&model); err != nil {
+				return err
+			}
+
+			return applyErr
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+			resourceGroupId, name, err := parseMonitorDiagnosticSettingDefaultId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state MonitorDiagnosticSettingDefaultModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Name = name
+			state.ResourceGroupId = resourceGroupId.ID()
+
+			// when importing, the Resources which have the Diagnostic Setting are discovered from the Resource Group
+			candidateResourceIds := state.TargetResourceIds
+			importing := state.LogAnalyticsWorkspaceId == ""
+			if importing {
+				resourceIds, err := r.listResourceIds(ctx, metadata, *resourceGroupId, state)
+				if err != nil {
+					return err
+				}
+				candidateResourceIds = resourceIds
+			}
+
+			// only the Resources which still have the Diagnostic Setting are retained, so that any Resource which
+			// had it removed is detected as a change and the Diagnostic Setting is applied again
+			targetResourceIds := make([]string, 0)
+			categoryGroups := make([]string, 0)
+			metricsEnabled := false
+			for _, targetResourceId := range candidateResourceIds {
+				id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name)
+				resp, err := client.Get(ctx, id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) || (importing && response.WasBadRequest(resp.HttpResponse)) {
+						log.Printf("[DEBUG] %s was not found - removing from `target_resource_ids`", id)
+						continue
+					}
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+				targetResourceIds = append(targetResourceIds, targetResourceId)
+
+				if resp.Model == nil || resp.Model.Properties == nil {
+					continue
+				}
+				props := resp.Model.Properties
+
+				// any Resource which differs from the configuration is surfaced, so that it's applied again
+				if workspaceId := pointer.From(props.WorkspaceId); importing || !strings.EqualFold(workspaceId, state.LogAnalyticsWorkspaceId) {
+					parsed, err := workspaces.ParseWorkspaceIDInsensitively(workspaceId)
+					if err != nil {
+						return fmt.Errorf("parsing `workspace_id` of %s: %+v", id, err)
+					}
+					state.LogAnalyticsWorkspaceId = parsed.ID()
+				}
+				if destinationType := pointer.From(props.LogAnalyticsDestinationType); importing || !strings.EqualFold(destinationType, state.LogAnalyticsDestinationType) {
+					state.LogAnalyticsDestinationType = destinationType
+				}
+				for _, v := range pointer.From(props.Logs) {
+					if categoryGroup := pointer.From(v.CategoryGroup); v.Enabled && categoryGroup != "" && !monitorDiagnosticSettingDefaultContainsFold(categoryGroups, categoryGroup) {
+						categoryGroups = append(categoryGroups, categoryGroup)
+					}
+				}
+				for _, v := range pointer.From(props.Metrics) {
+					if v.Enabled && strings.EqualFold(pointer.From(v.Category), monitorDiagnosticSettingDefaultAllMetrics) {
+						metricsEnabled = true
+					}
+				}
+				importing = false
+			}
+
+			if len(targetResourceIds) == 0 && state.LogAnalyticsWorkspaceId == "" {
+				return metadata.MarkAsGone(resourceGroupId)
+			}
+
+			state.TargetResourceIds = targetResourceIds
+			if len(targetResourceIds) > 0 {
+				sort.Strings(categoryGroups)
+				state.EnabledLogCategoryGroups = categoryGroups
+				state.MetricsEnabled = metricsEnabled
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resourceGroupId, name, err := parseMonitorDiagnosticSettingDefaultId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MonitorDiagnosticSettingDefaultModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			oldRaw, _ := metadata.ResourceData.GetChange("target_resource_ids")
+			previousTargetResourceIds := make([]string, 0)
+			for _, v := range oldRaw.([]interface{}) {
+				previousTargetResourceIds = append(previousTargetResourceIds, v.(string))
+			}
+
+			targets, err := r.listTargetResources(ctx, metadata, *resourceGroupId, model, nil)
+			if err != nil {
+				return err
+			}
+
+			// a Diagnostic Setting with the same name on a Resource which has come into scope isn't managed by this resource
+			for _, target := range targets {
+				if monitorDiagnosticSettingDefaultContainsFold(previousTargetResourceIds, target.resourceId) {
+					continue
+				}
+				exists, err := r.existsOnResource(ctx, metadata, target.resourceId, name)
+				if err != nil {
+					return err
+				}
+				if exists {
+					return fmt.Errorf("a Diagnostic Setting named %q already exists on %q - either remove it, or exclude the Resource using `excluded_resource_ids`", name, target.resourceId)
+				}
+			}
+
+			// when this fails part way through, both the Resources which it was applied to and those which previously had
+			// the Diagnostic Setting are tracked, so that none of them are left with a Diagnostic Setting nothing manages
+			targetResourceIds, err := r.applyToResources(ctx, metadata, targets, model)
+			if err != nil {
+				model.TargetResourceIds = monitorDiagnosticSettingDefaultMergeFold(previousTargetResourceIds, targetResourceIds)
+				if encodeErr := metadata.Encode(&model); encodeErr != nil {
+					return encodeErr
+				}
+				return err
+			}
+
+			// remove the Diagnostic Setting from the Resources which are no longer in scope, e.g. as they've been excluded
+			remaining := make([]string, 0)
+			for _, v := range previousTargetResourceIds {
+				if !monitorDiagnosticSettingDefaultContainsFold(targetResourceIds, v) {
+					remaining = append(remaining, v)
+				}
+			}
+			for len(remaining) > 0 {
+				if err := r.removeFromResource(ctx, metadata, remaining[0], name); err != nil {
+					// the Resources which still have the Diagnostic Setting remain tracked, so that removing it is retried
+					model.TargetResourceIds = monitorDiagnosticSettingDefaultMergeFold(targetResourceIds, remaining)
+					if encodeErr := metadata.Encode(&model); encodeErr != nil {
+						return encodeErr
+					}
+					return err
+				}
+				remaining = remaining[1:]
+			}
+
+			model.TargetResourceIds = targetResourceIds
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			_, name, err := parseMonitorDiagnosticSettingDefaultId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MonitorDiagnosticSettingDefaultModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, targetResourceId := range model.TargetResourceIds {
+				if err := r.removeFromResource(ctx, metadata, targetResourceId, name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Id() == "" {
+				return nil
+			}
+
+			var model MonitorDiagnosticSettingDefaultModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resourceGroupId, err := commonids.ParseResourceGroupID(model.ResourceGroupId)
+			if err != nil {
+				return err
+			}
+
+			// Resources which have been added to the Resource Group (or had the Diagnostic Setting removed) since the last
+			// apply are detected here, the Resources in scope are then determined again when applying so that Resources
+			// created during the same apply are also included. The supported categories are only retrieved for Resources
+			// which aren't already in scope, to avoid a request per Resource on every plan.
+			resourceIds, err := r.listResourceIds(ctx, metadata, *resourceGroupId, model)
+			if err != nil {
+				return err
+			}
+
+			changed := false
+			for _, v := range model.TargetResourceIds {
+				if !monitorDiagnosticSettingDefaultContainsFold(resourceIds, v) {
+					changed = true
+					break
+				}
+			}
+
+			if !changed {
+				targets, err := r.listTargetResources(ctx, metadata, *resourceGroupId, model, model.TargetResourceIds)
+				if err != nil {
+					return err
+				}
+				changed = len(targets) > 0
+			}
+
+			if changed {
+				return metadata.ResourceDiff.SetNewComputed("target_resource_ids")
+			}
+
+			return nil
+		},
+	}
+}
+
+type monitorDiagnosticSettingDefaultTarget struct {
+	resourceId     string
+	categoryGroups []string
+	metrics        bool
+}
+
+// applyToResources creates or updates the Diagnostic Setting on each of the Resources in scope, returning their IDs - when
+// this fails the IDs of the Resources which the Diagnostic Setting was applied to so far are returned alongside the error
+func (r MonitorDiagnosticSettingDefaultResource) applyToResources(ctx context.Context, metadata sdk.ResourceMetaData, targets []monitorDiagnosticSettingDefaultTarget, model MonitorDiagnosticSettingDefaultModel) ([]string, error) {
+	client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+	targetResourceIds := make([]string, 0)
+	for _, target := range targets {
+		id := diagnosticsettings.NewScopedDiagnosticSettingID(target.resourceId, model.Name)
+
+		logs := make([]diagnosticsettings.LogSettings, 0)
+		for _, categoryGroup := range target.categoryGroups {
+			logs = append(logs, diagnosticsettings.LogSettings{
+				CategoryGroup: pointer.To(categoryGroup),
+				Enabled:       true,
+			})
+		}
+
+		metrics := make([]diagnosticsettings.MetricSettings, 0)
+		if target.metrics {
+			metrics = append(metrics, diagnosticsettings.MetricSettings{
+				Category: pointer.To(monitorDiagnosticSettingDefaultAllMetrics),
+				Enabled:  true,
+			})
+		}
+
+		parameters := diagnosticsettings.DiagnosticSettingsResource{
+			Properties: &diagnosticsettings.DiagnosticSettings{
+				Logs:        &logs,
+				Metrics:     &metrics,
+				WorkspaceId: pointer.To(model.LogAnalyticsWorkspaceId),
+			},
+		}
+		if model.LogAnalyticsDestinationType != "" {
+			parameters.Properties.LogAnalyticsDestinationType = pointer.To(model.LogAnalyticsDestinationType)
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+			return targetResourceIds, fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+
+		targetResourceIds = append(targetResourceIds, target.resourceId)
+	}
+
+	return targetResourceIds, nil
+}
+
+// listResourceIds returns the IDs of the Resources within the Resource Group which match the `resource_types` and
+// `excluded_resource_ids`, regardless of whether they support Diagnostic Settings.
+func (r MonitorDiagnosticSettingDefaultResource) listResourceIds(ctx context.Context, metadata sdk.ResourceMetaData, resourceGroupId commonids.ResourceGroupId, model MonitorDiagnosticSettingDefaultModel) ([]string, error) {
+	resourcesClient := metadata.Client.Resource.ResourceGroupsClient
+
+	resp, err := resourcesClient.ResourcesListByResourceGroupComplete(ctx, resourceGroupId, resourcegroups.DefaultResourcesListByResourceGroupOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Resources within %s: %+v", resourceGroupId, err)
+	}
+
+	resourceIds := make([]string, 0)
+	for _, item := range resp.Items {
+		resourceId := pointer.From(item.Id)
+		if resourceId == "" {
+			continue
+		}
+		if monitorDiagnosticSettingDefaultContainsFold(model.ExcludedResourceIds, resourceId) {
+			continue
+		}
+		if len(model.ResourceTypes) > 0 && !monitorDiagnosticSettingDefaultContainsFold(model.ResourceTypes, pointer.From(item.Type)) {
+			continue
+		}
+		resourceIds = append(resourceIds, resourceId)
+	}
+
+	return resourceIds, nil
+}
+
+// listTargetResources returns the Resources within the Resource Group which the Diagnostic Setting applies to, along
+// with the requested Log Category Groups and Metrics which are supported by each of them. Resources which don't support
+// Diagnostic Settings, or none of the requested Log Category Groups or Metrics, are omitted - as are the Resources in
+// `skipResourceIds`, for which the supported categories aren't retrieved.
+func (r MonitorDiagnosticSettingDefaultResource) listTargetResources(ctx context.Context, metadata sdk.ResourceMetaData, resourceGroupId commonids.ResourceGroupId, model MonitorDiagnosticSettingDefaultModel, skipResourceIds []string) ([]monitorDiagnosticSettingDefaultTarget, error) {
+	categoriesClient := metadata.Client.Monitor.DiagnosticSettingsCategoryClient
+
+	resourceIds, err := r.listResourceIds(ctx, metadata, resourceGroupId, model)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]monitorDiagnosticSettingDefaultTarget, 0)
+	for _, resourceId := range resourceIds {
+		if monitorDiagnosticSettingDefaultContainsFold(skipResourceIds, resourceId) {
+			continue
+		}
+
+		categories, err := categoriesClient.DiagnosticSettingsCategoryList(ctx, commonids.NewScopeID(resourceId))
+		if err != nil {
+			// Resource types which don't support Diagnostic Settings return a client error
+			if categories.HttpResponse != nil && (categories.HttpResponse.StatusCode == http.StatusBadRequest || categories.HttpResponse.StatusCode == http.StatusNotFound) {
+				log.Printf("[DEBUG] Diagnostic Settings are not supported for %q - skipping", resourceId)
+				continue
+			}
+			return nil, fmt.Errorf("retrieving Diagnostics Categories for %q: %+v", resourceId, err)
+		}
+
+		supportedCategoryGroups := make([]string, 0)
+		supportsMetrics := false
+		if categories.Model != nil {
+			for _, category := range pointer.From(categories.Model.Value) {
+				props := category.Properties
+				if props == nil {
+					continue
+				}
+
+				if pointer.From(props.CategoryType) == diagnosticsettingscategories.CategoryTypeMetrics {
+					supportsMetrics = true
+				}
+				supportedCategoryGroups = append(supportedCategoryGroups, pointer.From(props.CategoryGroups)...)
+			}
+		}
+
+		target := monitorDiagnosticSettingDefaultTarget{
+			resourceId:     resourceId,
+			categoryGroups: make([]string, 0),
+			metrics:        model.MetricsEnabled && supportsMetrics,
+		}
+		for _, categoryGroup := range model.EnabledLogCategoryGroups {
+			if monitorDiagnosticSettingDefaultContainsFold(supportedCategoryGroups, categoryGroup) {
+				target.categoryGroups = append(target.categoryGroups, categoryGroup)
+			}
+		}
+		sort.Strings(target.categoryGroups)
+
+		if len(target.categoryGroups) == 0 && !target.metrics {
+			continue
+		}
+
+		targets = append(targets, target)
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		return strings.ToLower(targets[i].resourceId) < strings.ToLower(targets[j].resourceId)
+	})
+
+	return targets, nil
+}
+
+// existsOnResource returns whether a Diagnostic Setting with the specified name exists on the Resource
+func (r MonitorDiagnosticSettingDefaultResource) existsOnResource(ctx context.Context, metadata sdk.ResourceMetaData, targetResourceId, name string) (bool, error) {
+	client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name)
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, nil
+		}
+		return false, fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+	}
+
+	return true, nil
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) removeFromResource(ctx context.Context, metadata sdk.ResourceMetaData, targetResourceId, name string) error {
+	client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name)
+	if resp, err := client.Delete(ctx, id); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func monitorDiagnosticSettingDefaultContainsFold(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// monitorDiagnosticSettingDefaultMergeFold returns the values of both slices, ignoring values which differ only in casing
+func monitorDiagnosticSettingDefaultMergeFold(first []string, second []string) []string {
+	output := make([]string, 0)
+	for _, v := range append(append([]string{}, first...), second...) {
+		if !monitorDiagnosticSettingDefaultContainsFold(output, v) {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func parseMonitorDiagnosticSettingDefaultId(input string) (*commonids.ResourceGroupId, string, error) {
+	id, err := ParseMonitorDiagnosticId(input)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resourceGroupId, err := commonids.ParseResourceGroupIDInsensitively(id.ResourceUri)
+	if err != nil {
+		return nil, "", fmt.Errorf("parsing the Resource Group ID from %q: %+v", input, err)
+	}
+
+	return resourceGroupId, id.DiagnosticSettingName, nil
+}
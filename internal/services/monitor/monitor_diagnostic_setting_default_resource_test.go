@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorDiagnosticSettingDefaultResource struct{}
+
+func TestAccMonitorDiagnosticSettingDefault_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_default", "test")
+	r := MonitorDiagnosticSettingDefaultResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+		// the Resources in scope can't be determined from the Diagnostic Settings
+		data.ImportStep("resource_types"),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingDefault_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_default", "test")
+	r := MonitorDiagnosticSettingDefaultResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingDefault_existingDiagnosticSetting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_default", "test")
+	r := MonitorDiagnosticSettingDefaultResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.existingDiagnosticSetting(data),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func TestAccMonitorDiagnosticSettingDefault_newResourceInScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_default", "test")
+	r := MonitorDiagnosticSettingDefaultResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.additionalKeyVault(data),
+		},
+		{
+			// the Key Vault created in the previous step is picked up when planning
+			Config: r.additionalKeyVault(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.excluded(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (t MonitorDiagnosticSettingDefaultResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := monitor.ParseMonitorDiagnosticId(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := strconv.Atoi(state.Attributes["target_resource_ids.#"])
+	if err != nil {
+		return nil, fmt.Errorf("parsing `target_resource_ids.#`: %+v", err)
+	}
+
+	for i := 0; i < count; i++ {
+		settingId := diagnosticsettings.NewScopedDiagnosticSettingID(state.Attributes[fmt.Sprintf("target_resource_ids.%d", i)], id.DiagnosticSettingName)
+		resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, settingId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", settingId, err)
+		}
+		if resp.Model == nil {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (MonitorDiagnosticSettingDefaultResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_default" "test" {
+  name                        = "acctest-DSD-%d"
+  resource_group_id           = azurerm_resource_group.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  enabled_log_category_groups = ["allLogs", "audit"]
+  metrics_enabled             = true
+  resource_types              = ["Microsoft.KeyVault/vaults"]
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) additionalKeyVault(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_key_vault" "other" {
+  name                = "acctest2%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_monitor_diagnostic_setting_default" "test" {
+  name                        = "acctest-DSD-%[2]d"
+  resource_group_id           = azurerm_resource_group.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  enabled_log_category_groups = ["allLogs", "audit"]
+  metrics_enabled             = true
+  resource_types              = ["Microsoft.KeyVault/vaults"]
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) excluded(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_key_vault" "other" {
+  name                = "acctest2%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_monitor_diagnostic_setting_default" "test" {
+  name                        = "acctest-DSD-%[2]d"
+  resource_group_id           = azurerm_resource_group.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  enabled_log_category_groups = ["audit"]
+  resource_types              = ["Microsoft.KeyVault/vaults"]
+  excluded_resource_ids       = [azurerm_key_vault.other.id]
+
+  depends_on = [azurerm_key_vault.test]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_default" "import" {
+  name                        = azurerm_monitor_diagnostic_setting_default.test.name
+  resource_group_id           = azurerm_monitor_diagnostic_setting_default.test.resource_group_id
+  log_analytics_workspace_id  = azurerm_monitor_diagnostic_setting_default.test.log_analytics_workspace_id
+  enabled_log_category_groups = azurerm_monitor_diagnostic_setting_default.test.enabled_log_category_groups
+  metrics_enabled             = azurerm_monitor_diagnostic_setting_default.test.metrics_enabled
+  resource_types              = azurerm_monitor_diagnostic_setting_default.test.resource_types
+}
+`, r.basic(data))
+}
+
+func (r MonitorDiagnosticSettingDefaultResource) existingDiagnosticSetting(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctest-DSD-%[2]d"
+  target_resource_id         = azurerm_key_vault.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  enabled_log {
+    category_group = "audit"
+  }
+}
+
+resource "azurerm_monitor_diagnostic_setting_default" "test" {
+  name                        = "acctest-DSD-%[2]d"
+  resource_group_id           = azurerm_resource_group.test.id
+  log_analytics_workspace_id  = azurerm_log_analytics_workspace.test.id
+  enabled_log_category_groups = ["audit"]
+  resource_types              = ["Microsoft.KeyVault/vaults"]
+
+  depends_on = [azurerm_monitor_diagnostic_setting.test]
+}
+`, r.template(data), data.RandomInteger)
+}
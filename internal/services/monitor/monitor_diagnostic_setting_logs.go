@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+)
+
+// monitorDiagnosticEnabledLogsRequireCategoryGroups returns whether the enabled logs contain both a category group
+// and an individual category which isn't configured, in which case the category may be covered by the group.
+func monitorDiagnosticEnabledLogsRequireCategoryGroups(enabledLogs []interface{}, existing []interface{}) bool {
+	configured := monitorDiagnosticEnabledLogKeys(existing)
+
+	hasCategoryGroup := false
+	hasUnconfiguredCategory := false
+	for _, raw := range enabledLogs {
+		v := raw.(map[string]interface{})
+		if categoryGroup := v["category_group"].(string); categoryGroup != "" {
+			hasCategoryGroup = true
+		}
+		if category := v["category"].(string); category != "" {
+			if _, ok := configured[monitorDiagnosticEnabledLogKey(category, "")]; !ok {
+				hasUnconfiguredCategory = true
+			}
+		}
+	}
+
+	return hasCategoryGroup && hasUnconfiguredCategory
+}
+
+// monitorDiagnosticCategoryGroupMembers returns a map of the (lower-cased) Log Categories supported by the specified
+// Resource to the (lower-cased) Category Groups they belong to.
+func monitorDiagnosticCategoryGroupMembers(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, resourceId string) (map[string][]string, error) {
+	resp, err := client.DiagnosticSettingsCategoryList(ctx, commonids.NewScopeID(resourceId))
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string][]string)
+	if resp.Model == nil || resp.Model.Value == nil {
+		return members, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Name == nil || v.Properties == nil || v.Properties.CategoryGroups == nil {
+			continue
+		}
+		if v.Properties.CategoryType != nil && *v.Properties.CategoryType != diagnosticsettingscategories.CategoryTypeLogs {
+			continue
+		}
+
+		groups := make([]string, 0)
+		for _, group := range *v.Properties.CategoryGroups {
+			groups = append(groups, strings.ToLower(group))
+		}
+		members[strings.ToLower(*v.Name)] = groups
+	}
+
+	return members, nil
+}
+
+// normaliseMonitorDiagnosticEnabledLogs removes any individual categories returned by the API which are covered by an
+// enabled category group (and aren't explicitly configured), and retains the casing used in the configuration for the
+// `category` and `category_group` fields, so that switching between the two doesn't result in a perpetual diff.
+func normaliseMonitorDiagnosticEnabledLogs(enabledLogs []interface{}, existing []interface{}, categoryGroupMembers map[string][]string) []interface{} {
+	configured := monitorDiagnosticEnabledLogKeys(existing)
+
+	enabledGroups := make(map[string]struct{})
+	for _, raw := range enabledLogs {
+		if categoryGroup := raw.(map[string]interface{})["category_group"].(string); categoryGroup != "" {
+			enabledGroups[strings.ToLower(categoryGroup)] = struct{}{}
+		}
+	}
+
+	results := make([]interface{}, 0)
+	for _, raw := range enabledLogs {
+		v := raw.(map[string]interface{})
+		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+
+		key := monitorDiagnosticEnabledLogKey(category, categoryGroup)
+		if configuredLog, ok := configured[key]; ok {
+			v["category"] = configuredLog["category"]
+			v["category_group"] = configuredLog["category_group"]
+		} else if category != "" && monitorDiagnosticCategoryCoveredByGroups(category, enabledGroups, categoryGroupMembers) {
+			continue
+		}
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
+func monitorDiagnosticCategoryCoveredByGroups(category string, enabledGroups map[string]struct{}, categoryGroupMembers map[string][]string) bool {
+	if _, ok := enabledGroups["alllogs"]; ok {
+		return true
+	}
+
+	for _, group := range categoryGroupMembers[strings.ToLower(category)] {
+		if _, ok := enabledGroups[group]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func monitorDiagnosticEnabledLogKeys(input []interface{}) map[string]map[string]interface{} {
+	keys := make(map[string]map[string]interface{})
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		category, _ := v["category"].(string)
+		categoryGroup, _ := v["category_group"].(string)
+		keys[monitorDiagnosticEnabledLogKey(category, categoryGroup)] = v
+	}
+	return keys
+}
+
+func monitorDiagnosticEnabledLogKey(category, categoryGroup string) string {
+	return fmt.Sprintf("%s|%s", strings.ToLower(category), strings.ToLower(categoryGroup))
+}
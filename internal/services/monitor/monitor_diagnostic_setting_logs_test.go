@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormaliseMonitorDiagnosticEnabledLogs(t *testing.T) {
+	enabledLog := func(category, categoryGroup string) map[string]interface{} {
+		return map[string]interface{}{
+			"category":         category,
+			"category_group":   categoryGroup,
+			"retention_policy": []interface{}{},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Existing []interface{}
+		Members  map[string][]string
+		Expected []interface{}
+	}{
+		{
+			Name:     "categories only",
+			Input:    []interface{}{enabledLog("AuditEvent", ""), enabledLog("AzurePolicyEvaluationDetails", "")},
+			Existing: []interface{}{enabledLog("AuditEvent", "")},
+			Expected: []interface{}{enabledLog("AuditEvent", ""), enabledLog("AzurePolicyEvaluationDetails", "")},
+		},
+		{
+			Name:     "configured casing is retained",
+			Input:    []interface{}{enabledLog("", "allLogs")},
+			Existing: []interface{}{enabledLog("", "AllLogs")},
+			Expected: []interface{}{enabledLog("", "AllLogs")},
+		},
+		{
+			Name:     "categories covered by allLogs are removed",
+			Input:    []interface{}{enabledLog("", "allLogs"), enabledLog("AuditEvent", "")},
+			Existing: []interface{}{enabledLog("", "allLogs")},
+			Expected: []interface{}{enabledLog("", "allLogs")},
+		},
+		{
+			Name:     "categories covered by a group are removed",
+			Input:    []interface{}{enabledLog("", "audit"), enabledLog("AuditEvent", ""), enabledLog("AzurePolicyEvaluationDetails", "")},
+			Existing: []interface{}{enabledLog("", "audit")},
+			Members: map[string][]string{
+				"auditevent":                   {"audit", "alllogs"},
+				"azurepolicyevaluationdetails": {"alllogs"},
+			},
+			Expected: []interface{}{enabledLog("", "audit"), enabledLog("AzurePolicyEvaluationDetails", "")},
+		},
+		{
+			Name:     "configured categories within a group are retained",
+			Input:    []interface{}{enabledLog("", "allLogs"), enabledLog("AuditEvent", "")},
+			Existing: []interface{}{enabledLog("", "allLogs"), enabledLog("auditevent", "")},
+			Expected: []interface{}{enabledLog("", "allLogs"), enabledLog("auditevent", "")},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := normaliseMonitorDiagnosticEnabledLogs(v.Input, v.Existing, v.Members)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestMonitorDiagnosticEnabledLogsRequireCategoryGroups(t *testing.T) {
+	enabledLog := func(category, categoryGroup string) map[string]interface{} {
+		return map[string]interface{}{
+			"category":       category,
+			"category_group": categoryGroup,
+		}
+	}
+
+	if monitorDiagnosticEnabledLogsRequireCategoryGroups([]interface{}{enabledLog("", "audit")}, nil) {
+		t.Fatalf("expected category groups not to be required when no categories are returned")
+	}
+
+	if monitorDiagnosticEnabledLogsRequireCategoryGroups([]interface{}{enabledLog("", "audit"), enabledLog("AuditEvent", "")}, []interface{}{enabledLog("AuditEvent", "")}) {
+		t.Fatalf("expected category groups not to be required when the category is configured")
+	}
+
+	if !monitorDiagnosticEnabledLogsRequireCategoryGroups([]interface{}{enabledLog("", "audit"), enabledLog("AuditEvent", "")}, nil) {
+		t.Fatalf("expected category groups to be required")
+	}
+}
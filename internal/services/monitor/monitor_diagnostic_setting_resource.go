@@ -23,6 +23,7 @@ import (
 	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -90,10 +91,11 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			},
 
 			"partner_solution_id": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
-				AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"},
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				AtLeastOneOf:     []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"},
 			},
 
 			"log_analytics_destination_type": {
@@ -543,14 +545,14 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 				}
 
 				storageAccountId = parsedId.ID()
-				d.Set("storage_account_id", storageAccountId)
 			}
+			d.Set("storage_account_id", storageAccountId)
 
 			partnerSolutionId := ""
 			if props.MarketplacePartnerId != nil && *props.MarketplacePartnerId != "" {
 				partnerSolutionId = *props.MarketplacePartnerId
-				d.Set("partner_solution_id", partnerSolutionId)
 			}
+			d.Set("partner_solution_id", partnerSolutionId)
 
 			logAnalyticsDestinationType := ""
 			if resp.Model.Properties.LogAnalyticsDestinationType != nil && *resp.Model.Properties.LogAnalyticsDestinationType != "" {
@@ -558,7 +560,19 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			}
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
+			existingEnabledLogs := d.Get("enabled_log").(*pluginsdk.Set).List()
 			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
+
+			// when a category group is enabled the API can also return the individual categories within that group,
+			// so we look up which categories belong to which group to avoid a perpetual diff against the configuration
+			var categoryGroupMembers map[string][]string
+			if monitorDiagnosticEnabledLogsRequireCategoryGroups(enabledLogs, existingEnabledLogs) {
+				categoryGroupMembers, err = monitorDiagnosticCategoryGroupMembers(ctx, meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient, resourceUri)
+				if err != nil {
+					log.Printf("[DEBUG] unable to retrieve the Diagnostics Categories for Resource %q: %+v", resourceUri, err)
+				}
+			}
+			enabledLogs = normaliseMonitorDiagnosticEnabledLogs(enabledLogs, existingEnabledLogs, categoryGroupMembers)
 			if err = d.Set("enabled_log", enabledLogs); err != nil {
 				return fmt.Errorf("setting `enabled_log`: %+v", err)
 			}
@@ -872,10 +886,10 @@ func resourceMonitorDiagnosticLogSettingHash(input interface{}) int {
 	var buf bytes.Buffer
 	if rawData, ok := input.(map[string]interface{}); ok {
 		if category, ok := rawData["category"]; ok {
-			buf.WriteString(fmt.Sprintf("%s-", category.(string)))
+			buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(category.(string))))
 		}
 		if categoryGroup, ok := rawData["category_group"]; ok {
-			buf.WriteString(fmt.Sprintf("%s-", categoryGroup.(string)))
+			buf.WriteString(fmt.Sprintf("%s-", strings.ToLower(categoryGroup.(string))))
 		}
 		if enabled, ok := rawData["enabled"]; ok {
 			buf.WriteString(fmt.Sprintf("%t-", enabled.(bool)))
@@ -4,13 +4,20 @@
 package monitor
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2019-10-17-preview/privatelinkscopedresources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-07-01-preview/privatelinkscopesapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
@@ -62,8 +69,49 @@ func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
 			},
 
+			"access_mode_exclusion": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"private_endpoint_connection_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ingestion_access_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+						},
+
+						"query_access_mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+						},
+					},
+				},
+			},
+
 			"resource_group_name": commonschema.ResourceGroupName(),
 
+			// NOTE: O+C since the Scoped Resources can also be managed using the `azurerm_monitor_private_link_scoped_service` resource
+			"scoped_resources": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.Any(
+						components.ValidateComponentID,
+						workspaces.ValidateWorkspaceID,
+						datacollectionendpoints.ValidateDataCollectionEndpointID,
+					),
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -72,6 +120,7 @@ func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
 func resourceMonitorPrivateLinkScopeCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Monitor.PrivateLinkScopesClient
+	scopedResourcesClient := meta.(*clients.Client).Monitor.PrivateLinkScopedResourcesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -102,6 +151,7 @@ func resourceMonitorPrivateLinkScopeCreateUpdate(d *pluginsdk.ResourceData, meta
 		Tags:     utils.ExpandPtrMapStringString(d.Get("tags").(map[string]interface{})),
 		Properties: privatelinkscopesapis.AzureMonitorPrivateLinkScopeProperties{
 			AccessModeSettings: privatelinkscopesapis.AccessModeSettings{
+				Exclusions:          expandMonitorPrivateLinkScopeAccessModeExclusions(d.Get("access_mode_exclusion").([]interface{})),
 				IngestionAccessMode: ingestionAccessMode,
 				QueryAccessMode:     queryaccessMode,
 			},
@@ -114,11 +164,20 @@ func resourceMonitorPrivateLinkScopeCreateUpdate(d *pluginsdk.ResourceData, meta
 
 	d.SetId(id.ID())
 
+	if d.HasChange("scoped_resources") {
+		oldRaw, newRaw := d.GetChange("scoped_resources")
+		scopeId := privatelinkscopedresources.NewPrivateLinkScopeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateLinkScopeName)
+		if err := updateMonitorPrivateLinkScopedResources(ctx, scopedResourcesClient, scopeId, oldRaw.(*pluginsdk.Set).List(), newRaw.(*pluginsdk.Set).List()); err != nil {
+			return err
+		}
+	}
+
 	return resourceMonitorPrivateLinkScopeRead(d, meta)
 }
 
 func resourceMonitorPrivateLinkScopeRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Monitor.PrivateLinkScopesClient
+	scopedResourcesClient := meta.(*clients.Client).Monitor.PrivateLinkScopedResourcesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -149,6 +208,25 @@ func resourceMonitorPrivateLinkScopeRead(d *pluginsdk.ResourceData, meta interfa
 		d.Set("ingestion_access_mode", string(props.AccessModeSettings.IngestionAccessMode))
 		d.Set("query_access_mode", string(props.AccessModeSettings.QueryAccessMode))
 
+		if err := d.Set("access_mode_exclusion", flattenMonitorPrivateLinkScopeAccessModeExclusions(props.AccessModeSettings.Exclusions)); err != nil {
+			return fmt.Errorf("setting `access_mode_exclusion`: %+v", err)
+		}
+	}
+
+	scopeId := privatelinkscopedresources.NewPrivateLinkScopeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateLinkScopeName)
+	scopedResources, err := scopedResourcesClient.ListByPrivateLinkScopeComplete(ctx, scopeId)
+	if err != nil {
+		return fmt.Errorf("listing Scoped Resources for %s: %+v", *id, err)
+	}
+
+	linkedResourceIds := make([]interface{}, 0)
+	for _, item := range scopedResources.Items {
+		if props := item.Properties; props != nil && props.LinkedResourceId != nil {
+			linkedResourceIds = append(linkedResourceIds, pointer.From(normalizeLinkedResourceId(props.LinkedResourceId)))
+		}
+	}
+	if err := d.Set("scoped_resources", linkedResourceIds); err != nil {
+		return fmt.Errorf("setting `scoped_resources`: %+v", err)
 	}
 
 	return nil
@@ -171,3 +249,108 @@ func resourceMonitorPrivateLinkScopeDelete(d *pluginsdk.ResourceData, meta inter
 
 	return nil
 }
+
+// updateMonitorPrivateLinkScopedResources reconciles the Scoped Resources within the Private Link Scope, only the Scoped
+// Resources which have been added or removed are created/deleted - rather than each Scoped Resource being checked.
+func updateMonitorPrivateLinkScopedResources(ctx context.Context, client *privatelinkscopedresources.PrivateLinkScopedResourcesClient, scopeId privatelinkscopedresources.PrivateLinkScopeId, old []interface{}, new []interface{}) error {
+	existing, err := client.ListByPrivateLinkScopeComplete(ctx, scopeId)
+	if err != nil {
+		return fmt.Errorf("listing Scoped Resources for %s: %+v", scopeId, err)
+	}
+
+	// the Scoped Resources may have been created outside of this resource, so are looked up by the Linked Resource ID
+	existingIds := make(map[string]string)
+	for _, item := range existing.Items {
+		if item.Id != nil && item.Properties != nil && item.Properties.LinkedResourceId != nil {
+			existingIds[strings.ToLower(*item.Properties.LinkedResourceId)] = *item.Id
+		}
+	}
+
+	desired := make(map[string]bool)
+	for _, v := range new {
+		desired[strings.ToLower(v.(string))] = true
+	}
+
+	for _, v := range old {
+		linkedResourceId := v.(string)
+		if desired[strings.ToLower(linkedResourceId)] {
+			continue
+		}
+
+		scopedResourceId, ok := existingIds[strings.ToLower(linkedResourceId)]
+		if !ok {
+			continue
+		}
+		id, err := privatelinkscopedresources.ParseScopedResourceIDInsensitively(scopedResourceId)
+		if err != nil {
+			return err
+		}
+		if err := client.DeleteThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	for _, v := range new {
+		linkedResourceId := v.(string)
+		if _, ok := existingIds[strings.ToLower(linkedResourceId)]; ok {
+			continue
+		}
+
+		id := privatelinkscopedresources.NewScopedResourceID(scopeId.SubscriptionId, scopeId.ResourceGroupName, scopeId.PrivateLinkScopeName, monitorPrivateLinkScopedResourceName(linkedResourceId))
+		parameters := privatelinkscopedresources.ScopedResource{
+			Properties: &privatelinkscopedresources.ScopedResourceProperties{
+				LinkedResourceId: pointer.To(linkedResourceId),
+			},
+		}
+		if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// monitorPrivateLinkScopedResourceName returns the name of the Scoped Resource for a Linked Resource ID, the name of
+// the Linked Resource isn't unique within the Private Link Scope so a hash of the Linked Resource ID is appended
+func monitorPrivateLinkScopedResourceName(linkedResourceId string) string {
+	segments := strings.Split(strings.TrimSuffix(linkedResourceId, "/"), "/")
+	return fmt.Sprintf("%s-%d", segments[len(segments)-1], pluginsdk.HashString(strings.ToLower(linkedResourceId)))
+}
+
+func expandMonitorPrivateLinkScopeAccessModeExclusions(input []interface{}) *[]privatelinkscopesapis.AccessModeSettingsExclusion {
+	results := make([]privatelinkscopesapis.AccessModeSettingsExclusion, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		exclusion := privatelinkscopesapis.AccessModeSettingsExclusion{
+			PrivateEndpointConnectionName: pointer.To(v["private_endpoint_connection_name"].(string)),
+		}
+		if accessMode := v["ingestion_access_mode"].(string); accessMode != "" {
+			exclusion.IngestionAccessMode = pointer.To(privatelinkscopesapis.AccessMode(accessMode))
+		}
+		if accessMode := v["query_access_mode"].(string); accessMode != "" {
+			exclusion.QueryAccessMode = pointer.To(privatelinkscopesapis.AccessMode(accessMode))
+		}
+
+		results = append(results, exclusion)
+	}
+
+	return &results
+}
+
+func flattenMonitorPrivateLinkScopeAccessModeExclusions(input *[]privatelinkscopesapis.AccessModeSettingsExclusion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"private_endpoint_connection_name": pointer.From(item.PrivateEndpointConnectionName),
+			"ingestion_access_mode":            string(pointer.From(item.IngestionAccessMode)),
+			"query_access_mode":                string(pointer.From(item.QueryAccessMode)),
+		})
+	}
+
+	return results
+}
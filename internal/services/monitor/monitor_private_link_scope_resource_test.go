@@ -86,6 +86,38 @@ func TestAccMonitorPrivateLinkScope_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitorPrivateLinkScope_scopedResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scope", "test")
+	r := MonitorPrivateLinkScopeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scopedResources(data, "azurerm_log_analytics_workspace.test.id, azurerm_application_insights.test.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scoped_resources.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scopedResources(data, "azurerm_log_analytics_workspace.test.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scoped_resources.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.scopedResources(data, "azurerm_application_insights.test.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scoped_resources.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MonitorPrivateLinkScopeResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := privatelinkscopesapis.ParsePrivateLinkScopeID(state.ID)
 	if err != nil {
@@ -157,3 +189,30 @@ resource "azurerm_monitor_private_link_scope" "test" {
 }
 `, r.template(data), data.RandomInteger, ingestionAccessMode, queryAccessMode, tag)
 }
+
+func (r MonitorPrivateLinkScopeResource) scopedResources(data acceptance.TestData, scopedResources string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctest-appinsights-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_monitor_private_link_scope" "test" {
+  name                = "acctest-ampls-%d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  scoped_resources = [%s]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger, scopedResources)
+}
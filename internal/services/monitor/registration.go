@@ -22,6 +22,7 @@ func (r Registration) AssociatedGitHubLabel() string {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		AlertProcessingRulesDataSource{},
 		DataCollectionEndpointDataSource{},
 		DataCollectionRuleDataSource{},
 		WorkspaceDataSource{},
@@ -38,6 +39,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ScheduledQueryRulesAlertV2Resource{},
 		AlertPrometheusRuleGroupResource{},
 		WorkspaceResource{},
+		MonitorDiagnosticSettingDefaultResource{},
 	}
 }
 
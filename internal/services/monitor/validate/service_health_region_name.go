@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ServiceHealthRegionName validates a region as used by Service Health events, which are keyed on the display name
+// of the region (e.g. `West Europe`) rather than the normalized location (e.g. `westeurope`) - a normalized location
+// is accepted by the API but never matches any event.
+func ServiceHealthRegionName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%s must not be empty", k))
+		return
+	}
+
+	if strings.TrimSpace(v) != v {
+		errors = append(errors, fmt.Errorf("%s must not contain leading or trailing whitespace, got %q", k, v))
+		return
+	}
+
+	if v == "Global" {
+		return
+	}
+
+	if !strings.Contains(v, " ") {
+		errors = append(errors, fmt.Errorf("%s must be the display name of the region (e.g. `West Europe`) or `Global`, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestServiceHealthRegionName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// global
+			input:    "Global",
+			expected: true,
+		},
+		{
+			// display name
+			input:    "West Europe",
+			expected: true,
+		},
+		{
+			// display name with a number
+			input:    "East US 2",
+			expected: true,
+		},
+		{
+			// normalized location
+			input:    "westeurope",
+			expected: false,
+		},
+		{
+			// normalized location with a number
+			input:    "eastus2",
+			expected: false,
+		},
+		{
+			// leading whitespace
+			input:    " West Europe",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := ServiceHealthRegionName(v.input, "locations")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
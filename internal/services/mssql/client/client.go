@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/databasesecurityalertpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/elasticpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/geobackuppolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/ipv6firewallrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/longtermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/replicationlinks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/restorabledroppeddatabases"
@@ -20,7 +21,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/serverconnectionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/serversecurityalertpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/sqlvulnerabilityassessmentssettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/transparentdataencryptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/failovergroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/availabilitygrouplisteners"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachinegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
@@ -35,9 +38,10 @@ type Client struct {
 	DatabasesClient                                    *databases.DatabasesClient
 	ElasticPoolsClient                                 *elasticpools.ElasticPoolsClient
 	EncryptionProtectorClient                          *sql.EncryptionProtectorsClient
-	FailoverGroupsClient                               *sql.FailoverGroupsClient
+	FailoverGroupsClient                               *failovergroups.FailoverGroupsClient
 	FirewallRulesClient                                *sql.FirewallRulesClient
 	GeoBackupPoliciesClient                            *geobackuppolicies.GeoBackupPoliciesClient
+	IPv6FirewallRulesClient                            *ipv6firewallrules.IPv6FirewallRulesClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
 	LongTermRetentionPoliciesClient                    *longtermretentionpolicies.LongTermRetentionPoliciesClient
@@ -54,6 +58,7 @@ type Client struct {
 	ServerKeysClient                                   *sql.ServerKeysClient
 	ServerSecurityAlertPoliciesClient                  *serversecurityalertpolicies.ServerSecurityAlertPoliciesClient
 	LegacyServerSecurityAlertPoliciesClient            *sql.ServerSecurityAlertPoliciesClient
+	ServerSqlVulnerabilityAssessmentsSettingsClient    *sqlvulnerabilityassessmentssettings.SqlVulnerabilityAssessmentsSettingsClient
 	ServerVulnerabilityAssessmentsClient               *sql.ServerVulnerabilityAssessmentsClient
 	ServersClient                                      *servers.ServersClient
 	TransparentDataEncryptionsClient                   *transparentdataencryptions.TransparentDataEncryptionsClient
@@ -97,8 +102,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	encryptionProtectorClient := sql.NewEncryptionProtectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&encryptionProtectorClient.Client, o.ResourceManagerAuthorizer)
 
-	failoverGroupsClient := sql.NewFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
-	o.ConfigureClient(&failoverGroupsClient.Client, o.ResourceManagerAuthorizer)
+	failoverGroupsClient, err := failovergroups.NewFailoverGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Failover Groups Client: %+v", err)
+	}
+	o.Configure(failoverGroupsClient.Client, o.Authorizers.ResourceManager)
 
 	firewallRulesClient := sql.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&firewallRulesClient.Client, o.ResourceManagerAuthorizer)
@@ -109,6 +117,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(geoBackupPoliciesClient.Client, o.Authorizers.ResourceManager)
 
+	ipv6FirewallRulesClient, err := ipv6firewallrules.NewIPv6FirewallRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building IPv6 Firewall Rules Client: %+v", err)
+	}
+	o.Configure(ipv6FirewallRulesClient.Client, o.Authorizers.ResourceManager)
+
 	jobAgentsClient := sql.NewJobAgentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobAgentsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -179,6 +193,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(serverSecurityAlertPoliciesClient.Client, o.Authorizers.ResourceManager)
 
+	serverSqlVulnerabilityAssessmentsSettingsClient, err := sqlvulnerabilityassessmentssettings.NewSqlVulnerabilityAssessmentsSettingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Server SQL Vulnerability Assessments Settings Client: %+v", err)
+	}
+	o.Configure(serverSqlVulnerabilityAssessmentsSettingsClient.Client, o.Authorizers.ResourceManager)
+
 	serverVulnerabilityAssessmentsClient := sql.NewServerVulnerabilityAssessmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&serverVulnerabilityAssessmentsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -220,7 +240,6 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DatabaseExtendedBlobAuditingPoliciesClient:         &databaseExtendedBlobAuditingPoliciesClient,
 		DatabaseVulnerabilityAssessmentRuleBaselinesClient: &databaseVulnerabilityAssessmentRuleBaselinesClient,
 		EncryptionProtectorClient:                          &encryptionProtectorClient,
-		FailoverGroupsClient:                               &failoverGroupsClient,
 		FirewallRulesClient:                                &firewallRulesClient,
 		JobAgentsClient:                                    &jobAgentsClient,
 		JobCredentialsClient:                               &jobCredentialsClient,
@@ -240,19 +259,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		LegacyReplicationLinksClient:            &legacyReplicationLinksClient,
 
 		// 2023-02-01-preview Clients
-		BackupShortTermRetentionPoliciesClient: backupShortTermRetentionPoliciesClient,
-		DatabasesClient:                        databasesClient,
-		DatabaseSecurityAlertPoliciesClient:    databaseSecurityAlertPoliciesClient,
-		ElasticPoolsClient:                     elasticPoolsClient,
-		GeoBackupPoliciesClient:                geoBackupPoliciesClient,
-		LongTermRetentionPoliciesClient:        longTermRetentionPoliciesClient,
-		ReplicationLinksClient:                 replicationLinksClient,
-		RestorableDroppedDatabasesClient:       restorableDroppedDatabasesClient,
-		ServerAzureADAdministratorsClient:      serverAzureADAdministratorsClient,
-		ServerAzureADOnlyAuthenticationsClient: serverAzureADOnlyAuthenticationsClient,
-		ServerConnectionPoliciesClient:         serverConnectionPoliciesClient,
-		ServerSecurityAlertPoliciesClient:      serverSecurityAlertPoliciesClient,
-		TransparentDataEncryptionsClient:       transparentDataEncryptionsClient,
-		ServersClient:                          serversClient,
+		BackupShortTermRetentionPoliciesClient:          backupShortTermRetentionPoliciesClient,
+		DatabasesClient:                                 databasesClient,
+		DatabaseSecurityAlertPoliciesClient:             databaseSecurityAlertPoliciesClient,
+		ElasticPoolsClient:                              elasticPoolsClient,
+		GeoBackupPoliciesClient:                         geoBackupPoliciesClient,
+		IPv6FirewallRulesClient:                         ipv6FirewallRulesClient,
+		LongTermRetentionPoliciesClient:                 longTermRetentionPoliciesClient,
+		ReplicationLinksClient:                          replicationLinksClient,
+		RestorableDroppedDatabasesClient:                restorableDroppedDatabasesClient,
+		ServerAzureADAdministratorsClient:               serverAzureADAdministratorsClient,
+		ServerAzureADOnlyAuthenticationsClient:          serverAzureADOnlyAuthenticationsClient,
+		ServerConnectionPoliciesClient:                  serverConnectionPoliciesClient,
+		ServerSecurityAlertPoliciesClient:               serverSecurityAlertPoliciesClient,
+		ServerSqlVulnerabilityAssessmentsSettingsClient: serverSqlVulnerabilityAssessmentsSettingsClient,
+		TransparentDataEncryptionsClient:                transparentDataEncryptionsClient,
+		ServersClient:                                   serversClient,
+
+		// 2023-05-01-preview Clients
+		FailoverGroupsClient: failoverGroupsClient,
 	}, nil
 }
@@ -84,6 +84,23 @@ func resourceMsSqlDatabaseExtendedAuditingPolicy() *pluginsdk.Resource {
 				Optional: true,
 				Default:  true,
 			},
+
+			"predicate_expression": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"audit_actions_and_groups": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				// audit_actions_and_groups is pre-populated with the authentication and batch completed groups when not specified
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
 		},
 	}
 }
@@ -133,6 +150,14 @@ func resourceMsSqlDatabaseExtendedAuditingPolicyCreateUpdate(d *pluginsdk.Resour
 		params.ExtendedDatabaseBlobAuditingPolicyProperties.StorageAccountAccessKey = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("predicate_expression"); ok {
+		params.ExtendedDatabaseBlobAuditingPolicyProperties.PredicateExpression = pointer.To(v.(string))
+	}
+
+	if v, ok := d.GetOk("audit_actions_and_groups"); ok && len(v.([]interface{})) > 0 {
+		params.ExtendedDatabaseBlobAuditingPolicyProperties.AuditActionsAndGroups = utils.ExpandStringSlice(v.([]interface{}))
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, dbId.ResourceGroupName, dbId.ServerName, dbId.DatabaseName, params); err != nil {
 		return fmt.Errorf("creating extended auditing policy for %s: %+v", dbId, err)
 	}
@@ -185,6 +210,8 @@ func resourceMsSqlDatabaseExtendedAuditingPolicyRead(d *pluginsdk.ResourceData,
 		d.Set("retention_in_days", props.RetentionDays)
 		d.Set("log_monitoring_enabled", props.IsAzureMonitorTargetEnabled)
 		d.Set("enabled", props.State == sql.BlobAuditingPolicyStateEnabled)
+		d.Set("predicate_expression", props.PredicateExpression)
+		d.Set("audit_actions_and_groups", utils.FlattenStringSlice(props.AuditActionsAndGroups))
 	}
 
 	return nil
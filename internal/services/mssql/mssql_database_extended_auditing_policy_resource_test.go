@@ -194,6 +194,29 @@ func TestAccMsSqlDatabaseExtendedAuditingPolicy_eventhub(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabaseExtendedAuditingPolicy_eventhubWithPredicateExpression(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_extended_auditing_policy", "test")
+	r := MsSqlDatabaseExtendedAuditingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.eventhubWithPredicateExpression(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("audit_actions_and_groups.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("storage_account_access_key"),
+		{
+			Config: r.eventhub(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("storage_account_access_key"),
+	})
+}
+
 func (MsSqlDatabaseExtendedAuditingPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DatabaseExtendedAuditingPolicyID(state.ID)
 	if err != nil {
@@ -713,3 +736,48 @@ resource "azurerm_mssql_database_extended_auditing_policy" "test" {
 }
 `, r.monitorTemplate(data), data.RandomInteger)
 }
+
+func (r MsSqlDatabaseExtendedAuditingPolicyResource) eventhubWithPredicateExpression(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                           = "acctest-DS-%[2]d"
+  target_resource_id             = azurerm_mssql_database.test.id
+  eventhub_authorization_rule_id = azurerm_eventhub_namespace_authorization_rule.test.id
+  eventhub_name                  = azurerm_eventhub.test.name
+
+  log {
+    category = "SQLSecurityAuditEvents"
+    enabled  = true
+
+    retention_policy {
+      enabled = false
+    }
+  }
+
+  metric {
+    category = "AllMetrics"
+
+    retention_policy {
+      enabled = false
+    }
+  }
+
+  // log, metric will return all disabled categories
+  lifecycle {
+    ignore_changes = [log, metric]
+  }
+}
+
+resource "azurerm_mssql_database_extended_auditing_policy" "test" {
+  database_id            = azurerm_mssql_database.test.id
+  log_monitoring_enabled = true
+  predicate_expression   = "statement <> 'select 1'"
+  audit_actions_and_groups = [
+    "SUCCESSFUL_DATABASE_AUTHENTICATION_GROUP",
+    "FAILED_DATABASE_AUTHENTICATION_GROUP",
+  ]
+}
+`, r.monitorTemplate(data), data.RandomInteger)
+}
@@ -8,19 +8,19 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-05-01-preview/failovergroups"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type MsSqlFailoverGroupModel struct {
@@ -30,6 +30,12 @@ type MsSqlFailoverGroupModel struct {
 	ReadonlyEndpointFailurePolicyEnabled bool                 `tfschema:"readonly_endpoint_failover_policy_enabled"`
 	ServerId                             string               `tfschema:"server_id"`
 	Tags                                 map[string]string    `tfschema:"tags"`
+	SecondaryType                        string               `tfschema:"secondary_type"`
+	FailoverTriggers                     map[string]string    `tfschema:"failover_triggers"`
+	FailoverMode                         string               `tfschema:"failover_mode"`
+	FailoverDataLossAllowed              bool                 `tfschema:"failover_data_loss_allowed"`
+	ReplicationRole                      string               `tfschema:"replication_role"`
+	ReplicationState                     string               `tfschema:"replication_state"`
 
 	ReadWriteEndpointFailurePolicy []ReadWriteEndpointFailurePolicyModel `tfschema:"read_write_endpoint_failover_policy"`
 }
@@ -45,6 +51,12 @@ type ReadWriteEndpointFailurePolicyModel struct {
 	Mode         string `tfschema:"mode"`
 }
 
+const (
+	failoverModePlanned                = "Planned"
+	failoverModeTryPlannedBeforeForced = "TryPlannedBeforeForced"
+	failoverModeForced                 = "Forced"
+)
+
 var (
 	_ sdk.Resource           = MsSqlFailoverGroupResource{}
 	_ sdk.ResourceWithUpdate = MsSqlFailoverGroupResource{}
@@ -127,8 +139,8 @@ func (r MsSqlFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
 						Type:     pluginsdk.TypeString,
 						Required: true,
 						ValidateFunc: validation.StringInSlice([]string{
-							string(sql.ReadWriteEndpointFailoverPolicyAutomatic),
-							string(sql.ReadWriteEndpointFailoverPolicyManual),
+							string(failovergroups.ReadWriteEndpointFailoverPolicyAutomatic),
+							string(failovergroups.ReadWriteEndpointFailoverPolicyManual),
 						}, false),
 					},
 					"grace_minutes": {
@@ -140,12 +152,55 @@ func (r MsSqlFailoverGroupResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
+		"secondary_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(failovergroups.PossibleValuesForFailoverGroupDatabasesSecondaryType(), false),
+		},
+
+		// there's no resource representing a failover, so one is triggered by changing this
+		"failover_triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"failover_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  failoverModePlanned,
+			ValidateFunc: validation.StringInSlice([]string{
+				failoverModePlanned,
+				failoverModeTryPlannedBeforeForced,
+				failoverModeForced,
+			}, false),
+		},
+
+		"failover_data_loss_allowed": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"tags": tags.Schema(),
 	}
 }
 
 func (r MsSqlFailoverGroupResource) Attributes() map[string]*pluginsdk.Schema {
-	return nil
+	return map[string]*pluginsdk.Schema{
+		"replication_role": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"replication_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
 }
 
 func (r MsSqlFailoverGroupResource) CustomizeDiff() sdk.ResourceFunc {
@@ -157,14 +212,19 @@ func (r MsSqlFailoverGroupResource) CustomizeDiff() sdk.ResourceFunc {
 			}
 
 			if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
-				if rwPolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) && rwPolicy[0].GraceMinutes < 60 {
-					return fmt.Errorf("`grace_minutes` should be %d or greater when `mode` is %q", 60, sql.ReadWriteEndpointFailoverPolicyAutomatic)
+				if rwPolicy[0].Mode == string(failovergroups.ReadWriteEndpointFailoverPolicyAutomatic) && rwPolicy[0].GraceMinutes < 60 {
+					return fmt.Errorf("`grace_minutes` should be %d or greater when `mode` is %q", 60, failovergroups.ReadWriteEndpointFailoverPolicyAutomatic)
 				}
-				if rwPolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyManual) && rwPolicy[0].GraceMinutes > 0 {
-					return fmt.Errorf("`grace_minutes` should not be specified when `mode` is %q", sql.ReadWriteEndpointFailoverPolicyManual)
+				if rwPolicy[0].Mode == string(failovergroups.ReadWriteEndpointFailoverPolicyManual) && rwPolicy[0].GraceMinutes > 0 {
+					return fmt.Errorf("`grace_minutes` should not be specified when `mode` is %q", failovergroups.ReadWriteEndpointFailoverPolicyManual)
 				}
 			}
 
+			// a forced failover can lose any data which hasn't been replicated to the partner server yet
+			if model.FailoverMode != failoverModePlanned && !model.FailoverDataLossAllowed {
+				return fmt.Errorf("`failover_data_loss_allowed` must be set to `true` when `failover_mode` is %q", model.FailoverMode)
+			}
+
 			return nil
 		},
 	}
@@ -192,52 +252,24 @@ func (r MsSqlFailoverGroupResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", serverId, err)
 			}
 
-			id := parse.NewFailoverGroupID(subscriptionId, serverId.ResourceGroupName, serverId.ServerName, model.Name)
+			id := failovergroups.NewFailoverGroupID(subscriptionId, serverId.ResourceGroupName, serverId.ServerName, model.Name)
 
-			existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+			existing, err := client.Get(ctx, id)
 			if err != nil {
-				if !utils.ResponseWasNotFound(existing.Response) {
+				if !response.WasNotFound(existing.HttpResponse) {
 					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 				}
 			}
 
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
-			readOnlyFailoverPolicy := sql.ReadOnlyEndpointFailoverPolicyDisabled
-			if model.ReadonlyEndpointFailurePolicyEnabled {
-				readOnlyFailoverPolicy = sql.ReadOnlyEndpointFailoverPolicyEnabled
-			}
-
-			properties := sql.FailoverGroup{
-				FailoverGroupProperties: &sql.FailoverGroupProperties{
-					Databases: &model.Databases,
-					ReadOnlyEndpoint: &sql.FailoverGroupReadOnlyEndpoint{
-						FailoverPolicy: readOnlyFailoverPolicy,
-					},
-					ReadWriteEndpoint: &sql.FailoverGroupReadWriteEndpoint{},
-					PartnerServers:    r.expandPartnerServers(model.PartnerServers),
-				},
-				Tags: tags.FromTypedObject(model.Tags),
-			}
-
-			if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
-				properties.FailoverGroupProperties.ReadWriteEndpoint.FailoverPolicy = sql.ReadWriteEndpointFailoverPolicy(rwPolicy[0].Mode)
-				if rwPolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) {
-					properties.FailoverGroupProperties.ReadWriteEndpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(rwPolicy[0].GraceMinutes))
-				}
-			}
-
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.Name, properties)
-			if err != nil {
+			// the failover triggers are only used once the Failover Group exists
+			if err = client.CreateOrUpdateThenPoll(ctx, id, r.expandFailoverGroup(model)); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
-			}
-
 			metadata.SetID(id)
 			return nil
 		},
@@ -250,7 +282,7 @@ func (r MsSqlFailoverGroupResource) Update() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.MSSQL.FailoverGroupsClient
 
-			id, err := parse.FailoverGroupID(metadata.ResourceData.Id())
+			id, err := failovergroups.ParseFailoverGroupID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -261,39 +293,19 @@ func (r MsSqlFailoverGroupResource) Update() sdk.ResourceFunc {
 				return err
 			}
 
-			metadata.Logger.Infof("updating %s", id)
-
-			readOnlyFailoverPolicy := sql.ReadOnlyEndpointFailoverPolicyDisabled
-			if state.ReadonlyEndpointFailurePolicyEnabled {
-				readOnlyFailoverPolicy = sql.ReadOnlyEndpointFailoverPolicyEnabled
-			}
+			if metadata.ResourceData.HasChanges("partner_server", "databases", "readonly_endpoint_failover_policy_enabled", "read_write_endpoint_failover_policy", "secondary_type", "tags") {
+				metadata.Logger.Infof("updating %s", id)
 
-			properties := sql.FailoverGroup{
-				FailoverGroupProperties: &sql.FailoverGroupProperties{
-					Databases: &state.Databases,
-					ReadOnlyEndpoint: &sql.FailoverGroupReadOnlyEndpoint{
-						FailoverPolicy: readOnlyFailoverPolicy,
-					},
-					ReadWriteEndpoint: &sql.FailoverGroupReadWriteEndpoint{
-						FailoverPolicy: sql.ReadWriteEndpointFailoverPolicy(state.ReadWriteEndpointFailurePolicy[0].Mode),
-					},
-					PartnerServers: r.expandPartnerServers(state.PartnerServers),
-				},
-				Tags: tags.FromTypedObject(state.Tags),
-			}
-
-			if state.ReadWriteEndpointFailurePolicy[0].Mode == string(sql.ReadWriteEndpointFailoverPolicyAutomatic) {
-				properties.FailoverGroupProperties.ReadWriteEndpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(state.ReadWriteEndpointFailurePolicy[0].GraceMinutes))
-			}
-
-			// client.Update doesn't support changing the PartnerServers
-			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.Name, properties)
-			if err != nil {
-				return fmt.Errorf("updating %s: %+v", id, err)
+				// client.Update doesn't support changing the PartnerServers
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, r.expandFailoverGroup(state)); err != nil {
+					return fmt.Errorf("updating %s: %+v", id, err)
+				}
 			}
 
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			if metadata.ResourceData.HasChange("failover_triggers") {
+				if err := r.failover(ctx, metadata, *id, state); err != nil {
+					return err
+				}
 			}
 
 			return nil
@@ -306,49 +318,58 @@ func (r MsSqlFailoverGroupResource) Read() sdk.ResourceFunc {
 		Timeout: 5 * time.Minute,
 
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			subscriptionId := metadata.Client.Account.SubscriptionId
 			client := metadata.Client.MSSQL.FailoverGroupsClient
 
-			id, err := parse.FailoverGroupID(metadata.ResourceData.Id())
+			id, err := failovergroups.ParseFailoverGroupID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+			existing, err := client.Get(ctx, *id)
 			if err != nil {
-				if utils.ResponseWasNotFound(existing.Response) {
+				if response.WasNotFound(existing.HttpResponse) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
-			serverId := parse.NewServerID(subscriptionId, id.ResourceGroup, id.ServerName)
+			// the failover settings aren't returned by the API, so they're retained from the state
+			var state MsSqlFailoverGroupModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
 
 			model := MsSqlFailoverGroupModel{
-				Name:     id.Name,
-				ServerId: serverId.ID(),
-				Tags:     tags.ToTypedObject(existing.Tags),
+				Name:                    id.FailoverGroupName,
+				ServerId:                commonids.NewSqlServerID(id.SubscriptionId, id.ResourceGroupName, id.ServerName).ID(),
+				FailoverTriggers:        state.FailoverTriggers,
+				FailoverMode:            state.FailoverMode,
+				FailoverDataLossAllowed: state.FailoverDataLossAllowed,
 			}
 
-			if props := existing.FailoverGroupProperties; props != nil {
-				if props.Databases != nil {
-					model.Databases = *props.Databases
-				}
+			if model.FailoverMode == "" {
+				// the default isn't applied when importing
+				model.FailoverMode = failoverModePlanned
+			}
 
-				model.PartnerServers = r.flattenPartnerServers(props.PartnerServers)
+			if existing.Model != nil {
+				model.Tags = pointer.From(existing.Model.Tags)
 
-				if props.ReadOnlyEndpoint != nil && props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled {
-					model.ReadonlyEndpointFailurePolicyEnabled = true
-				}
+				if props := existing.Model.Properties; props != nil {
+					model.Databases = pointer.From(props.Databases)
+					model.PartnerServers = r.flattenPartnerServers(props.PartnerServers)
+					model.ReplicationRole = string(pointer.From(props.ReplicationRole))
+					model.ReplicationState = pointer.From(props.ReplicationState)
+					model.SecondaryType = string(pointer.From(props.SecondaryType))
+
+					if props.ReadOnlyEndpoint != nil && pointer.From(props.ReadOnlyEndpoint.FailoverPolicy) == failovergroups.ReadOnlyEndpointFailoverPolicyEnabled {
+						model.ReadonlyEndpointFailurePolicyEnabled = true
+					}
 
-				if endpoint := props.ReadWriteEndpoint; endpoint != nil {
 					model.ReadWriteEndpointFailurePolicy = []ReadWriteEndpointFailurePolicyModel{{
-						Mode: string(endpoint.FailoverPolicy),
+						Mode:         string(props.ReadWriteEndpoint.FailoverPolicy),
+						GraceMinutes: pointer.From(props.ReadWriteEndpoint.FailoverWithDataLossGracePeriodMinutes),
 					}}
-
-					if endpoint.FailoverWithDataLossGracePeriodMinutes != nil {
-						model.ReadWriteEndpointFailurePolicy[0].GraceMinutes = int64(*endpoint.FailoverWithDataLossGracePeriodMinutes)
-					}
 				}
 			}
 
@@ -363,63 +384,125 @@ func (r MsSqlFailoverGroupResource) Delete() sdk.ResourceFunc {
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.MSSQL.FailoverGroupsClient
 
-			id, err := parse.FailoverGroupID(metadata.ResourceData.Id())
+			id, err := failovergroups.ParseFailoverGroupID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			if existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
-				if utils.ResponseWasNotFound(existing.Response) {
+			if existing, err := client.Get(ctx, *id); err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
-			future, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
-			if err != nil {
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
 				return fmt.Errorf("deleting %s: %+v", id, err)
 			}
 
-			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
-			}
-
 			return nil
 		},
 	}
 }
 
-func (r MsSqlFailoverGroupResource) flattenPartnerServers(input *[]sql.PartnerInfo) []PartnerServerModel {
-	output := make([]PartnerServerModel, 0)
-	if input == nil {
-		return output
+// failover makes the partner server the primary of the Failover Group - the failover is requested from the Failover Group
+// on the partner server, since the API fails over from the current primary to the server the request is sent to.
+func (r MsSqlFailoverGroupResource) failover(ctx context.Context, metadata sdk.ResourceMetaData, id failovergroups.FailoverGroupId, model MsSqlFailoverGroupModel) error {
+	client := metadata.Client.MSSQL.FailoverGroupsClient
+
+	if len(model.PartnerServers) != 1 {
+		return fmt.Errorf("a failover of %s can only be triggered when there is exactly one `partner_server`", id)
 	}
 
-	for _, partner := range *input {
-		model := PartnerServerModel{
-			Location: location.NormalizeNilable(partner.Location),
-			Role:     string(partner.ReplicationRole),
-		}
-		if partner.ID != nil {
-			model.ID = *partner.ID
+	partnerServerId, err := commonids.ParseSqlServerIDInsensitively(model.PartnerServers[0].ID)
+	if err != nil {
+		return err
+	}
+	partnerId := failovergroups.NewFailoverGroupID(partnerServerId.SubscriptionId, partnerServerId.ResourceGroupName, partnerServerId.ServerName, id.FailoverGroupName)
+
+	partner, err := client.Get(ctx, partnerId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", partnerId, err)
+	}
+	if partner.Model == nil || partner.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", partnerId)
+	}
+
+	if pointer.From(partner.Model.Properties.ReplicationRole) == failovergroups.FailoverGroupReplicationRolePrimary {
+		metadata.Logger.Infof("skipping the failover of %s since %s is already the primary", id, partnerServerId)
+		return nil
+	}
+
+	metadata.Logger.Infof("failing over %s to %s using the %q mode", id, partnerServerId, model.FailoverMode)
+
+	switch model.FailoverMode {
+	case failoverModeForced:
+		err = client.ForceFailoverAllowDataLossThenPoll(ctx, partnerId)
+	case failoverModeTryPlannedBeforeForced:
+		err = client.TryPlannedBeforeForcedFailoverThenPoll(ctx, partnerId)
+	default:
+		err = client.FailoverThenPoll(ctx, partnerId)
+	}
+	if err != nil {
+		return fmt.Errorf("failing over %s to %s: %+v", id, partnerServerId, err)
+	}
+
+	return nil
+}
+
+func (r MsSqlFailoverGroupResource) expandFailoverGroup(model MsSqlFailoverGroupModel) failovergroups.FailoverGroup {
+	readOnlyFailoverPolicy := failovergroups.ReadOnlyEndpointFailoverPolicyDisabled
+	if model.ReadonlyEndpointFailurePolicyEnabled {
+		readOnlyFailoverPolicy = failovergroups.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	properties := failovergroups.FailoverGroup{
+		Properties: &failovergroups.FailoverGroupProperties{
+			Databases: pointer.To(model.Databases),
+			ReadOnlyEndpoint: &failovergroups.FailoverGroupReadOnlyEndpoint{
+				FailoverPolicy: pointer.To(readOnlyFailoverPolicy),
+			},
+			PartnerServers: r.expandPartnerServers(model.PartnerServers),
+		},
+		Tags: pointer.To(model.Tags),
+	}
+
+	if model.SecondaryType != "" {
+		properties.Properties.SecondaryType = pointer.To(failovergroups.FailoverGroupDatabasesSecondaryType(model.SecondaryType))
+	}
+
+	if rwPolicy := model.ReadWriteEndpointFailurePolicy; len(rwPolicy) > 0 {
+		properties.Properties.ReadWriteEndpoint.FailoverPolicy = failovergroups.ReadWriteEndpointFailoverPolicy(rwPolicy[0].Mode)
+		if rwPolicy[0].Mode == string(failovergroups.ReadWriteEndpointFailoverPolicyAutomatic) {
+			properties.Properties.ReadWriteEndpoint.FailoverWithDataLossGracePeriodMinutes = pointer.To(rwPolicy[0].GraceMinutes)
 		}
-		output = append(output, model)
 	}
 
-	return output
+	return properties
 }
 
-func (r MsSqlFailoverGroupResource) expandPartnerServers(input []PartnerServerModel) *[]sql.PartnerInfo {
-	var partnerServers []sql.PartnerInfo
-	if input == nil {
-		return &partnerServers
+func (r MsSqlFailoverGroupResource) flattenPartnerServers(input []failovergroups.PartnerInfo) []PartnerServerModel {
+	output := make([]PartnerServerModel, 0)
+
+	for _, partner := range input {
+		output = append(output, PartnerServerModel{
+			ID:       partner.Id,
+			Location: location.NormalizeNilable(partner.Location),
+			Role:     string(pointer.From(partner.ReplicationRole)),
+		})
 	}
 
+	return output
+}
+
+func (r MsSqlFailoverGroupResource) expandPartnerServers(input []PartnerServerModel) []failovergroups.PartnerInfo {
+	partnerServers := make([]failovergroups.PartnerInfo, 0)
+
 	for _, v := range input {
-		partnerServers = append(partnerServers, sql.PartnerInfo{
-			ID: utils.String(v.ID),
+		partnerServers = append(partnerServers, failovergroups.PartnerInfo{
+			Id: v.ID,
 		})
 	}
 
-	return &partnerServers
+	return partnerServers
 }
@@ -6,6 +6,7 @@ package mssql_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -107,6 +108,77 @@ func TestAccMsSqlFailoverGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlFailoverGroup_secondaryTypeStandby(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.secondaryType(data, "Standby"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Standby"),
+				check.That(data.ResourceName).Key("replication_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.secondaryType(data, "Geo"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("secondary_type").HasValue("Geo"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_failoverTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.failoverTriggers(data, "initial"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep("failover_triggers"),
+		{
+			// fails over to the partner server
+			Config: r.failoverTriggers(data, "failover"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_role").HasValue("Secondary"),
+			),
+		},
+		data.ImportStep("failover_triggers"),
+		{
+			// and back again, so that the Failover Group can be deleted from the primary server
+			Config: r.failoverTriggers(data, "failback"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_role").HasValue("Primary"),
+			),
+		},
+		data.ImportStep("failover_triggers"),
+	})
+}
+
+func TestAccMsSqlFailoverGroup_forcedFailoverWithoutDataLossAllowed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
+	r := MsSqlFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.forcedFailoverWithoutDataLossAllowed(data),
+			ExpectError: regexp.MustCompile("`failover_data_loss_allowed` must be set to `true`"),
+		},
+	})
+}
+
 func TestAccMsSqlFailoverGroup_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_failover_group", "test")
 	r := MsSqlFailoverGroupResource{}
@@ -268,6 +340,69 @@ resource "azurerm_mssql_failover_group" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MsSqlFailoverGroupResource) secondaryType(data acceptance.TestData, secondaryType string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name           = "acctestsfg%[2]d"
+  server_id      = azurerm_mssql_server.test_primary.id
+  secondary_type = "%[3]s"
+
+  partner_server {
+    id = azurerm_mssql_server.test_secondary.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+}
+`, r.template(data), data.RandomInteger, secondaryType)
+}
+
+func (r MsSqlFailoverGroupResource) failoverTriggers(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name      = "acctestsfg%[2]d"
+  server_id = azurerm_mssql_server.test_primary.id
+
+  partner_server {
+    id = azurerm_mssql_server.test_secondary.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+
+  failover_triggers = {
+    trigger = "%[3]s"
+  }
+}
+`, r.template(data), data.RandomInteger, trigger)
+}
+
+func (r MsSqlFailoverGroupResource) forcedFailoverWithoutDataLossAllowed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_failover_group" "test" {
+  name          = "acctestsfg%[2]d"
+  server_id     = azurerm_mssql_server.test_primary.id
+  failover_mode = "Forced"
+
+  partner_server {
+    id = azurerm_mssql_server.test_secondary.id
+  }
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MsSqlFailoverGroupResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/ipv6firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlIPv6FirewallRuleResource struct{}
+
+func TestAccMsSqlIPv6FirewallRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_ipv6_firewall_rule", "test")
+	r := MsSqlIPv6FirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlIPv6FirewallRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_ipv6_firewall_rule", "test")
+	r := MsSqlIPv6FirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlIPv6FirewallRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_ipv6_firewall_rule", "test")
+	r := MsSqlIPv6FirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r MsSqlIPv6FirewallRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := ipv6firewallrules.ParseIPv6FirewallRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.IPv6FirewallRulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r MsSqlIPv6FirewallRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_ipv6_firewall_rule" "test" {
+  name             = "acctestsqlserver%[2]d"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "2001:db8::"
+  end_ip_address   = "2001:db8::ffff"
+}
+`, MsSqlFirewallRuleResource{}.template(data), data.RandomInteger)
+}
+
+func (r MsSqlIPv6FirewallRuleResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_ipv6_firewall_rule" "test" {
+  name             = "acctestsqlserver%[2]d"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "2001:db8:1::"
+  end_ip_address   = "2001:db8:1::ff"
+}
+`, MsSqlFirewallRuleResource{}.template(data), data.RandomInteger)
+}
+
+func (r MsSqlIPv6FirewallRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_ipv6_firewall_rule" "import" {
+  name             = azurerm_mssql_ipv6_firewall_rule.test.name
+  server_id        = azurerm_mssql_ipv6_firewall_rule.test.server_id
+  start_ip_address = azurerm_mssql_ipv6_firewall_rule.test.start_ip_address
+  end_ip_address   = azurerm_mssql_ipv6_firewall_rule.test.end_ip_address
+}
+`, r.basic(data))
+}
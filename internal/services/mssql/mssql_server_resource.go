@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -388,73 +389,47 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("azuread_administrator") {
-		// need to check if aadOnly is enabled or not before calling delete, else you will get the following error:
-		// InvalidServerAADOnlyAuthNoAADAdminPropertyName: AAD Admin is not configured, AAD Admin must be set
-		// before enabling/disabling AAD Only Authentication.
-		log.Printf("[INFO] Checking if Azure Active Directory Administrators exist")
-		aadOnlyAdmin := false
-
-		resp, err := adminClient.Get(ctx, pointer.From(id))
-		if err != nil {
-			if !response.WasNotFound(resp.HttpResponse) {
-				return fmt.Errorf("retrieving Azure Active Directory Administrators %s: %+v", pointer.From(id), err)
+		oldRaw, newRaw := d.GetChange("azuread_administrator")
+		oldAdmin := expandMsSqlServerAdministrator(oldRaw.([]interface{}))
+		newAdmin := expandMsSqlServerAdministrator(newRaw.([]interface{}))
+		aadOnlyWasEnabled := expandMsSqlServerAADOnlyAuthentictions(oldRaw.([]interface{}))
+		aadOnlyEnabled := expandMsSqlServerAADOnlyAuthentictions(newRaw.([]interface{}))
+
+		// NOTE: the Azure Active Directory Administrator is only recreated when it's been removed, since the database
+		// users created for the administrator (and any contained users) are otherwise kept. Azure Active Directory Only
+		// Authentication must be disabled before the Azure Active Directory Administrator can be removed, else you will
+		// get the following error: InvalidServerAADOnlyAuthNoAADAdminPropertyName: AAD Admin is not configured, AAD Admin
+		// must be set before enabling/disabling AAD Only Authentication.
+		if aadOnlyWasEnabled && !aadOnlyEnabled {
+			if err := disableMsSqlServerAADOnlyAuthentication(ctx, aadOnlyAuthenticationsClient, *id); err != nil {
+				return err
 			}
-		} else {
-			aadOnlyAdmin = true
 		}
 
-		if aadOnlyAdmin {
-			resp, err := aadOnlyAuthenticationsClient.Delete(ctx, *id)
-			if err != nil {
-				log.Printf("[INFO] Deletion of Azure Active Directory Only Authentication failed for %s: %+v", pointer.From(id), err)
-				return fmt.Errorf("deleting Azure Active Directory Only Authentications for %s: %+v", pointer.From(id), err)
-			}
-
-			// NOTE: This call does not return a future it returns a response, but you will get a future back if the status code is 202...
-			// https://learn.microsoft.com/en-us/rest/api/sql/server-azure-ad-only-authentications/delete?view=rest-sql-2023-05-01-preview&tabs=HTTP
-			if response.WasStatusCode(resp.HttpResponse, 202) {
-				// NOTE: It was accepted but not completed, it is now an async operation...
-				// create a custom poller and wait for it to complete as 'Succeeded'...
-				log.Printf("[INFO] Delete Azure Active Directory Only Administrators response was a 202 WaitForStateContext...")
-
-				initialDelayDuration := 5 * time.Second
-				pollerType := custompollers.NewMsSqlServerDeleteServerAzureADOnlyAuthenticationPoller(aadOnlyAuthenticationsClient, pointer.From(id))
-				poller := pollers.NewPoller(pollerType, initialDelayDuration, pollers.DefaultNumberOfDroppedConnectionsToAllow)
-				if err := poller.PollUntilDone(ctx); err != nil {
-					return fmt.Errorf("waiting for the deletion of the Azure Active Directory Only Administrator: %+v", err)
+		if newAdmin == nil {
+			if oldAdmin != nil {
+				log.Printf("[INFO] Deleting the Azure Active Directory Administrator for %s", *id)
+				if err := adminClient.DeleteThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("deleting Azure Active Directory Administrator %s: %+v", id, err)
 				}
 			}
-		}
-
-		log.Printf("[INFO] Expanding 'azuread_administrator' to see if we need Create or Delete")
-		if adminProps := expandMsSqlServerAdministrator(d.Get("azuread_administrator").([]interface{})); adminProps != nil {
-			err := adminClient.CreateOrUpdateThenPoll(ctx, *id, pointer.From(adminProps))
-			if err != nil {
+		} else if msSqlServerAdministratorHasChanged(oldAdmin, newAdmin) {
+			log.Printf("[INFO] Creating/Updating the Azure Active Directory Administrator for %s", *id)
+			if err := adminClient.CreateOrUpdateThenPoll(ctx, *id, *newAdmin); err != nil {
 				return fmt.Errorf("creating Azure Active Directory Administrator %s: %+v", id, err)
 			}
-		} else {
-			_, err := adminClient.Get(ctx, *id)
-			if err != nil {
-				return fmt.Errorf("retrieving Azure Active Directory Administrator %s: %+v", id, err)
-			}
-
-			err = adminClient.DeleteThenPoll(ctx, *id)
-			if err != nil {
-				return fmt.Errorf("deleting Azure Active Directory Administrator %s: %+v", id, err)
-			}
 		}
-	}
 
-	if aadOnlyAuthentictionsEnabled := expandMsSqlServerAADOnlyAuthentictions(d.Get("azuread_administrator").([]interface{})); d.HasChange("azuread_administrator") && aadOnlyAuthentictionsEnabled {
-		aadOnlyAuthentictionsProps := serverazureadonlyauthentications.ServerAzureADOnlyAuthentication{
-			Properties: &serverazureadonlyauthentications.AzureADOnlyAuthProperties{
-				AzureADOnlyAuthentication: aadOnlyAuthentictionsEnabled,
-			},
-		}
+		if aadOnlyEnabled && !aadOnlyWasEnabled {
+			aadOnlyAuthentictionsProps := serverazureadonlyauthentications.ServerAzureADOnlyAuthentication{
+				Properties: &serverazureadonlyauthentications.AzureADOnlyAuthProperties{
+					AzureADOnlyAuthentication: aadOnlyEnabled,
+				},
+			}
 
-		err := aadOnlyAuthenticationsClient.CreateOrUpdateThenPoll(ctx, *id, aadOnlyAuthentictionsProps)
-		if err != nil {
-			return fmt.Errorf("updating Azure Active Directory Only Authentication for %s: %+v", id, err)
+			if err := aadOnlyAuthenticationsClient.CreateOrUpdateThenPoll(ctx, *id, aadOnlyAuthentictionsProps); err != nil {
+				return fmt.Errorf("updating Azure Active Directory Only Authentication for %s: %+v", id, err)
+			}
 		}
 	}
 
@@ -585,6 +560,41 @@ func resourceMsSqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func disableMsSqlServerAADOnlyAuthentication(ctx context.Context, client *serverazureadonlyauthentications.ServerAzureADOnlyAuthenticationsClient, id commonids.SqlServerId) error {
+	log.Printf("[INFO] Disabling Azure Active Directory Only Authentication for %s", id)
+	resp, err := client.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("deleting Azure Active Directory Only Authentications for %s: %+v", id, err)
+	}
+
+	// NOTE: This call does not return a future it returns a response, but you will get a future back if the status code is 202...
+	// https://learn.microsoft.com/en-us/rest/api/sql/server-azure-ad-only-authentications/delete?view=rest-sql-2023-05-01-preview&tabs=HTTP
+	if response.WasStatusCode(resp.HttpResponse, 202) {
+		// NOTE: It was accepted but not completed, it is now an async operation...
+		// create a custom poller and wait for it to complete as 'Succeeded'...
+		log.Printf("[INFO] Delete Azure Active Directory Only Administrators response was a 202 WaitForStateContext...")
+
+		initialDelayDuration := 5 * time.Second
+		pollerType := custompollers.NewMsSqlServerDeleteServerAzureADOnlyAuthenticationPoller(client, id)
+		poller := pollers.NewPoller(pollerType, initialDelayDuration, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+		if err := poller.PollUntilDone(ctx); err != nil {
+			return fmt.Errorf("waiting for the deletion of the Azure Active Directory Only Administrator: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func msSqlServerAdministratorHasChanged(old, new *serverazureadadministrators.ServerAzureADAdministrator) bool {
+	if old == nil || new == nil || old.Properties == nil || new.Properties == nil {
+		return old != new
+	}
+
+	return !strings.EqualFold(old.Properties.Login, new.Properties.Login) ||
+		!strings.EqualFold(old.Properties.Sid, new.Properties.Sid) ||
+		!strings.EqualFold(pointer.From(old.Properties.TenantId), pointer.From(new.Properties.TenantId))
+}
+
 func expandMsSqlServerAADOnlyAuthentictions(input []interface{}) bool {
 	if len(input) == 0 || input[0] == nil {
 		return false
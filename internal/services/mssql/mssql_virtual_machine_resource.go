@@ -162,6 +162,13 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						"storage_container_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
 						"system_databases_backup_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -852,6 +859,9 @@ func expandSqlVirtualMachineAutoBackupSettings(input []interface{}) (*sqlvirtual
 		if v, ok := config["storage_account_access_key"]; ok {
 			ret.StorageAccessKey = utils.String(v.(string))
 		}
+		if v, ok := config["storage_container_name"]; ok && v.(string) != "" {
+			ret.StorageContainerName = utils.String(v.(string))
+		}
 
 		v, ok := config["encryption_enabled"]
 		enableEncryption := ok && v.(bool)
@@ -952,6 +962,7 @@ func flattenSqlVirtualMachineAutoBackup(autoBackup *sqlvirtualmachines.AutoBacku
 			"retention_period_in_days":        retentionPeriod,
 			"storage_account_access_key":      storageKey,
 			"storage_blob_endpoint":           blobEndpoint,
+			"storage_container_name":          pointer.From(autoBackup.StorageContainerName),
 			"system_databases_backup_enabled": autoBackup.BackupSystemDbs != nil && *autoBackup.BackupSystemDbs,
 		},
 	}
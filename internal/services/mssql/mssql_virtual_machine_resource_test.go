@@ -90,6 +90,7 @@ func TestAccMsSqlVirtualMachine_autoBackup(t *testing.T) {
 			Config: r.withAutoBackupManualSchedule(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_backup.0.storage_container_name").HasValue("sqlbackups"),
 			),
 		},
 		data.ImportStep("auto_backup.0.encryption_password",
@@ -621,6 +622,7 @@ resource "azurerm_mssql_virtual_machine" "test" {
     retention_period_in_days        = 14
     storage_blob_endpoint           = azurerm_storage_account.test.primary_blob_endpoint
     storage_account_access_key      = azurerm_storage_account.test.primary_access_key
+    storage_container_name          = "sqlbackups"
     system_databases_backup_enabled = true
 
     manual_schedule {
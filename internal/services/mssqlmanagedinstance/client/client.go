@@ -4,7 +4,10 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/managedinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -21,10 +24,13 @@ type Client struct {
 	ManagedInstanceFailoverGroupsClient              *sql.InstanceFailoverGroupsClient
 	ManagedInstanceKeysClient                        *sql.ManagedInstanceKeysClient
 
+	// 2023-02-01-preview Clients
+	ManagedInstancesOutboundNetworkDependenciesClient *managedinstances.ManagedInstancesClient
+
 	options *common.ClientOptions
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	managedDatabasesClient := sql.NewManagedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedDatabasesClient.Client, o.ResourceManagerAuthorizer)
@@ -59,6 +65,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	managedInstanceServerSecurityAlertPoliciesClient := sql.NewManagedServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstanceServerSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	managedInstancesOutboundNetworkDependenciesClient, err := managedinstances.NewManagedInstancesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Managed Instances Client: %+v", err)
+	}
+	o.Configure(managedInstancesOutboundNetworkDependenciesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ManagedDatabasesClient:                           &managedDatabasesClient,
 		ManagedInstanceAdministratorsClient:              &managedInstancesAdministratorsClient,
@@ -72,8 +84,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagedInstanceVulnerabilityAssessmentsClient:    &managedInstanceVulnerabilityAssessmentsClient,
 		ManagedInstancesClient:                           &managedInstancesClient,
 
+		ManagedInstancesOutboundNetworkDependenciesClient: managedInstancesOutboundNetworkDependenciesClient,
+
 		options: o,
-	}
+	}, nil
 }
 
 func (c Client) ManagedInstancesClientForSubscription(subscriptionID string) *sql.ManagedInstancesClient {
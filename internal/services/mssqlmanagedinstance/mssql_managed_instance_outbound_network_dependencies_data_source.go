@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-02-01-preview/managedinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlManagedInstanceOutboundNetworkDependenciesDataSourceModel struct {
+	ManagedInstanceId           string                                          `tfschema:"managed_instance_id"`
+	OutboundNetworkDependencies []MsSqlManagedInstanceOutboundNetworkDependency `tfschema:"outbound_network_dependency"`
+}
+
+type MsSqlManagedInstanceOutboundNetworkDependency struct {
+	Category string                                        `tfschema:"category"`
+	Endpoint []MsSqlManagedInstanceOutboundNetworkEndpoint `tfschema:"endpoint"`
+}
+
+type MsSqlManagedInstanceOutboundNetworkEndpoint struct {
+	DomainName string  `tfschema:"domain_name"`
+	Ports      []int64 `tfschema:"ports"`
+}
+
+var _ sdk.DataSource = MsSqlManagedInstanceOutboundNetworkDependenciesDataSource{}
+
+type MsSqlManagedInstanceOutboundNetworkDependenciesDataSource struct{}
+
+func (d MsSqlManagedInstanceOutboundNetworkDependenciesDataSource) ResourceType() string {
+	return "azurerm_mssql_managed_instance_outbound_network_dependencies"
+}
+
+func (d MsSqlManagedInstanceOutboundNetworkDependenciesDataSource) ModelObject() interface{} {
+	return &MsSqlManagedInstanceOutboundNetworkDependenciesDataSourceModel{}
+}
+
+func (d MsSqlManagedInstanceOutboundNetworkDependenciesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_instance_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSqlManagedInstanceID,
+		},
+	}
+}
+
+func (d MsSqlManagedInstanceOutboundNetworkDependenciesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"outbound_network_dependency": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"category": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"endpoint": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"domain_name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"ports": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeInt,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d MsSqlManagedInstanceOutboundNetworkDependenciesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQLManagedInstance.ManagedInstancesOutboundNetworkDependenciesClient
+
+			var state MsSqlManagedInstanceOutboundNetworkDependenciesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseSqlManagedInstanceID(state.ManagedInstanceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListOutboundNetworkDependenciesByManagedInstanceComplete(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.LatestHttpResponse) {
+					return fmt.Errorf("%s was not found", *id)
+				}
+				return fmt.Errorf("listing Outbound Network Dependencies for %s: %+v", *id, err)
+			}
+
+			model := MsSqlManagedInstanceOutboundNetworkDependenciesDataSourceModel{
+				ManagedInstanceId:           id.ID(),
+				OutboundNetworkDependencies: flattenMsSqlManagedInstanceOutboundNetworkDependencies(resp.Items),
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func flattenMsSqlManagedInstanceOutboundNetworkDependencies(input []managedinstances.OutboundEnvironmentEndpoint) []MsSqlManagedInstanceOutboundNetworkDependency {
+	results := make([]MsSqlManagedInstanceOutboundNetworkDependency, 0)
+	for _, item := range input {
+		endpoints := make([]MsSqlManagedInstanceOutboundNetworkEndpoint, 0)
+		if item.Endpoints != nil {
+			for _, endpoint := range *item.Endpoints {
+				ports := make([]int64, 0)
+				if endpoint.EndpointDetails != nil {
+					for _, detail := range *endpoint.EndpointDetails {
+						if detail.Port != nil {
+							ports = append(ports, *detail.Port)
+						}
+					}
+				}
+
+				endpoints = append(endpoints, MsSqlManagedInstanceOutboundNetworkEndpoint{
+					DomainName: pointer.From(endpoint.DomainName),
+					Ports:      ports,
+				})
+			}
+		}
+
+		results = append(results, MsSqlManagedInstanceOutboundNetworkDependency{
+			Category: pointer.From(item.Category),
+			Endpoint: endpoints,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssqlmanagedinstance_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlManagedInstanceOutboundNetworkDependenciesDataSource struct{}
+
+func TestAccDataSourceMsSqlManagedInstanceOutboundNetworkDependencies_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_managed_instance_outbound_network_dependencies", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: MsSqlManagedInstanceOutboundNetworkDependenciesDataSource{}.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("outbound_network_dependency.#").Exists(),
+				check.That(data.ResourceName).Key("outbound_network_dependency.0.category").Exists(),
+				check.That(data.ResourceName).Key("outbound_network_dependency.0.endpoint.0.domain_name").Exists(),
+			),
+		},
+	})
+}
+
+func (d MsSqlManagedInstanceOutboundNetworkDependenciesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_mssql_managed_instance_outbound_network_dependencies" "test" {
+  managed_instance_id = azurerm_mssql_managed_instance.test.id
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
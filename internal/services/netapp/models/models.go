@@ -62,6 +62,37 @@ type NetAppVolumeGroupSapHanaDataSourceModel struct {
 	Volumes               []NetAppVolumeGroupVolume `tfschema:"volume"`
 }
 
+type NetAppVolumeGroupOracleVolume struct {
+	Id                           string                         `tfschema:"id"`
+	Name                         string                         `tfschema:"name"`
+	VolumePath                   string                         `tfschema:"volume_path"`
+	ServiceLevel                 string                         `tfschema:"service_level"`
+	SubnetId                     string                         `tfschema:"subnet_id"`
+	Protocols                    []string                       `tfschema:"protocols"`
+	SecurityStyle                string                         `tfschema:"security_style"`
+	StorageQuotaInGB             int64                          `tfschema:"storage_quota_in_gb"`
+	ThroughputInMibps            float64                        `tfschema:"throughput_in_mibps"`
+	Tags                         map[string]string              `tfschema:"tags"`
+	SnapshotDirectoryVisible     bool                           `tfschema:"snapshot_directory_visible"`
+	CapacityPoolId               string                         `tfschema:"capacity_pool_id"`
+	ProximityPlacementGroupId    string                         `tfschema:"proximity_placement_group_id"`
+	Zone                         string                         `tfschema:"zone"`
+	VolumeSpecName               string                         `tfschema:"volume_spec_name"`
+	ExportPolicy                 []ExportPolicyRule             `tfschema:"export_policy_rule"`
+	MountIpAddresses             []string                       `tfschema:"mount_ip_addresses"`
+	DataProtectionSnapshotPolicy []DataProtectionSnapshotPolicy `tfschema:"data_protection_snapshot_policy"`
+}
+
+type NetAppVolumeGroupOracleModel struct {
+	Name                  string                          `tfschema:"name"`
+	ResourceGroupName     string                          `tfschema:"resource_group_name"`
+	Location              string                          `tfschema:"location"`
+	AccountName           string                          `tfschema:"account_name"`
+	GroupDescription      string                          `tfschema:"group_description"`
+	ApplicationIdentifier string                          `tfschema:"application_identifier"`
+	Volumes               []NetAppVolumeGroupOracleVolume `tfschema:"volume"`
+}
+
 type ExportPolicyRule struct {
 	RuleIndex         int64  `tfschema:"rule_index"`
 	AllowedClients    string `tfschema:"allowed_clients"`
@@ -30,7 +30,7 @@ import (
 
 type NetAppVolumeGroupSapHanaResource struct{}
 
-var _ sdk.Resource = NetAppVolumeGroupSapHanaResource{}
+var _ sdk.ResourceWithCustomizeDiff = NetAppVolumeGroupSapHanaResource{}
 
 func (r NetAppVolumeGroupSapHanaResource) ModelObject() interface{} {
 	return &netAppModels.NetAppVolumeGroupSapHanaModel{}
@@ -295,6 +295,35 @@ func (r NetAppVolumeGroupSapHanaResource) Attributes() map[string]*pluginsdk.Sch
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r NetAppVolumeGroupSapHanaResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model netAppModels.NetAppVolumeGroupSapHanaModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// Validating the volumes as a whole during plan, since a volume group which fails part way through
+			// its creation leaves volumes behind - when the placement isn't known yet this is performed in Create
+			if len(model.Volumes) == 0 || !netAppVolumeGroupVolumesValuesKnown(metadata, len(model.Volumes), "proximity_placement_group_id") {
+				return nil
+			}
+
+			volumeList, err := expandNetAppVolumeGroupVolumes(model.Volumes)
+			if err != nil {
+				return err
+			}
+
+			if errorList := netAppValidate.ValidateNetAppVolumeGroupSAPHanaVolumes(volumeList); len(errorList) > 0 {
+				return fmt.Errorf("one or more issues found while performing deeper validations for volume group %q:\n%+v", model.Name, errorList)
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r NetAppVolumeGroupSapHanaResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 90 * time.Minute,
@@ -360,8 +389,10 @@ func (r NetAppVolumeGroupSapHanaResource) Create() sdk.ResourceFunc {
 				},
 			}
 
-			err = client.CreateThenPoll(ctx, id, parameters)
-			if err != nil {
+			if err = client.CreateThenPoll(ctx, id, parameters); err != nil {
+				// the volume group may still exist (or still be provisioning, e.g. when polling timed out), so it's tracked
+				// in the state to be tainted rather than orphaned - it's removed from the state on refresh if it doesn't exist
+				metadata.SetID(id)
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
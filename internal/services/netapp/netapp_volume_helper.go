@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumesreplication"
@@ -153,6 +154,54 @@ func expandNetAppVolumeGroupVolumes(input []netAppModels.NetAppVolumeGroupVolume
 	return &results, nil
 }
 
+func expandNetAppVolumeGroupOracleVolumes(input []netAppModels.NetAppVolumeGroupOracleVolume) (*[]volumegroups.VolumeGroupVolumeProperties, error) {
+	if len(input) == 0 {
+		return &[]volumegroups.VolumeGroupVolumeProperties{}, fmt.Errorf("received empty NetAppVolumeGroupOracleVolume slice")
+	}
+
+	results := make([]volumegroups.VolumeGroupVolumeProperties, 0)
+
+	for _, item := range input {
+		serviceLevel := volumegroups.ServiceLevel(item.ServiceLevel)
+		securityStyle := volumegroups.SecurityStyle(item.SecurityStyle)
+		protocols := item.Protocols
+		dataProtectionSnapshotPolicy := expandNetAppVolumeGroupDataProtectionSnapshotPolicy(item.DataProtectionSnapshotPolicy)
+
+		volumeProperties := volumegroups.VolumeGroupVolumeProperties{
+			Name: utils.String(item.Name),
+			Properties: volumegroups.VolumeProperties{
+				CapacityPoolResourceId:   utils.String(item.CapacityPoolId),
+				CreationToken:            item.VolumePath,
+				ServiceLevel:             &serviceLevel,
+				SubnetId:                 item.SubnetId,
+				ProtocolTypes:            &protocols,
+				SecurityStyle:            &securityStyle,
+				UsageThreshold:           item.StorageQuotaInGB * 1073741824,
+				ExportPolicy:             expandNetAppVolumeGroupVolumeExportPolicyRule(item.ExportPolicy),
+				SnapshotDirectoryVisible: utils.Bool(item.SnapshotDirectoryVisible),
+				ThroughputMibps:          utils.Float(item.ThroughputInMibps),
+				VolumeSpecName:           utils.String(item.VolumeSpecName),
+				DataProtection: &volumegroups.VolumePropertiesDataProtection{
+					Snapshot: dataProtectionSnapshotPolicy.Snapshot,
+				},
+			},
+			Tags: &item.Tags,
+		}
+
+		if item.ProximityPlacementGroupId != "" {
+			volumeProperties.Properties.ProximityPlacementGroup = utils.String(item.ProximityPlacementGroupId)
+		}
+
+		if item.Zone != "" {
+			volumeProperties.Zones = pointer.To(zones.Schema{item.Zone})
+		}
+
+		results = append(results, volumeProperties)
+	}
+
+	return &results, nil
+}
+
 func expandNetAppVolumeGroupVolumeExportPolicyRulePatch(input []interface{}) *volumes.VolumePatchPropertiesExportPolicy {
 	if len(input) == 0 {
 		return &volumes.VolumePatchPropertiesExportPolicy{}
@@ -339,6 +388,71 @@ func flattenNetAppVolumeGroupVolumes(ctx context.Context, input *[]volumegroups.
 	return results, nil
 }
 
+func flattenNetAppVolumeGroupOracleVolumes(ctx context.Context, input *[]volumegroups.VolumeGroupVolumeProperties, metadata sdk.ResourceMetaData) ([]netAppModels.NetAppVolumeGroupOracleVolume, error) {
+	results := make([]netAppModels.NetAppVolumeGroupOracleVolume, 0)
+
+	if input == nil || len(pointer.From(input)) == 0 {
+		return results, fmt.Errorf("received empty volumegroups.VolumeGroupVolumeProperties slice")
+	}
+
+	for _, item := range *input {
+		volumeGroupVolume := netAppModels.NetAppVolumeGroupOracleVolume{}
+
+		props := item.Properties
+		volumeGroupVolume.Name = getUserDefinedVolumeName(item.Name)
+		volumeGroupVolume.VolumePath = props.CreationToken
+		volumeGroupVolume.ServiceLevel = string(pointer.From(props.ServiceLevel))
+		volumeGroupVolume.SubnetId = props.SubnetId
+		volumeGroupVolume.CapacityPoolId = utils.NormalizeNilableString(props.CapacityPoolResourceId)
+		volumeGroupVolume.Protocols = pointer.From(props.ProtocolTypes)
+		volumeGroupVolume.SecurityStyle = string(pointer.From(props.SecurityStyle))
+		volumeGroupVolume.SnapshotDirectoryVisible = pointer.From(props.SnapshotDirectoryVisible)
+		volumeGroupVolume.ThroughputInMibps = pointer.From(props.ThroughputMibps)
+		volumeGroupVolume.Tags = pointer.From(item.Tags)
+		volumeGroupVolume.ProximityPlacementGroupId = utils.NormalizeNilableString(props.ProximityPlacementGroup)
+		volumeGroupVolume.VolumeSpecName = pointer.From(props.VolumeSpecName)
+
+		if volumeZones := zones.Flatten(item.Zones); len(volumeZones) > 0 {
+			volumeGroupVolume.Zone = volumeZones[0]
+		}
+
+		if props.UsageThreshold > 0 {
+			volumeGroupVolume.StorageQuotaInGB = props.UsageThreshold / 1073741824
+		}
+
+		if props.ExportPolicy != nil && props.ExportPolicy.Rules != nil && len(pointer.From(props.ExportPolicy.Rules)) > 0 {
+			volumeGroupVolume.ExportPolicy = flattenNetAppVolumeGroupVolumesExportPolicies(props.ExportPolicy.Rules)
+		}
+
+		if props.MountTargets != nil && len(pointer.From(props.MountTargets)) > 0 {
+			volumeGroupVolume.MountIpAddresses = flattenNetAppVolumeGroupVolumesMountIpAddresses(props.MountTargets)
+		}
+
+		// Getting volume resource directly from standalone volume
+		// since VolumeGroup Volumes don't return DataProtection information
+		volumeClient := metadata.Client.NetApp.VolumeClient
+		id, err := volumes.ParseVolumeID(pointer.From(item.Id))
+		if err != nil {
+			return []netAppModels.NetAppVolumeGroupOracleVolume{}, err
+		}
+
+		standaloneVol, err := volumeClient.Get(ctx, pointer.From(id))
+		if err != nil {
+			return []netAppModels.NetAppVolumeGroupOracleVolume{}, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+
+		if standaloneVol.Model.Properties.DataProtection != nil && standaloneVol.Model.Properties.DataProtection.Snapshot != nil {
+			volumeGroupVolume.DataProtectionSnapshotPolicy = flattenNetAppVolumeGroupVolumesDPSnapshotPolicy(standaloneVol.Model.Properties.DataProtection.Snapshot)
+		}
+
+		volumeGroupVolume.Id = pointer.From(standaloneVol.Model.Id)
+
+		results = append(results, volumeGroupVolume)
+	}
+
+	return results, nil
+}
+
 func flattenNetAppVolumeGroupVolumesExportPolicies(input *[]volumegroups.ExportPolicyRule) []netAppModels.ExportPolicyRule {
 	results := make([]netAppModels.ExportPolicyRule, 0)
 
@@ -507,6 +621,20 @@ func deleteVolume(ctx context.Context, metadata sdk.ResourceMetaData, volumeId s
 	return nil
 }
 
+// netAppVolumeGroupVolumesValuesKnown returns whether the given keys are known for every volume of a volume group at plan time,
+// values such as the Proximity Placement Group are commonly created alongside the volume group and are only known during apply
+func netAppVolumeGroupVolumesValuesKnown(metadata sdk.ResourceMetaData, volumeCount int, keys ...string) bool {
+	for i := 0; i < volumeCount; i++ {
+		for _, key := range keys {
+			if !metadata.ResourceDiff.NewValueKnown(fmt.Sprintf("volume.%d.%s", i, key)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 func waitForVolumeCreateOrUpdate(ctx context.Context, client *volumes.VolumesClient, id volumes.VolumeId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -62,6 +62,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		NetAppVolumeGroupSapHanaResource{},
+		NetAppVolumeGroupOracleResource{},
 		NetAppVolumeQuotaRuleResource{},
 		NetAppAccountEncryptionResource{},
 	}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VolumeSpecNameOracle string
+
+const (
+	VolumeSpecNameOracleData1     VolumeSpecNameOracle = "ora-data1"
+	VolumeSpecNameOracleData2     VolumeSpecNameOracle = "ora-data2"
+	VolumeSpecNameOracleData3     VolumeSpecNameOracle = "ora-data3"
+	VolumeSpecNameOracleData4     VolumeSpecNameOracle = "ora-data4"
+	VolumeSpecNameOracleData5     VolumeSpecNameOracle = "ora-data5"
+	VolumeSpecNameOracleData6     VolumeSpecNameOracle = "ora-data6"
+	VolumeSpecNameOracleData7     VolumeSpecNameOracle = "ora-data7"
+	VolumeSpecNameOracleData8     VolumeSpecNameOracle = "ora-data8"
+	VolumeSpecNameOracleLog       VolumeSpecNameOracle = "ora-log"
+	VolumeSpecNameOracleLogMirror VolumeSpecNameOracle = "ora-log-mirror"
+	VolumeSpecNameOracleBinary    VolumeSpecNameOracle = "ora-binary"
+	VolumeSpecNameOracleBackup    VolumeSpecNameOracle = "ora-backup"
+)
+
+func PossibleValuesForVolumeSpecNameOracle() []string {
+	return []string{
+		string(VolumeSpecNameOracleData1),
+		string(VolumeSpecNameOracleData2),
+		string(VolumeSpecNameOracleData3),
+		string(VolumeSpecNameOracleData4),
+		string(VolumeSpecNameOracleData5),
+		string(VolumeSpecNameOracleData6),
+		string(VolumeSpecNameOracleData7),
+		string(VolumeSpecNameOracleData8),
+		string(VolumeSpecNameOracleLog),
+		string(VolumeSpecNameOracleLogMirror),
+		string(VolumeSpecNameOracleBinary),
+		string(VolumeSpecNameOracleBackup),
+	}
+}
+
+func RequiredVolumesForOracle() []string {
+	return []string{
+		string(VolumeSpecNameOracleData1),
+		string(VolumeSpecNameOracleLog),
+	}
+}
+
+func PossibleValuesForProtocolTypeVolumeGroupOracle() []string {
+	return []string{
+		string(ProtocolTypeNfsV41),
+		string(ProtocolTypeNfsV3),
+	}
+}
+
+func ValidateNetAppVolumeGroupOracleVolumes(volumeList *[]volumegroups.VolumeGroupVolumeProperties) []error {
+	errors := make([]error, 0)
+	volumeSpecRepeatCount := make(map[string]int)
+	applicationType := string(volumegroups.ApplicationTypeORACLE)
+	zone := ""
+
+	// Validating minimum volume count
+	if len(*volumeList) < len(RequiredVolumesForOracle()) {
+		errors = append(errors, fmt.Errorf("'minimum %v volumes are required for %v'", len(RequiredVolumesForOracle()), applicationType))
+	}
+
+	// Validating each volume
+	for _, volume := range pointer.From(volumeList) {
+
+		// Get protocol list
+		protocolTypeList := pointer.From(volume.Properties.ProtocolTypes)
+		protocolType := ""
+
+		// Validate protocol list is not empty
+		if len(protocolTypeList) == 0 {
+			errors = append(errors, fmt.Errorf("'protocol type list cannot be empty'"))
+		}
+
+		// Validate protocol list is not > 1
+		if len(protocolTypeList) > 1 {
+			errors = append(errors, fmt.Errorf("'multi-protocol volumes are not supported, protocol count is %v'", len(protocolTypeList)))
+		}
+
+		// Getting protocol for next validations
+		if len(protocolTypeList) > 0 {
+			protocolType = protocolTypeList[0]
+		}
+
+		// Validate that protocol is valid for Oracle
+		if !findStringInSlice(PossibleValuesForProtocolTypeVolumeGroupOracle(), protocolType) {
+			errors = append(errors, fmt.Errorf("'protocol %v is invalid for Oracle'", protocolType))
+		}
+
+		// Validating export policies
+		if volume.Properties.ExportPolicy != nil {
+			for _, rule := range pointer.From(volume.Properties.ExportPolicy.Rules) {
+				errors = append(errors, ValidateNetAppVolumeGroupExportPolicyRule(rule, protocolType)...)
+			}
+		}
+
+		// Validating that a volume is not pinned to both an availability zone and a proximity placement group
+		volumeZones := pointer.From(volume.Zones)
+		if len(volumeZones) > 0 && utils.NormalizeNilableString(volume.Properties.ProximityPlacementGroup) != "" {
+			errors = append(errors, fmt.Errorf("'zone and proximity placement group cannot be defined at the same time for %v on volume %v'", applicationType, pointer.From(volume.Name)))
+		}
+
+		// Validating that all volumes are placed in the same availability zone
+		if len(volumeZones) > 0 {
+			if zone == "" {
+				zone = volumeZones[0]
+			} else if !strings.EqualFold(zone, volumeZones[0]) {
+				errors = append(errors, fmt.Errorf("'all volumes must be in the same zone for %v, volume %v is in zone %v but expected zone %v'", applicationType, pointer.From(volume.Name), volumeZones[0], zone))
+			}
+		}
+
+		// Adding volume spec name to hashmap for post volume loop check
+		volumeSpecRepeatCount[pointer.From(volume.Properties.VolumeSpecName)] += 1
+	}
+
+	// Validating that all volumes are placed in the same proximity placement group
+	errors = append(errors, validateNetAppVolumeGroupProximityPlacementGroup(volumeList, applicationType)...)
+
+	// Validating required volume spec types
+	for _, requiredVolumeSpec := range RequiredVolumesForOracle() {
+		if _, ok := volumeSpecRepeatCount[requiredVolumeSpec]; !ok {
+			errors = append(errors, fmt.Errorf("'required volume spec type %v is not present for %v'", requiredVolumeSpec, applicationType))
+		}
+	}
+
+	// Validating that volume spec does not repeat
+	for volumeSpecName, count := range volumeSpecRepeatCount {
+		if count > 1 {
+			errors = append(errors, fmt.Errorf("'volume spec type %v cannot be repeated for %v'", volumeSpecName, applicationType))
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,495 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
+)
+
+func TestValidateNetAppVolumeGroupOracleVolumes(t *testing.T) {
+	cases := []struct {
+		Name        string
+		VolumesData []volumegroups.VolumeGroupVolumeProperties
+		Errors      int
+	}{
+		{
+			Name: "ValidateCorrectSettingsWithProximityPlacementGroup",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+				{ // VolumeSpecNameOracleBinary
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleBinary))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleBinary)),
+					},
+				},
+			},
+			Errors: 0,
+		},
+		{
+			Name: "ValidateCorrectSettingsWithZone",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name:  pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Zones: pointer.To(zones.Schema{"1"}),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name:  pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Zones: pointer.To(zones.Schema{"1"}),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+				{ // VolumeSpecNameOracleBinary
+					Name:  pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleBinary))),
+					Zones: pointer.To(zones.Schema{"1"}),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleBinary)),
+					},
+				},
+			},
+			Errors: 0,
+		},
+		{
+			Name: "ValidateCorrectSettingsNfsv3",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(true),
+									Nfsv41: pointer.To(false),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv3"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(true),
+									Nfsv41: pointer.To(false),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv3"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 0,
+		},
+		{
+			Name: "ValidateLessThanMinimumVolumes",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+			},
+			Errors: 2,
+		},
+		{
+			Name: "ValidateRequiredVolumeSpecs",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleBinary
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleBinary))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleBinary)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+		{
+			Name: "ValidateCIFSInvalidProtocolForOracle",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"CIFS"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+		{
+			Name: "ValidateExportPolicyMatchesProtocol",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv3"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+		{
+			Name: "ValidateZoneAndPPGCannotBeSetTogether",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name:  pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Zones: pointer.To(zones.Schema{"1"}),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+		{
+			Name: "ValidateZoneMustMatch",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name:  pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Zones: pointer.To(zones.Schema{"1"}),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name:  pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Zones: pointer.To(zones.Schema{"2"}),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+		{
+			Name: "ValidateProximityPlacementGroupMustMatch",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg1"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:           pointer.To([]string{"NFSv4.1"}),
+						ProximityPlacementGroup: pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/proximityPlacementGroups/ppg2"),
+						SecurityStyle:           pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName:          pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+		{
+			Name: "ValidateVolumeSpecCantRepeat",
+			VolumesData: []volumegroups.VolumeGroupVolumeProperties{
+				{ // VolumeSpecNameOracleData1
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleData1))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleData1)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+				{ // VolumeSpecNameOracleLog
+					Name: pointer.To(fmt.Sprintf("volume-%v", string(VolumeSpecNameOracleLog))),
+					Properties: volumegroups.VolumeProperties{
+						ExportPolicy: &volumegroups.VolumePropertiesExportPolicy{
+							Rules: &[]volumegroups.ExportPolicyRule{
+								{
+									Nfsv3:  pointer.To(false),
+									Nfsv41: pointer.To(true),
+								},
+							},
+						},
+						ProtocolTypes:  pointer.To([]string{"NFSv4.1"}),
+						SecurityStyle:  pointer.To(volumegroups.SecurityStyleUnix),
+						VolumeSpecName: pointer.To(string(VolumeSpecNameOracleLog)),
+					},
+				},
+			},
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			errors := ValidateNetAppVolumeGroupOracleVolumes(pointer.To(tc.VolumesData))
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("expected ValidateNetAppVolumeGroupOracleVolumes to return %d error(s) not %d\nError List: \n%v", tc.Errors, len(errors), errors)
+			}
+		})
+	}
+}
@@ -12,6 +12,12 @@ import (
 )
 
 func ValidateNetAppVolumeGroupExportPolicyRuleSAPHanna(rule volumegroups.ExportPolicyRule, protocolType string) []error {
+	return ValidateNetAppVolumeGroupExportPolicyRule(rule, protocolType)
+}
+
+// ValidateNetAppVolumeGroupExportPolicyRule validates an export policy rule against the protocol of the volume
+// it belongs to, the same rules apply to every application volume group type
+func ValidateNetAppVolumeGroupExportPolicyRule(rule volumegroups.ExportPolicyRule, protocolType string) []error {
 	errors := make([]error, 0)
 
 	// Validating that nfsv3 and nfsv4.1 are not enabled in the same rule
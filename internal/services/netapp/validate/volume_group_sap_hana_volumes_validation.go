@@ -160,6 +160,9 @@ func ValidateNetAppVolumeGroupSAPHanaVolumes(volumeList *[]volumegroups.VolumeGr
 		volumeSpecRepeatCount[pointer.From(volume.Properties.VolumeSpecName)] += 1
 	}
 
+	// Validating that all volumes are placed in the same proximity placement group
+	errors = append(errors, validateNetAppVolumeGroupProximityPlacementGroup(volumeList, applicationType)...)
+
 	// Validating required volume spec types
 	for _, requiredVolumeSpec := range RequiredVolumesForSAPHANA() {
 		if _, ok := volumeSpecRepeatCount[requiredVolumeSpec]; !ok {
@@ -177,6 +180,29 @@ func ValidateNetAppVolumeGroupSAPHanaVolumes(volumeList *[]volumegroups.VolumeGr
 	return errors
 }
 
+func validateNetAppVolumeGroupProximityPlacementGroup(volumeList *[]volumegroups.VolumeGroupVolumeProperties, applicationType string) []error {
+	errors := make([]error, 0)
+	proximityPlacementGroupId := ""
+
+	for _, volume := range pointer.From(volumeList) {
+		volumeProximityPlacementGroupId := utils.NormalizeNilableString(volume.Properties.ProximityPlacementGroup)
+		if volumeProximityPlacementGroupId == "" {
+			continue
+		}
+
+		if proximityPlacementGroupId == "" {
+			proximityPlacementGroupId = volumeProximityPlacementGroupId
+			continue
+		}
+
+		if !strings.EqualFold(proximityPlacementGroupId, volumeProximityPlacementGroupId) {
+			errors = append(errors, fmt.Errorf("'all volumes must be in the same proximity placement group for %v, volume %v is in %v but expected %v'", applicationType, pointer.From(volume.Name), volumeProximityPlacementGroupId, proximityPlacementGroupId))
+		}
+	}
+
+	return errors
+}
+
 func findStringInSlice(slice []string, val string) bool {
 	for _, item := range slice {
 		if strings.EqualFold(item, val) {
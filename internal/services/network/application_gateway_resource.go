@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -32,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	dataplane "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
 )
 
 func base64EncodedStateFunc(v interface{}) string {
@@ -1511,6 +1515,20 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 				ValidateFunc: webapplicationfirewallpolicies.ValidateApplicationGatewayWebApplicationFirewallPolicyID,
 			},
 
+			"key_vault_secret_version_tracking_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"ssl_certificate_key_vault_secret_versions": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"custom_error_configuration": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1979,6 +1997,10 @@ func resourceApplicationGatewayUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	// updating the Application Gateway causes any versionless Key Vault Secrets to be retrieved again, as such
+	// the previously tracked versions are cleared so that the latest versions are looked up during the Read
+	d.Set("ssl_certificate_key_vault_secret_versions", map[string]interface{}{})
+
 	d.SetId(id.ID())
 	return resourceApplicationGatewayRead(d, meta)
 }
@@ -2121,6 +2143,18 @@ func resourceApplicationGatewayRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `ssl_certificate`: %+v", setErr)
 			}
 
+			secretVersions := make(map[string]interface{})
+			if d.Get("key_vault_secret_version_tracking_enabled").(bool) {
+				existingVersions := d.Get("ssl_certificate_key_vault_secret_versions").(map[string]interface{})
+				secretVersions, err = flattenApplicationGatewaySslCertificateKeyVaultSecretVersions(ctx, meta.(*clients.Client).KeyVault.ManagementClient, meta.(*clients.Client).ReadLimiter, props.SslCertificates, existingVersions)
+				if err != nil {
+					return fmt.Errorf("flattening `ssl_certificate_key_vault_secret_versions`: %+v", err)
+				}
+			}
+			if setErr := d.Set("ssl_certificate_key_vault_secret_versions", secretVersions); setErr != nil {
+				return fmt.Errorf("setting `ssl_certificate_key_vault_secret_versions`: %+v", setErr)
+			}
+
 			if setErr := d.Set("trusted_client_certificate", flattenApplicationGatewayTrustedClientCertificates(props.TrustedClientCertificates)); setErr != nil {
 				return fmt.Errorf("setting `trusted_client_certificate`: %+v", setErr)
 			}
@@ -4070,6 +4104,129 @@ func flattenApplicationGatewaySslCertificates(input *[]applicationgateways.Appli
 	return results
 }
 
+// flattenApplicationGatewaySslCertificateKeyVaultSecretVersions returns the version of the Key Vault Secret used by each
+// SSL Certificate. Since the Application Gateway only retrieves a versionless Key Vault Secret when it's created/updated
+// (or periodically thereafter) any previously tracked version is kept, otherwise the latest version is looked up - with
+// the lookups for each SSL Certificate made concurrently.
+func flattenApplicationGatewaySslCertificateKeyVaultSecretVersions(ctx context.Context, client *dataplane.BaseClient, limiter *common.ReadLimiter, input *[]applicationgateways.ApplicationGatewaySslCertificate, existing map[string]interface{}) (map[string]interface{}, error) {
+	results := make(map[string]interface{})
+	if input == nil {
+		return results, nil
+	}
+
+	var lock sync.Mutex
+	lookups := make([]func(ctx context.Context) error, 0)
+
+	for _, v := range *input {
+		if v.Name == nil || v.Properties == nil || v.Properties.KeyVaultSecretId == nil {
+			continue
+		}
+		name := *v.Name
+
+		secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(*v.Properties.KeyVaultSecretId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `key_vault_secret_id` for the `ssl_certificate` block %q: %+v", name, err)
+		}
+
+		if secretId.Version != "" {
+			results[name] = secretId.Version
+			continue
+		}
+
+		if version, ok := existing[name].(string); ok && version != "" {
+			results[name] = version
+			continue
+		}
+
+		lookups = append(lookups, func(ctx context.Context) error {
+			version, err := latestApplicationGatewayKeyVaultSecretVersion(ctx, client, *secretId)
+			if err != nil {
+				return err
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+			results[name] = version
+			return nil
+		})
+	}
+
+	if err := limiter.Run(ctx, lookups...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// applicationGatewayKeyVaultSecretVersionsChanged returns whether a newer version exists for any versionless Key Vault
+// Secret referenced by the `ssl_certificate` blocks than the version which was retrieved by the Application Gateway
+func applicationGatewayKeyVaultSecretVersionsChanged(ctx context.Context, client *dataplane.BaseClient, sslCertificates []interface{}, existing map[string]interface{}) (bool, error) {
+	for _, raw := range sslCertificates {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		kvsid := v["key_vault_secret_id"].(string)
+		if kvsid == "" {
+			continue
+		}
+
+		secretId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(kvsid)
+		if err != nil {
+			return false, fmt.Errorf("parsing `key_vault_secret_id` for the `ssl_certificate` block %q: %+v", name, err)
+		}
+		if secretId.Version != "" {
+			continue
+		}
+
+		version, err := latestApplicationGatewayKeyVaultSecretVersion(ctx, client, *secretId)
+		if err != nil {
+			return false, err
+		}
+
+		if existingVersion, ok := existing[name].(string); !ok || !strings.EqualFold(existingVersion, version) {
+			log.Printf("[DEBUG] Key Vault Secret %q used by the `ssl_certificate` block %q has a new version %q", secretId.VersionlessID(), name, version)
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// latestApplicationGatewayKeyVaultSecretVersion returns the latest (most recently created) version of the Key Vault Secret,
+// which is determined by listing the metadata of each version - rather than retrieving the Secret, which includes its value
+func latestApplicationGatewayKeyVaultSecretVersion(ctx context.Context, client *dataplane.BaseClient, id keyVaultParse.NestedItemId) (string, error) {
+	iterator, err := client.GetSecretVersionsComplete(ctx, id.KeyVaultBaseUrl, id.Name, nil)
+	if err != nil {
+		return "", fmt.Errorf("listing the versions of Key Vault Secret %q: %+v", id.VersionlessID(), err)
+	}
+
+	latestVersion := ""
+	var latestCreated time.Time
+	for iterator.NotDone() {
+		item := iterator.Value()
+		if item.ID != nil && item.Attributes != nil && item.Attributes.Created != nil {
+			if created := time.Time(*item.Attributes.Created); latestVersion == "" || created.After(latestCreated) {
+				version, err := keyVaultParse.ParseNestedItemID(*item.ID)
+				if err != nil {
+					return "", err
+				}
+				latestVersion = version.Version
+				latestCreated = created
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return "", fmt.Errorf("listing the versions of Key Vault Secret %q: %+v", id.VersionlessID(), err)
+		}
+	}
+
+	if latestVersion == "" {
+		return "", fmt.Errorf("listing the versions of Key Vault Secret %q: no versions were found", id.VersionlessID())
+	}
+
+	return latestVersion, nil
+}
+
 func expandApplicationGatewayTrustedClientCertificates(d *pluginsdk.ResourceData) (*[]applicationgateways.ApplicationGatewayTrustedClientCertificate, error) {
 	vs := d.Get("trusted_client_certificate").([]interface{})
 	results := make([]applicationgateways.ApplicationGatewayTrustedClientCertificate, 0)
@@ -4707,11 +4864,41 @@ func checkSslPolicy(sslPolicy []interface{}) error {
 		if len(disabledProtocols) > 0 && policyType != "" {
 			return fmt.Errorf("setting disabled_protocols is not allowed when policy_type is defined")
 		}
+
+		if policyName := v["policy_name"].(string); policyName != "" && policyType != "" && policyType != string(applicationgateways.ApplicationGatewaySslPolicyTypePredefined) {
+			return fmt.Errorf("`policy_name` can only be set when `policy_type` is `%s`", applicationgateways.ApplicationGatewaySslPolicyTypePredefined)
+		}
+
+		minProtocolVersion := v["min_protocol_version"].(string)
+		cipherSuites := v["cipher_suites"].([]interface{})
+
+		// TLS 1.3 is only supported by `CustomV2` policies, where the TLS 1.3 cipher suites are fixed by the service
+		if minProtocolVersion == string(applicationgateways.ApplicationGatewaySslProtocolTLSvOneThree) {
+			if policyType == string(applicationgateways.ApplicationGatewaySslPolicyTypeCustom) {
+				return fmt.Errorf("a `min_protocol_version` of `%s` requires a `policy_type` of `%s`", minProtocolVersion, applicationgateways.ApplicationGatewaySslPolicyTypeCustomVTwo)
+			}
+			if policyType == string(applicationgateways.ApplicationGatewaySslPolicyTypeCustomVTwo) && len(cipherSuites) > 0 {
+				return fmt.Errorf("`cipher_suites` cannot be set when `min_protocol_version` is `%s` since the TLS 1.3 cipher suites aren't configurable", minProtocolVersion)
+			}
+		}
+
+		// the order of the cipher suites is the order of preference, so each can only be listed once
+		seen := make(map[string]struct{})
+		for _, cipherSuite := range cipherSuites {
+			name, ok := cipherSuite.(string)
+			if !ok || name == "" {
+				continue
+			}
+			if _, exists := seen[name]; exists {
+				return fmt.Errorf("the cipher suite %q is specified more than once in `cipher_suites`", name)
+			}
+			seen[name] = struct{}{}
+		}
 	}
 	return nil
 }
 
-func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
 	_, hasAutoscaleConfig := d.GetOk("autoscale_configuration.0")
 	capacity, hasCapacity := d.GetOk("sku.0.capacity")
 	tier := d.Get("sku.0.tier").(string)
@@ -4750,6 +4937,24 @@ func applicationGatewayCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceD
 		}
 	}
 
+	if d.Id() != "" && d.Get("key_vault_secret_version_tracking_enabled").(bool) {
+		if d.HasChanges("ssl_certificate", "key_vault_secret_version_tracking_enabled") {
+			return d.SetNewComputed("ssl_certificate_key_vault_secret_versions")
+		}
+
+		// when a newer version of a Key Vault Secret exists an update is planned, which causes the Application Gateway to retrieve it.
+		// only the metadata of the versions is listed here - the certificate itself is retrieved by the Application Gateway during apply
+		client := meta.(*clients.Client).KeyVault.ManagementClient
+		existing := d.Get("ssl_certificate_key_vault_secret_versions").(map[string]interface{})
+		changed, err := applicationGatewayKeyVaultSecretVersionsChanged(ctx, client, d.Get("ssl_certificate").(*pluginsdk.Set).List(), existing)
+		if err != nil {
+			return err
+		}
+		if changed {
+			return d.SetNewComputed("ssl_certificate_key_vault_secret_versions")
+		}
+	}
+
 	return nil
 }
 
@@ -49,6 +49,16 @@ func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			// there's no API to regenerate the Authorization Key, so it's regenerated by re-creating the Authorization
+			"key_regeneration_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"authorization_key": {
 				Type:      pluginsdk.TypeString,
 				Computed:  true,
@@ -70,6 +70,30 @@ func testAccExpressRouteCircuitAuthorization_multiple(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteCircuitAuthorization_regenerateKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_authorization", "test")
+	r := ExpressRouteCircuitAuthorizationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyRegenerationTriggersConfig(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authorization_key").Exists(),
+			),
+		},
+		data.ImportStep("key_regeneration_triggers"),
+		{
+			Config: r.keyRegenerationTriggersConfig(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authorization_key").Exists(),
+			),
+		},
+		data.ImportStep("key_regeneration_triggers"),
+	})
+}
+
 func (t ExpressRouteCircuitAuthorizationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := expressroutecircuitauthorizations.ParseAuthorizationID(state.ID)
 	if err != nil {
@@ -124,6 +148,45 @@ resource "azurerm_express_route_circuit_authorization" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ExpressRouteCircuitAuthorizationResource) keyRegenerationTriggersConfig(data acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+
+  allow_classic_operations = false
+}
+
+resource "azurerm_express_route_circuit_authorization" "test" {
+  name                       = "acctestauth%[1]d"
+  express_route_circuit_name = azurerm_express_route_circuit.test.name
+  resource_group_name        = azurerm_resource_group.test.name
+
+  key_regeneration_triggers = {
+    rotation = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, rotation)
+}
+
 func (r ExpressRouteCircuitAuthorizationResource) requiresImportConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
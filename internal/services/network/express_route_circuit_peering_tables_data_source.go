@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/expressroutecircuitarptable"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/expressroutecircuitroutestable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ExpressRouteCircuitPeeringTablesDataSource struct{}
+
+var _ sdk.DataSource = ExpressRouteCircuitPeeringTablesDataSource{}
+
+type ExpressRouteCircuitPeeringTablesDataSourceModel struct {
+	ExpressRouteCircuitPeeringId string                                      `tfschema:"express_route_circuit_peering_id"`
+	DevicePath                   string                                      `tfschema:"device_path"`
+	ArpTable                     []ExpressRouteCircuitPeeringArpTableModel   `tfschema:"arp_table"`
+	RouteTable                   []ExpressRouteCircuitPeeringRouteTableModel `tfschema:"route_table"`
+}
+
+type ExpressRouteCircuitPeeringArpTableModel struct {
+	Age        int64  `tfschema:"age"`
+	Interface  string `tfschema:"interface"`
+	IPAddress  string `tfschema:"ip_address"`
+	MacAddress string `tfschema:"mac_address"`
+}
+
+type ExpressRouteCircuitPeeringRouteTableModel struct {
+	Network         string `tfschema:"network"`
+	NextHop         string `tfschema:"next_hop"`
+	LocalPreference string `tfschema:"local_preference"`
+	Weight          int64  `tfschema:"weight"`
+	Path            string `tfschema:"path"`
+}
+
+func (ExpressRouteCircuitPeeringTablesDataSource) ResourceType() string {
+	return "azurerm_express_route_circuit_peering_tables"
+}
+
+func (ExpressRouteCircuitPeeringTablesDataSource) ModelObject() interface{} {
+	return &ExpressRouteCircuitPeeringTablesDataSourceModel{}
+}
+
+func (ExpressRouteCircuitPeeringTablesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"express_route_circuit_peering_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateExpressRouteCircuitPeeringID,
+		},
+
+		"device_path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "primary",
+			ValidateFunc: validation.StringInSlice([]string{
+				"primary",
+				"secondary",
+			}, false),
+		},
+	}
+}
+
+func (ExpressRouteCircuitPeeringTablesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arp_table": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"age": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"interface": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"mac_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"route_table": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"next_hop": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"local_preference": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"weight": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"path": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ExpressRouteCircuitPeeringTablesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			arpTableClient := metadata.Client.Network.ExpressRouteCircuitArpTable
+			routesTableClient := metadata.Client.Network.ExpressRouteCircuitRoutesTable
+
+			var state ExpressRouteCircuitPeeringTablesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseExpressRouteCircuitPeeringID(state.ExpressRouteCircuitPeeringId)
+			if err != nil {
+				return err
+			}
+
+			arpTableId := expressroutecircuitarptable.NewArpTableID(id.SubscriptionId, id.ResourceGroupName, id.CircuitName, id.PeeringName, state.DevicePath)
+			arpTableResp, err := arpTableClient.ExpressRouteCircuitsListArpTable(ctx, arpTableId)
+			if err != nil {
+				return fmt.Errorf("listing the ARP Table for %s: %+v", arpTableId, err)
+			}
+
+			var arpTable expressRouteCircuitArpTableListResult
+			if err := expressRouteCircuitPeeringTableResult(ctx, arpTableClient.Client, arpTableResp.HttpResponse, arpTableResp.Poller, &arpTable); err != nil {
+				return fmt.Errorf("retrieving the ARP Table for %s: %+v", arpTableId, err)
+			}
+
+			state.ArpTable = make([]ExpressRouteCircuitPeeringArpTableModel, 0)
+			for _, v := range pointer.From(arpTable.Value) {
+				state.ArpTable = append(state.ArpTable, ExpressRouteCircuitPeeringArpTableModel{
+					Age:        pointer.From(v.Age),
+					Interface:  pointer.From(v.Interface),
+					IPAddress:  pointer.From(v.IPAddress),
+					MacAddress: pointer.From(v.MacAddress),
+				})
+			}
+
+			routeTableId := expressroutecircuitroutestable.NewPeeringRouteTableID(id.SubscriptionId, id.ResourceGroupName, id.CircuitName, id.PeeringName, state.DevicePath)
+			routeTableResp, err := routesTableClient.ExpressRouteCircuitsListRoutesTable(ctx, routeTableId)
+			if err != nil {
+				return fmt.Errorf("listing the Route Table for %s: %+v", routeTableId, err)
+			}
+
+			var routeTable expressRouteCircuitRoutesTableListResult
+			if err := expressRouteCircuitPeeringTableResult(ctx, routesTableClient.Client, routeTableResp.HttpResponse, routeTableResp.Poller, &routeTable); err != nil {
+				return fmt.Errorf("retrieving the Route Table for %s: %+v", routeTableId, err)
+			}
+
+			state.RouteTable = make([]ExpressRouteCircuitPeeringRouteTableModel, 0)
+			for _, v := range pointer.From(routeTable.Value) {
+				state.RouteTable = append(state.RouteTable, ExpressRouteCircuitPeeringRouteTableModel{
+					Network:         pointer.From(v.Network),
+					NextHop:         pointer.From(v.NextHop),
+					LocalPreference: pointer.From(v.LocPrf),
+					Weight:          pointer.From(v.Weight),
+					Path:            pointer.From(v.Path),
+				})
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+type expressRouteCircuitArpTableListResult struct {
+	Value *[]expressroutecircuitarptable.ExpressRouteCircuitArpTable `json:"value,omitempty"`
+}
+
+type expressRouteCircuitRoutesTableListResult struct {
+	Value *[]expressroutecircuitroutestable.ExpressRouteCircuitRoutesTable `json:"value,omitempty"`
+}
+
+// expressRouteCircuitPeeringTableResult unmarshals the result of listing an ARP or Route Table into `model`. These are
+// long-running operations where the result is made available from the `Location` header of the initial response once
+// the operation has completed, which the SDK doesn't expose - so we retrieve it ourselves.
+func expressRouteCircuitPeeringTableResult(ctx context.Context, c *resourcemanager.Client, initial *http.Response, poller pollers.Poller, model interface{}) error {
+	if initial == nil {
+		return fmt.Errorf("no HTTP Response was returned")
+	}
+
+	if initial.StatusCode != http.StatusAccepted {
+		return (&client.Response{Response: initial}).Unmarshal(model)
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling: %+v", err)
+	}
+
+	location := initial.Header.Get("Location")
+	if location == "" {
+		return fmt.Errorf("the `Location` header was not returned")
+	}
+	locationUrl, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("parsing the `Location` header %q: %+v", location, err)
+	}
+
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       locationUrl.Path,
+	})
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	req.URL.RawQuery = locationUrl.RawQuery
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("retrieving result: %+v", err)
+	}
+
+	return resp.Unmarshal(model)
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteCircuitPeeringTablesDataSource struct{}
+
+func testAccDataSourceExpressRouteCircuitPeeringTables_privatePeering(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_peering_tables", "test")
+	d := ExpressRouteCircuitPeeringTablesDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.privatePeering(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("device_path").HasValue("primary"),
+				check.That(data.ResourceName).Key("arp_table.#").Exists(),
+				check.That(data.ResourceName).Key("route_table.#").Exists(),
+			),
+		},
+	})
+}
+
+func (d ExpressRouteCircuitPeeringTablesDataSource) privatePeering(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_peering_tables" "test" {
+  express_route_circuit_peering_id = azurerm_express_route_circuit_peering.test.id
+}
+`, ExpressRouteCircuitPeeringResource{}.privatePeering(data))
+}
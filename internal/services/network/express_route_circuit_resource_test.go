@@ -41,6 +41,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 		"PrivatePeering": {
 			"azurePrivatePeering":           testAccExpressRouteCircuitPeering_azurePrivatePeering,
 			"azurePrivatePeeringDataSource": testAccDataSourceExpressRouteCircuitPeering_privatePeering,
+			"azurePrivatePeeringTables":     testAccDataSourceExpressRouteCircuitPeeringTables_privatePeering,
 			"azurePrivatePeeringWithUpdate": testAccExpressRouteCircuitPeering_azurePrivatePeeringWithCircuitUpdate,
 			"requiresImport":                testAccExpressRouteCircuitPeering_requiresImport,
 		},
@@ -56,6 +57,7 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"basic":          testAccExpressRouteCircuitAuthorization_basic,
 			"multiple":       testAccExpressRouteCircuitAuthorization_multiple,
 			"requiresImport": testAccExpressRouteCircuitAuthorization_requiresImport,
+			"regenerateKey":  testAccExpressRouteCircuitAuthorization_regenerateKey,
 		},
 	}
 
@@ -5,9 +5,11 @@ package network
 
 import (
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/ipgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -73,9 +75,7 @@ func resourceIpGroupCidrCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	existing, err := client.Get(ctx, *ipGroupId, ipgroups.DefaultGetOperationOptions())
 	if err != nil {
-		if response.WasNotFound(existing.HttpResponse) {
-			return fmt.Errorf("checking for presence of existing %s: %s", ipGroupId, err)
-		}
+		return fmt.Errorf("retrieving %s: %+v", ipGroupId, err)
 	}
 
 	if existing.Model == nil {
@@ -85,14 +85,10 @@ func resourceIpGroupCidrCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("retrieving %s: `properties` was nil", ipGroupId)
 	}
 
-	if utils.SliceContainsValue(*existing.Model.Properties.IPAddresses, cidr) {
+	ipAddresses := pointer.From(existing.Model.Properties.IPAddresses)
+	if utils.SliceContainsValue(ipAddresses, cidr) {
 		return tf.ImportAsExistsError("azurerm_ip_group_cidr", id.ID())
 	}
-
-	ipAddresses := make([]string, 0)
-	if existing.Model.Properties.IPAddresses != nil {
-		ipAddresses = *existing.Model.Properties.IPAddresses
-	}
 	ipAddresses = append(ipAddresses, cidr)
 
 	params := ipgroups.IPGroup{
@@ -128,18 +124,25 @@ func resourceIpGroupCidrRead(d *pluginsdk.ResourceData, meta interface{}) error
 	resp, err := client.Get(ctx, ipGroupId, ipgroups.DefaultGetOperationOptions())
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
-			return fmt.Errorf("retrieving %s: %+v", ipGroupId, err)
-		}
-		if resp.Model == nil {
-			return fmt.Errorf("retrieving %s: `model` was nil", ipGroupId)
-		}
-		if resp.Model.Properties == nil {
-			return fmt.Errorf("retrieving %s: `properties` was nil", ipGroupId)
-		}
-		if !utils.SliceContainsValue(*resp.Model.Properties.IPAddresses, cidr) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", ipGroupId, id)
 			d.SetId("")
 			return nil
 		}
+		return fmt.Errorf("retrieving %s: %+v", ipGroupId, err)
+	}
+
+	if resp.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", ipGroupId)
+	}
+	if resp.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", ipGroupId)
+	}
+
+	// the CIDR may have been removed from the IP Group outside of this resource, e.g. by the `cidrs` of the IP Group
+	if !utils.SliceContainsValue(pointer.From(resp.Model.Properties.IPAddresses), cidr) {
+		log.Printf("[DEBUG] %s was not found in %s - removing from state", id, ipGroupId)
+		d.SetId("")
+		return nil
 	}
 
 	d.Set("ip_group_id", ipGroupId.ID())
@@ -158,9 +161,7 @@ func resourceIpGroupCidrDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	// TODO this resource should use a composite resource ID to remove this instance of d.Get() in the Delete
-	// this file can then be removed from the exceptions list in the run-gradually-deprecated.sh script
-	cidr := d.Get("cidr").(string)
+	cidr := strings.ReplaceAll(id.CidrName, "_", "/")
 	ipGroupId := ipgroups.NewIPGroupID(id.SubscriptionId, id.ResourceGroup, id.IpGroupName)
 
 	locks.ByID(ipGroupId.ID())
@@ -169,8 +170,9 @@ func resourceIpGroupCidrDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	existing, err := client.Get(ctx, ipGroupId, ipgroups.DefaultGetOperationOptions())
 	if err != nil {
 		if response.WasNotFound(existing.HttpResponse) {
-			return fmt.Errorf("retrieving %s: %s", ipGroupId, err)
+			return nil
 		}
+		return fmt.Errorf("retrieving %s: %+v", ipGroupId, err)
 	}
 	if existing.Model == nil {
 		return fmt.Errorf("retrieving %s: `model` was nil", ipGroupId)
@@ -179,7 +181,10 @@ func resourceIpGroupCidrDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("retrieving %s: `properties` was nil", ipGroupId)
 	}
 
-	ipAddresses := *existing.Model.Properties.IPAddresses
+	ipAddresses := pointer.From(existing.Model.Properties.IPAddresses)
+	if !utils.SliceContainsValue(ipAddresses, cidr) {
+		return nil
+	}
 	ipAddresses = utils.RemoveFromStringArray(ipAddresses, cidr)
 
 	params := ipgroups.IPGroup{
@@ -195,5 +200,5 @@ func resourceIpGroupCidrDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("updating %s: %+v", ipGroupId.ID(), err)
 	}
 
-	return err
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/ipgroups"
@@ -58,6 +59,17 @@ func TestAccIpGroupCidr_multiple(t *testing.T) {
 	})
 }
 
+func TestAccIpGroupCidr_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ip_group_cidr", "test")
+	r := IPGroupCidrResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config:       r.basic,
+			TestResource: r,
+		}),
+	})
+}
+
 func TestAccIpGroupCidr_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_ip_group_cidr", "test")
 	r := IPGroupCidrResource{}
@@ -102,6 +114,38 @@ func (t IPGroupCidrResource) Exists(ctx context.Context, clients *clients.Client
 	return pointer.To(true), nil
 }
 
+func (IPGroupCidrResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IpGroupCidrID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ipGroupId := ipgroups.NewIPGroupID(id.SubscriptionId, id.ResourceGroup, id.IpGroupName)
+
+	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	defer cancel()
+	resp, err := client.Network.Client.IPGroups.Get(ctx2, ipGroupId, ipgroups.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", ipGroupId, err)
+	}
+
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", ipGroupId)
+	}
+	if resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", ipGroupId)
+	}
+
+	ipAddresses := utils.RemoveFromStringArray(pointer.From(resp.Model.Properties.IPAddresses), state.Attributes["cidr"])
+	resp.Model.Properties.IPAddresses = &ipAddresses
+
+	if err := client.Network.Client.IPGroups.CreateOrUpdateThenPoll(ctx2, ipGroupId, *resp.Model); err != nil {
+		return nil, fmt.Errorf("removing %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
 func (IPGroupCidrResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -47,6 +48,8 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkInterfaceCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -240,6 +243,40 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 	return resource
 }
 
+func resourceNetworkInterfaceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// the auxiliary modes are only supported on Network Interfaces using Accelerated Networking
+	auxiliaryMode := d.Get("auxiliary_mode").(string)
+	if auxiliaryMode == "" || auxiliaryMode == string(networkinterfaces.NetworkInterfaceAuxiliaryModeNone) {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	acceleratedNetworkingKey := "accelerated_networking_enabled"
+	if !features.FourPointOhBeta() && !config.GetAttr("enable_accelerated_networking").IsNull() {
+		acceleratedNetworkingKey = "enable_accelerated_networking"
+	}
+
+	value := config.GetAttr(acceleratedNetworkingKey)
+	if !value.IsKnown() {
+		// this can only be checked during the apply
+		return nil
+	}
+
+	acceleratedNetworkingEnabled := false
+	if !value.IsNull() {
+		acceleratedNetworkingEnabled = value.True()
+	} else if d.NewValueKnown(acceleratedNetworkingKey) {
+		// when not configured, the value from the state is retained
+		acceleratedNetworkingEnabled = d.Get(acceleratedNetworkingKey).(bool)
+	}
+
+	if !acceleratedNetworkingEnabled {
+		return fmt.Errorf("`%s` must be enabled when `auxiliary_mode` is set to `%s`", acceleratedNetworkingKey, auxiliaryMode)
+	}
+
+	return nil
+}
+
 func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.NetworkInterfaces
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -73,6 +74,17 @@ func TestAccNetworkInterface_auxiliary(t *testing.T) {
 	})
 }
 
+func TestAccNetworkInterface_auxiliaryWithoutAcceleratedNetworking(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
+	r := NetworkInterfaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.auxiliaryWithoutAcceleratedNetworking(data),
+			ExpectError: regexp.MustCompile("must be enabled when `auxiliary_mode` is set to `AcceleratedConnections`"),
+		},
+	})
+}
+
 func TestAccNetworkInterface_dnsServers(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_interface", "test")
 	r := NetworkInterfaceResource{}
@@ -504,6 +516,28 @@ resource "azurerm_network_interface" "test" {
 `, r.template(data), data.RandomInteger, data.Locations.Primary)
 }
 
+func (r NetworkInterfaceResource) auxiliaryWithoutAcceleratedNetworking(data acceptance.TestData) string {
+	data.Locations.Primary = "westus"
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "%s"
+  resource_group_name = azurerm_resource_group.test.name
+  auxiliary_mode      = "AcceleratedConnections"
+  auxiliary_sku       = "A2"
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
 func (r NetworkInterfaceResource) withMultipleParameters(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NetworkSecurityGroupRulesDataSource struct{}
+
+var _ sdk.DataSource = NetworkSecurityGroupRulesDataSource{}
+
+type NetworkSecurityGroupRulesDataSourceModel struct {
+	NetworkSecurityGroupId string                          `tfschema:"network_security_group_id"`
+	Direction              string                          `tfschema:"direction"`
+	MinimumPriority        int64                           `tfschema:"minimum_priority"`
+	MaximumPriority        int64                           `tfschema:"maximum_priority"`
+	DestinationPort        int64                           `tfschema:"destination_port"`
+	Rules                  []NetworkSecurityGroupRuleModel `tfschema:"rules"`
+}
+
+type NetworkSecurityGroupRuleModel struct {
+	Id                                     string   `tfschema:"id"`
+	Name                                   string   `tfschema:"name"`
+	Description                            string   `tfschema:"description"`
+	Access                                 string   `tfschema:"access"`
+	Direction                              string   `tfschema:"direction"`
+	Priority                               int64    `tfschema:"priority"`
+	Protocol                               string   `tfschema:"protocol"`
+	SourcePortRanges                       []string `tfschema:"source_port_ranges"`
+	DestinationPortRanges                  []string `tfschema:"destination_port_ranges"`
+	SourceAddressPrefixes                  []string `tfschema:"source_address_prefixes"`
+	DestinationAddressPrefixes             []string `tfschema:"destination_address_prefixes"`
+	SourceApplicationSecurityGroupIds      []string `tfschema:"source_application_security_group_ids"`
+	DestinationApplicationSecurityGroupIds []string `tfschema:"destination_application_security_group_ids"`
+}
+
+func (NetworkSecurityGroupRulesDataSource) ResourceType() string {
+	return "azurerm_network_security_group_rules"
+}
+
+func (NetworkSecurityGroupRulesDataSource) ModelObject() interface{} {
+	return &NetworkSecurityGroupRulesDataSourceModel{}
+}
+
+func (NetworkSecurityGroupRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_security_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networksecuritygroups.ValidateNetworkSecurityGroupID,
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(networksecuritygroups.PossibleValuesForSecurityRuleDirection(), false),
+		},
+
+		"minimum_priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(100, 4096),
+		},
+
+		"maximum_priority": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(100, 4096),
+		},
+
+		"destination_port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+	}
+}
+
+func (NetworkSecurityGroupRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	stringList := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"rules": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"access": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"direction": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"priority": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source_port_ranges": stringList(),
+
+					"destination_port_ranges": stringList(),
+
+					"source_address_prefixes": stringList(),
+
+					"destination_address_prefixes": stringList(),
+
+					"source_application_security_group_ids": stringList(),
+
+					"destination_application_security_group_ids": stringList(),
+				},
+			},
+		},
+	}
+}
+
+func (NetworkSecurityGroupRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityGroups
+
+			var state NetworkSecurityGroupRulesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if state.MinimumPriority != 0 && state.MaximumPriority != 0 && state.MinimumPriority > state.MaximumPriority {
+				return fmt.Errorf("`minimum_priority` (%d) must not be greater than `maximum_priority` (%d)", state.MinimumPriority, state.MaximumPriority)
+			}
+
+			id, err := networksecuritygroups.ParseNetworkSecurityGroupID(state.NetworkSecurityGroupId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, networksecuritygroups.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", *id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			rules := make([]NetworkSecurityGroupRuleModel, 0)
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.SecurityRules != nil {
+				for _, rule := range *model.Properties.SecurityRules {
+					if rule.Properties == nil {
+						continue
+					}
+
+					flattened := flattenNetworkSecurityGroupRuleModel(rule)
+					if !state.matches(flattened) {
+						continue
+					}
+					rules = append(rules, flattened)
+				}
+			}
+
+			sort.Slice(rules, func(i, j int) bool {
+				if rules[i].Direction != rules[j].Direction {
+					return rules[i].Direction < rules[j].Direction
+				}
+				return rules[i].Priority < rules[j].Priority
+			})
+
+			state.NetworkSecurityGroupId = id.ID()
+			state.Rules = rules
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (m NetworkSecurityGroupRulesDataSourceModel) matches(rule NetworkSecurityGroupRuleModel) bool {
+	if m.Direction != "" && !strings.EqualFold(m.Direction, rule.Direction) {
+		return false
+	}
+
+	if m.MinimumPriority != 0 && rule.Priority < m.MinimumPriority {
+		return false
+	}
+
+	if m.MaximumPriority != 0 && rule.Priority > m.MaximumPriority {
+		return false
+	}
+
+	if m.DestinationPort != 0 && !securityRulePortRangesContain(rule.DestinationPortRanges, m.DestinationPort) {
+		return false
+	}
+
+	return true
+}
+
+// securityRulePortRangesContain returns whether the port falls within any of the port ranges of
+// a security rule, which are either `*`, a single port (e.g. `443`) or a range (e.g. `1000-2000`)
+func securityRulePortRangesContain(portRanges []string, port int64) bool {
+	for _, portRange := range portRanges {
+		portRange = strings.TrimSpace(portRange)
+		if portRange == "*" {
+			return true
+		}
+
+		start, end, found := strings.Cut(portRange, "-")
+		if !found {
+			end = start
+		}
+
+		from, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+		if err != nil {
+			continue
+		}
+		to, err := strconv.ParseInt(strings.TrimSpace(end), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if port >= from && port <= to {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenNetworkSecurityGroupRuleModel(input networksecuritygroups.SecurityRule) NetworkSecurityGroupRuleModel {
+	props := input.Properties
+
+	return NetworkSecurityGroupRuleModel{
+		Id:                                     pointer.From(input.Id),
+		Name:                                   pointer.From(input.Name),
+		Description:                            pointer.From(props.Description),
+		Access:                                 string(props.Access),
+		Direction:                              string(props.Direction),
+		Priority:                               props.Priority,
+		Protocol:                               string(props.Protocol),
+		SourcePortRanges:                       mergeSecurityRuleValues(props.SourcePortRange, props.SourcePortRanges),
+		DestinationPortRanges:                  mergeSecurityRuleValues(props.DestinationPortRange, props.DestinationPortRanges),
+		SourceAddressPrefixes:                  mergeSecurityRuleValues(props.SourceAddressPrefix, props.SourceAddressPrefixes),
+		DestinationAddressPrefixes:             mergeSecurityRuleValues(props.DestinationAddressPrefix, props.DestinationAddressPrefixes),
+		SourceApplicationSecurityGroupIds:      flattenSecurityRuleApplicationSecurityGroupIds(props.SourceApplicationSecurityGroups),
+		DestinationApplicationSecurityGroupIds: flattenSecurityRuleApplicationSecurityGroupIds(props.DestinationApplicationSecurityGroups),
+	}
+}
+
+// mergeSecurityRuleValues combines the singular and plural forms of a security rule property,
+// since the API only populates one of them depending on how the rule was defined
+func mergeSecurityRuleValues(single *string, multiple *[]string) []string {
+	result := make([]string, 0)
+	if v := pointer.From(single); v != "" {
+		result = append(result, v)
+	}
+	if multiple != nil {
+		result = append(result, *multiple...)
+	}
+	return result
+}
+
+func flattenSecurityRuleApplicationSecurityGroupIds(input *[]networksecuritygroups.ApplicationSecurityGroup) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, group := range *input {
+		if group.Id != nil {
+			result = append(result, *group.Id)
+		}
+	}
+	return result
+}
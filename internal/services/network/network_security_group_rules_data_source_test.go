@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkSecurityGroupRulesDataSource struct{}
+
+func TestAccDataSourceNetworkSecurityGroupRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_group_rules", "test")
+	r := NetworkSecurityGroupRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rules.#").HasValue("3"),
+				check.That(data.ResourceName).Key("rules.0.name").HasValue("allow-https"),
+				check.That(data.ResourceName).Key("rules.0.destination_port_ranges.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rules.2.name").HasValue("deny-all-outbound"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceNetworkSecurityGroupRules_filtered(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_group_rules", "test")
+	r := NetworkSecurityGroupRulesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.filtered(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("rules.#").HasValue("1"),
+				check.That(data.ResourceName).Key("rules.0.name").HasValue("allow-app-ports"),
+				check.That(data.ResourceName).Key("rules.0.destination_port_ranges.#").HasValue("2"),
+				check.That(data.ResourceName).Key("rules.0.source_address_prefixes.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (NetworkSecurityGroupRulesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_group_rules" "test" {
+  network_security_group_id = azurerm_network_security_group.test.id
+}
+`, NetworkSecurityGroupRulesDataSource{}.template(data))
+}
+
+func (NetworkSecurityGroupRulesDataSource) filtered(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_group_rules" "test" {
+  network_security_group_id = azurerm_network_security_group.test.id
+  direction                 = "Inbound"
+  minimum_priority          = 150
+  maximum_priority          = 300
+  destination_port          = 8081
+}
+`, NetworkSecurityGroupRulesDataSource{}.template(data))
+}
+
+func (NetworkSecurityGroupRulesDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "allow-https"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "allow-app-ports"
+    priority                   = 200
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_ranges    = ["8080-8090", "9000"]
+    source_address_prefixes    = ["10.0.0.0/24", "10.0.1.0/24"]
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "deny-all-outbound"
+    priority                   = 4096
+    direction                  = "Outbound"
+    access                     = "Deny"
+    protocol                   = "*"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -95,7 +95,8 @@ func dataSourceNetworkServiceTagsRead(d *pluginsdk.ResourceData, meta interface{
 				continue
 			}
 
-			if location.NormalizeNilable(props.Region) == locationFilter {
+			// a regional service tag (e.g. `AzureCloud.westeurope`) can be requested by name, in which case the region is implied
+			if location.NormalizeNilable(props.Region) == locationFilter || strings.EqualFold(*value.Name, service) {
 				d.Set("name", value.Name)
 
 				addressPrefixes := make([]string, 0)
@@ -151,7 +152,12 @@ func dataSourceNetworkServiceTagsRead(d *pluginsdk.ResourceData, meta interface{
 // Service tag name has format as below:
 // - (regional) serviceName.locationName
 // - (all) serviceName
+// When `serviceName` is itself a regional service tag name, only that service tag matches.
 func isServiceTagOf(stName, serviceName string) bool {
+	if strings.Contains(serviceName, ".") {
+		return strings.EqualFold(stName, serviceName)
+	}
+
 	stNameComponents := strings.Split(stName, ".")
 	if len(stNameComponents) != 1 && len(stNameComponents) != 2 {
 		return false
@@ -47,6 +47,22 @@ func TestAccDataSourceAzureRMServiceTags_tagName(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMServiceTags_regionalTagName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_service_tags", "test")
+	r := NetworkServiceTagsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.regionalTagName(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue("AzureCloud.westeurope"),
+				check.That(data.ResourceName).Key("address_prefixes.#").Exists(),
+				check.That(data.ResourceName).Key("ipv4_cidrs.#").Exists(),
+			),
+		},
+	})
+}
+
 func TestAccDataSourceAzureRMServiceTags_region(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azurerm_network_service_tags", "test")
 	r := NetworkServiceTagsDataSource{}
@@ -86,3 +102,10 @@ func (NetworkServiceTagsDataSource) tagName() string {
   location_filter = "westus2"
 }`
 }
+
+func (NetworkServiceTagsDataSource) regionalTagName() string {
+	return `data "azurerm_network_service_tags" "test" {
+  location = "westeurope"
+  service  = "AzureCloud.westeurope"
+}`
+}
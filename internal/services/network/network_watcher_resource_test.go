@@ -75,6 +75,7 @@ func TestAccNetworkWatcher(t *testing.T) {
 			"withFilters":                testAccVirtualMachineScaleSetPacketCapture_withFilters,
 			"requiresImport":             testAccVirtualMachineScaleSetPacketCapture_requiresImport,
 			"machineScope":               testAccVirtualMachineScaleSetPacketCapture_machineScope,
+			"stopped":                    testAccVirtualMachineScaleSetPacketCapture_stopped,
 		},
 		"FlowLog": {
 			"basic":                testAccNetworkWatcherFlowLog_basic,
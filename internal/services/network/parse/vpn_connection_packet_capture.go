@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type VpnConnectionPacketCaptureId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	VpnGatewayName    string
+	VpnConnectionName string
+	PacketCaptureName string
+}
+
+func NewVpnConnectionPacketCaptureID(subscriptionId, resourceGroup, vpnGatewayName, vpnConnectionName, packetCaptureName string) VpnConnectionPacketCaptureId {
+	return VpnConnectionPacketCaptureId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		VpnGatewayName:    vpnGatewayName,
+		VpnConnectionName: vpnConnectionName,
+		PacketCaptureName: packetCaptureName,
+	}
+}
+
+func (id VpnConnectionPacketCaptureId) String() string {
+	segments := []string{
+		fmt.Sprintf("Packet Capture Name %q", id.PacketCaptureName),
+		fmt.Sprintf("Vpn Connection Name %q", id.VpnConnectionName),
+		fmt.Sprintf("Vpn Gateway Name %q", id.VpnGatewayName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Vpn Connection Packet Capture", segmentsStr)
+}
+
+func (id VpnConnectionPacketCaptureId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/vpnGateways/%s/vpnConnections/%s/packetCaptures/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.VpnConnectionName, id.PacketCaptureName)
+}
+
+// VpnConnectionPacketCaptureID parses a VpnConnectionPacketCapture ID into an VpnConnectionPacketCaptureId struct
+func VpnConnectionPacketCaptureID(input string) (*VpnConnectionPacketCaptureId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an VpnConnectionPacketCapture ID: %+v", input, err)
+	}
+
+	resourceId := VpnConnectionPacketCaptureId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VpnGatewayName, err = id.PopSegment("vpnGateways"); err != nil {
+		return nil, err
+	}
+	if resourceId.VpnConnectionName, err = id.PopSegment("vpnConnections"); err != nil {
+		return nil, err
+	}
+	if resourceId.PacketCaptureName, err = id.PopSegment("packetCaptures"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// privateEndpointDnsZoneNames is a registry of the Private DNS Zone names recommended for each Private Link Resource
+// Type and Sub Resource in Azure Public Cloud, keyed by the lower-cased `{resourceType}/{subResource}`.
+// See: https://learn.microsoft.com/azure/private-link/private-endpoint-dns
+var privateEndpointDnsZoneNames = map[string][]string{
+	"microsoft.appconfiguration/configurationstores/configurationstores": {"privatelink.azconfig.io"},
+	"microsoft.automation/automationaccounts/dscandhybridworker":         {"privatelink.azure-automation.net"},
+	"microsoft.automation/automationaccounts/webhook":                    {"privatelink.azure-automation.net"},
+	"microsoft.batch/batchaccounts/batchaccount":                         {"privatelink.batch.azure.com"},
+	"microsoft.batch/batchaccounts/nodemanagement":                       {"privatelink.batch.azure.com"},
+	"microsoft.cache/redis/rediscache":                                   {"privatelink.redis.cache.windows.net"},
+	"microsoft.cache/redisenterprise/redisenterprise":                    {"privatelink.redisenterprise.cache.azure.net"},
+	"microsoft.cognitiveservices/accounts/account":                       {"privatelink.cognitiveservices.azure.com"},
+	"microsoft.containerregistry/registries/registry":                    {"privatelink.azurecr.io"},
+	"microsoft.databricks/workspaces/browser_authentication":             {"privatelink.azuredatabricks.net"},
+	"microsoft.databricks/workspaces/databricks_ui_api":                  {"privatelink.azuredatabricks.net"},
+	"microsoft.datafactory/factories/datafactory":                        {"privatelink.datafactory.azure.net"},
+	"microsoft.datafactory/factories/portal":                             {"privatelink.adf.azure.com"},
+	"microsoft.dbformariadb/servers/mariadbserver":                       {"privatelink.mariadb.database.azure.com"},
+	"microsoft.dbformysql/flexibleservers/mysqlserver":                   {"privatelink.mysql.database.azure.com"},
+	"microsoft.dbformysql/servers/mysqlserver":                           {"privatelink.mysql.database.azure.com"},
+	"microsoft.dbforpostgresql/flexibleservers/postgresqlserver":         {"privatelink.postgres.database.azure.com"},
+	"microsoft.dbforpostgresql/servers/postgresqlserver":                 {"privatelink.postgres.database.azure.com"},
+	"microsoft.devices/iothubs/iothub":                                   {"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+	"microsoft.digitaltwins/digitaltwinsinstances/api":                   {"privatelink.digitaltwins.azure.net"},
+	"microsoft.documentdb/databaseaccounts/analytical":                   {"privatelink.analytics.cosmos.azure.com"},
+	"microsoft.documentdb/databaseaccounts/cassandra":                    {"privatelink.cassandra.cosmos.azure.com"},
+	"microsoft.documentdb/databaseaccounts/gremlin":                      {"privatelink.gremlin.cosmos.azure.com"},
+	"microsoft.documentdb/databaseaccounts/mongodb":                      {"privatelink.mongo.cosmos.azure.com"},
+	"microsoft.documentdb/databaseaccounts/sql":                          {"privatelink.documents.azure.com"},
+	"microsoft.documentdb/databaseaccounts/table":                        {"privatelink.table.cosmos.azure.com"},
+	"microsoft.eventgrid/domains/domain":                                 {"privatelink.eventgrid.azure.net"},
+	"microsoft.eventgrid/topics/topic":                                   {"privatelink.eventgrid.azure.net"},
+	"microsoft.eventhub/namespaces/namespace":                            {"privatelink.servicebus.windows.net"},
+	"microsoft.insights/privatelinkscopes/azuremonitor":                  {"privatelink.monitor.azure.com", "privatelink.oms.opinsights.azure.com", "privatelink.ods.opinsights.azure.com", "privatelink.agentsvc.azure-automation.net", "privatelink.blob.core.windows.net"},
+	"microsoft.keyvault/managedhsms/managedhsm":                          {"privatelink.managedhsm.azure.net"},
+	"microsoft.keyvault/vaults/vault":                                    {"privatelink.vaultcore.azure.net"},
+	"microsoft.machinelearningservices/workspaces/amlworkspace":          {"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+	"microsoft.purview/accounts/account":                                 {"privatelink.purview.azure.com"},
+	"microsoft.purview/accounts/portal":                                  {"privatelink.purviewstudio.azure.com"},
+	"microsoft.recoveryservices/vaults/azuresiterecovery":                {"privatelink.siterecovery.windowsazure.com"},
+	"microsoft.relay/namespaces/namespace":                               {"privatelink.servicebus.windows.net"},
+	"microsoft.search/searchservices/searchservice":                      {"privatelink.search.windows.net"},
+	"microsoft.servicebus/namespaces/namespace":                          {"privatelink.servicebus.windows.net"},
+	"microsoft.signalrservice/signalr/signalr":                           {"privatelink.service.signalr.net"},
+	"microsoft.signalrservice/webpubsub/webpubsub":                       {"privatelink.webpubsub.azure.com"},
+	"microsoft.sql/servers/sqlserver":                                    {"privatelink.database.windows.net"},
+	"microsoft.storage/storageaccounts/blob":                             {"privatelink.blob.core.windows.net"},
+	"microsoft.storage/storageaccounts/blob_secondary":                   {"privatelink.blob.core.windows.net"},
+	"microsoft.storage/storageaccounts/dfs":                              {"privatelink.dfs.core.windows.net"},
+	"microsoft.storage/storageaccounts/dfs_secondary":                    {"privatelink.dfs.core.windows.net"},
+	"microsoft.storage/storageaccounts/file":                             {"privatelink.file.core.windows.net"},
+	"microsoft.storage/storageaccounts/queue":                            {"privatelink.queue.core.windows.net"},
+	"microsoft.storage/storageaccounts/queue_secondary":                  {"privatelink.queue.core.windows.net"},
+	"microsoft.storage/storageaccounts/table":                            {"privatelink.table.core.windows.net"},
+	"microsoft.storage/storageaccounts/table_secondary":                  {"privatelink.table.core.windows.net"},
+	"microsoft.storage/storageaccounts/web":                              {"privatelink.web.core.windows.net"},
+	"microsoft.storage/storageaccounts/web_secondary":                    {"privatelink.web.core.windows.net"},
+	"microsoft.synapse/privatelinkhubs/web":                              {"privatelink.azuresynapse.net"},
+	"microsoft.synapse/workspaces/dev":                                   {"privatelink.dev.azuresynapse.net"},
+	"microsoft.synapse/workspaces/sql":                                   {"privatelink.sql.azuresynapse.net"},
+	"microsoft.synapse/workspaces/sqlondemand":                           {"privatelink.sql.azuresynapse.net"},
+	"microsoft.web/sites/sites":                                          {"privatelink.azurewebsites.net"},
+}
+
+// privateDnsZoneNamesForPrivateEndpoint returns the sorted, de-duplicated list of Private DNS Zone names recommended for
+// a Private Endpoint connecting to the specified Resource and Sub Resources. Combinations which aren't in the registry
+// (for example those which require a region-specific zone) are omitted.
+//
+// Since the registry only contains the names used in Azure Public Cloud, an empty list is returned for other environments
+// (e.g. Azure China or Azure US Government) where the Private DNS Zones use different suffixes.
+func privateDnsZoneNamesForPrivateEndpoint(environment environments.Environment, privateConnectionResourceId string, subResourceNames []string) []string {
+	results := make([]string, 0)
+	if !strings.EqualFold(environment.Name, environments.AzurePublicCloud) {
+		return results
+	}
+
+	resourceType := privateEndpointTargetResourceType(privateConnectionResourceId)
+	if resourceType == "" {
+		return results
+	}
+
+	seen := make(map[string]struct{})
+	for _, subResourceName := range subResourceNames {
+		for _, zoneName := range privateEndpointDnsZoneNames[strings.ToLower(resourceType+"/"+subResourceName)] {
+			if _, ok := seen[zoneName]; ok {
+				continue
+			}
+			seen[zoneName] = struct{}{}
+			results = append(results, zoneName)
+		}
+	}
+
+	sort.Strings(results)
+	return results
+}
+
+// privateEndpointTargetResourceType returns the Resource Type (e.g. `Microsoft.Storage/storageAccounts`) of the
+// specified Resource ID, or an empty string if this can't be determined.
+func privateEndpointTargetResourceType(resourceId string) string {
+	idx := strings.LastIndex(strings.ToLower(resourceId), "/providers/")
+	if idx == -1 {
+		return ""
+	}
+
+	// {namespace}/{type}/{name}[/{childType}/{childName}...]
+	segments := strings.Split(strings.Trim(resourceId[idx+len("/providers/"):], "/"), "/")
+	if len(segments) < 3 || len(segments)%2 == 0 {
+		return ""
+	}
+
+	resourceType := segments[0]
+	for i := 1; i < len(segments); i += 2 {
+		resourceType += "/" + segments[i]
+	}
+	return resourceType
+}
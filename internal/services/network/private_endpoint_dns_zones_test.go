@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestPrivateEndpointTargetResourceType(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			// no provider
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1",
+			Expected: "",
+		},
+		{
+			// no resource name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts",
+			Expected: "",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: "Microsoft.Storage/storageAccounts",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1/",
+			Expected: "Microsoft.Storage/storageAccounts",
+		},
+		{
+			// child resource
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/pool1",
+			Expected: "Microsoft.Synapse/workspaces/sqlPools",
+		},
+		{
+			// child resource without a name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools",
+			Expected: "",
+		},
+		{
+			// extension resource, where the type of the innermost resource is used
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: "Microsoft.Insights/diagnosticSettings",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := privateEndpointTargetResourceType(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestPrivateDnsZoneNamesForPrivateEndpoint(t *testing.T) {
+	storageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/account1"
+
+	cases := []struct {
+		Name             string
+		Environment      environments.Environment
+		ResourceId       string
+		SubResourceNames []string
+		Expected         []string
+	}{
+		{
+			Name:             "Single Sub Resource",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       storageAccountId,
+			SubResourceNames: []string{"blob"},
+			Expected:         []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Name:             "Sub Resource Names are Case Insensitive",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/microsoft.storage/STORAGEACCOUNTS/account1",
+			SubResourceNames: []string{"Blob"},
+			Expected:         []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			Name:             "Multiple Sub Resources are Sorted and De-duplicated",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       storageAccountId,
+			SubResourceNames: []string{"queue", "blob", "blob_secondary"},
+			Expected:         []string{"privatelink.blob.core.windows.net", "privatelink.queue.core.windows.net"},
+		},
+		{
+			Name:             "Multiple Zones for a Sub Resource",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Devices/iotHubs/hub1",
+			SubResourceNames: []string{"iotHub"},
+			Expected:         []string{"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+		},
+		{
+			Name:             "Unknown Sub Resource",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       storageAccountId,
+			SubResourceNames: []string{"unknown"},
+			Expected:         []string{},
+		},
+		{
+			Name:             "Unknown Resource Type",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resGroup1/providers/Microsoft.Example/things/thing1",
+			SubResourceNames: []string{"blob"},
+			Expected:         []string{},
+		},
+		{
+			Name:             "Invalid Resource ID",
+			Environment:      *environments.AzurePublic(),
+			ResourceId:       "not-a-resource-id",
+			SubResourceNames: []string{"blob"},
+			Expected:         []string{},
+		},
+		{
+			Name:             "Azure China",
+			Environment:      *environments.AzureChina(),
+			ResourceId:       storageAccountId,
+			SubResourceNames: []string{"blob"},
+			Expected:         []string{},
+		},
+		{
+			Name:             "Azure US Government",
+			Environment:      *environments.AzureUSGovernment(),
+			ResourceId:       storageAccountId,
+			SubResourceNames: []string{"blob"},
+			Expected:         []string{},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := privateDnsZoneNamesForPrivateEndpoint(v.Environment, v.ResourceId, v.SubResourceNames)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
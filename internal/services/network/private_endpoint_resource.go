@@ -287,9 +287,30 @@ func resourcePrivateEndpoint() *pluginsdk.Resource {
 				},
 			},
 
+			"private_dns_zone_names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourcePrivateEndpointCustomizeDiff),
+	}
+}
+
+func resourcePrivateEndpointCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// the recommended Private DNS Zone names are determined from the configuration so they're known during the plan
+	if !d.NewValueKnown("private_service_connection.0.private_connection_resource_id") || !d.NewValueKnown("private_service_connection.0.subresource_names") {
+		return d.SetNewComputed("private_dns_zone_names")
 	}
+
+	privateConnectionResourceId := d.Get("private_service_connection.0.private_connection_resource_id").(string)
+	subResourceNames := utils.ExpandStringSlice(d.Get("private_service_connection.0.subresource_names").([]interface{}))
+	return d.SetNew("private_dns_zone_names", privateDnsZoneNamesForPrivateEndpoint(meta.(*clients.Client).Account.Environment, privateConnectionResourceId, *subResourceNames))
 }
 
 func resourcePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -643,6 +664,18 @@ func resourcePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("setting `private_service_connection`: %+v", err)
 			}
 
+			privateDnsZoneNames := make([]string, 0)
+			if len(flattenedConnection) > 0 {
+				connection := flattenedConnection[0].(map[string]interface{})
+				if privateConnectionResourceId, ok := connection["private_connection_resource_id"].(string); ok {
+					subResourceNames := utils.ExpandStringSlice(connection["subresource_names"].([]interface{}))
+					privateDnsZoneNames = privateDnsZoneNamesForPrivateEndpoint(meta.(*clients.Client).Account.Environment, privateConnectionResourceId, *subResourceNames)
+				}
+			}
+			if err := d.Set("private_dns_zone_names", privateDnsZoneNames); err != nil {
+				return fmt.Errorf("setting `private_dns_zone_names`: %+v", err)
+			}
+
 			flattenedipconfiguration := flattenPrivateEndpointIPConfigurations(props.IPConfigurations)
 			if err := d.Set("ip_configuration", flattenedipconfiguration); err != nil {
 				return fmt.Errorf("setting `ip_configuration`: %+v", err)
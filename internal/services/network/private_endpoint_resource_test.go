@@ -145,6 +145,8 @@ func TestAccPrivateEndpoint_privateDnsZoneGroup(t *testing.T) {
 				check.That(data.ResourceName).Key("private_dns_zone_group.0.private_dns_zone_ids.#").HasValue("1"),
 				check.That(data.ResourceName).Key("private_dns_zone_configs.#").HasValue("1"),
 				check.That(data.ResourceName).Key("private_dns_zone_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_dns_zone_names.0").HasValue("privatelink.postgres.database.azure.com"),
 			),
 		},
 		data.ImportStep("private_dns_zone_configs", "private_dns_zone_group"),
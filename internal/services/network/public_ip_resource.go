@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -49,6 +50,8 @@ func resourcePublicIp() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourcePublicIpCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -101,10 +104,10 @@ func resourcePublicIp() *pluginsdk.Resource {
 				}, false),
 			},
 
+			// a Basic SKU Public IP can be upgraded to the Standard SKU in-place, other changes force a new resource - see the CustomizeDiff
 			"sku": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Default: func() interface{} {
 					// https://azure.microsoft.com/en-us/updates/upgrade-to-standard-sku-public-ip-addresses-in-azure-by-30-september-2025-basic-sku-will-be-retired/
 					if !features.FourPointOhBeta() {
@@ -165,9 +168,10 @@ func resourcePublicIp() *pluginsdk.Resource {
 			},
 
 			"ip_tags": {
-				Type:     pluginsdk.TypeMap,
-				Optional: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PublicIpTags,
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
@@ -320,6 +324,19 @@ func resourcePublicIpUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	payload := existing.Model
 
+	if d.HasChange("sku") {
+		// the CustomizeDiff ensures the only in-place SKU change is an upgrade from Basic to Standard, which requires
+		// the Public IP to be dissociated from any resources first
+		if payload.Properties.IPConfiguration != nil && payload.Properties.IPConfiguration.Id != nil {
+			return fmt.Errorf("upgrading %s to the Standard SKU: the Public IP must be dissociated from %q before it can be upgraded, it can be re-associated once the upgrade has completed", id, *payload.Properties.IPConfiguration.Id)
+		}
+
+		if payload.Sku == nil {
+			payload.Sku = &publicipaddresses.PublicIPAddressSku{}
+		}
+		payload.Sku.Name = pointer.To(publicipaddresses.PublicIPAddressSkuName(d.Get("sku").(string)))
+	}
+
 	if d.HasChange("allocation_method") {
 		payload.Properties.PublicIPAllocationMethod = pointer.To(publicipaddresses.IPAllocationMethod(d.Get("allocation_method").(string)))
 	}
@@ -454,6 +471,30 @@ func resourcePublicIpDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourcePublicIpCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	sku := d.Get("sku").(string)
+
+	if d.Id() != "" && d.HasChange("sku") {
+		oldSku, newSku := d.GetChange("sku")
+		isUpgrade := strings.EqualFold(oldSku.(string), string(publicipaddresses.PublicIPAddressSkuNameBasic)) && strings.EqualFold(newSku.(string), string(publicipaddresses.PublicIPAddressSkuNameStandard))
+		if !isUpgrade {
+			if err := d.ForceNew("sku"); err != nil {
+				return err
+			}
+		} else if d.NewValueKnown("allocation_method") && !strings.EqualFold(d.Get("allocation_method").(string), string(publicipaddresses.IPAllocationMethodStatic)) {
+			return fmt.Errorf("`allocation_method` must be `Static` when upgrading a Public IP to the `Standard` SKU")
+		}
+	}
+
+	if v, ok := d.GetOk("ip_tags"); ok {
+		if _, ok := v.(map[string]interface{})[validate.PublicIpTagTypeRoutingPreference]; ok && !strings.EqualFold(sku, string(publicipaddresses.PublicIPAddressSkuNameStandard)) {
+			return fmt.Errorf("the IP Tag `%s` can only be used with the `Standard` SKU", validate.PublicIpTagTypeRoutingPreference)
+		}
+	}
+
+	return nil
+}
+
 func flattenPublicIpPropsIpTags(input *[]publicipaddresses.IPTag) map[string]interface{} {
 	out := make(map[string]interface{})
 
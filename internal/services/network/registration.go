@@ -33,9 +33,11 @@ func (r Registration) WebsiteCategories() []string {
 
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
+		ExpressRouteCircuitPeeringTablesDataSource{},
 		ManagerDataSource{},
 		ManagerNetworkGroupDataSource{},
 		ManagerConnectivityConfigurationDataSource{},
+		NetworkSecurityGroupRulesDataSource{},
 	}
 }
 
@@ -55,7 +57,9 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerSubscriptionConnectionResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
 		RouteMapResource{},
+		VirtualHubNetworkVirtualApplianceResource{},
 		VirtualHubRoutingIntentResource{},
+		VPNGatewayConnectionPacketCaptureResource{},
 	}
 }
 
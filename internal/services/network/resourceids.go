@@ -38,3 +38,6 @@ package network
 
 // Network
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NetworkInterfaceIpConfiguration -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkInterfaces/networkInterface1/ipConfigurations/config1
+
+// VPN Gateway
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=VpnConnectionPacketCapture -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnGateways/gateway1/vpnConnections/connection1/packetCaptures/default
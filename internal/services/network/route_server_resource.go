@@ -83,6 +83,17 @@ func resourceRouteServer() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"hub_routing_preference": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(virtualwans.HubRoutingPreferenceExpressRoute),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(virtualwans.HubRoutingPreferenceExpressRoute),
+					string(virtualwans.HubRoutingPreferenceVpnGateway),
+					string(virtualwans.HubRoutingPreferenceASPath),
+				}, false),
+			},
+
 			"virtual_router_ips": {
 				Type:     pluginsdk.TypeSet,
 				Computed: true,
@@ -132,6 +143,7 @@ func resourceRouteServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Properties: &virtualwans.VirtualHubProperties{
 			Sku:                        pointer.To(d.Get("sku").(string)),
 			AllowBranchToBranchTraffic: pointer.To(d.Get("branch_to_branch_traffic_enabled").(bool)),
+			HubRoutingPreference:       pointer.To(virtualwans.HubRoutingPreference(d.Get("hub_routing_preference").(string))),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -210,6 +222,10 @@ func resourceRouteServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		payload.Properties.AllowBranchToBranchTraffic = pointer.To(d.Get("branch_to_branch_traffic_enabled").(bool))
 	}
 
+	if d.HasChange("hub_routing_preference") {
+		payload.Properties.HubRoutingPreference = pointer.To(virtualwans.HubRoutingPreference(d.Get("hub_routing_preference").(string)))
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -276,6 +292,11 @@ func resourceRouteServerRead(d *pluginsdk.ResourceData, meta interface{}) error
 			if props.VirtualRouterAsn != nil {
 				d.Set("virtual_router_asn", props.VirtualRouterAsn)
 			}
+			hubRoutingPreference := string(virtualwans.HubRoutingPreferenceExpressRoute)
+			if props.HubRoutingPreference != nil {
+				hubRoutingPreference = string(*props.HubRoutingPreference)
+			}
+			d.Set("hub_routing_preference", hubRoutingPreference)
 			d.Set("routing_state", string(pointer.From(props.RoutingState)))
 		}
 
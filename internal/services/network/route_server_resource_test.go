@@ -80,8 +80,18 @@ func TestAccRouteServer_update(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r)),
 		},
 		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("branch_to_branch_traffic_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("hub_routing_preference").HasValue("ExpressRoute"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
+
 func (r RouteServerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := virtualwans.ParseVirtualHubID(state.ID)
 	if err != nil {
@@ -146,6 +156,7 @@ resource "azurerm_route_server" "test" {
   public_ip_address_id             = azurerm_public_ip.test.id
   subnet_id                        = azurerm_subnet.test.id
   branch_to_branch_traffic_enabled = true
+  hub_routing_preference           = "ASPath"
 }
 `, r.template(data), data.RandomInteger)
 }
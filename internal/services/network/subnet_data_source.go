@@ -77,6 +77,11 @@ func dataSourceSubnet() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"sharing_scope": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"private_endpoint_network_policies": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -154,6 +159,7 @@ func dataSourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				defaultOutboundAccessEnabled = *props.DefaultOutboundAccess
 			}
 			d.Set("default_outbound_access_enabled", defaultOutboundAccessEnabled)
+			d.Set("sharing_scope", string(pointer.From(props.SharingScope)))
 
 			d.Set("private_endpoint_network_policies", string(pointer.From(props.PrivateEndpointNetworkPolicies)))
 			d.Set("private_link_service_network_policies_enabled", flattenSubnetNetworkPolicy(string(*props.PrivateLinkServiceNetworkPolicies)))
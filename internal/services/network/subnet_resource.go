@@ -113,6 +113,8 @@ func resourceSubnet() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceSubnetCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:     pluginsdk.TypeString,
@@ -203,7 +205,12 @@ func resourceSubnet() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeBool,
 				Default:  true,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			"sharing_scope": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(subnets.PossibleValuesForSharingScope(), false),
 			},
 
 			"private_endpoint_network_policies": {
@@ -296,6 +303,14 @@ func resourceSubnet() *pluginsdk.Resource {
 	return resource
 }
 
+func resourceSubnetCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if d.Get("sharing_scope").(string) != "" && d.Get("default_outbound_access_enabled").(bool) {
+		return fmt.Errorf("`sharing_scope` can only be specified when `default_outbound_access_enabled` is set to `false`")
+	}
+
+	return nil
+}
+
 // TODO: refactor the create/flatten functions
 func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.Client.Subnets
@@ -421,6 +436,10 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	properties.DefaultOutboundAccess = pointer.To(d.Get("default_outbound_access_enabled").(bool))
 
+	if v, ok := d.GetOk("sharing_scope"); ok {
+		properties.SharingScope = pointer.To(subnets.SharingScope(v.(string)))
+	}
+
 	delegationsRaw := d.Get("delegation").([]interface{})
 	properties.Delegations = expandSubnetDelegation(delegationsRaw)
 
@@ -578,6 +597,17 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		props.ServiceEndpointPolicies = expandSubnetServiceEndpointPolicies(serviceEndpointPoliciesRaw)
 	}
 
+	// the Sharing Scope can only be set when Default Outbound Access is disabled, so both are always sent together
+	// to allow them to be changed within a single update
+	if d.HasChanges("default_outbound_access_enabled", "sharing_scope") {
+		props.DefaultOutboundAccess = pointer.To(d.Get("default_outbound_access_enabled").(bool))
+
+		props.SharingScope = nil
+		if v := d.Get("sharing_scope").(string); v != "" {
+			props.SharingScope = pointer.To(subnets.SharingScope(v))
+		}
+	}
+
 	subnet := subnets.Subnet{
 		Name:       utils.String(id.SubnetName),
 		Properties: &props,
@@ -656,6 +686,7 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				defaultOutboundAccessEnabled = *props.DefaultOutboundAccess
 			}
 			d.Set("default_outbound_access_enabled", defaultOutboundAccessEnabled)
+			d.Set("sharing_scope", string(pointer.From(props.SharingScope)))
 
 			delegation := flattenSubnetDelegation(props.Delegations)
 			if err := d.Set("delegation", delegation); err != nil {
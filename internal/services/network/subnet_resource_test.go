@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -144,6 +145,45 @@ func TestAccSubnet_defaultOutbound(t *testing.T) {
 	})
 }
 
+func TestAccSubnet_sharingScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sharingScope(data, "Tenant"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_outbound_access_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("sharing_scope").HasValue("Tenant"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.sharingScope(data, "DelegatedServices"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sharing_scope").HasValue("DelegatedServices"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the Sharing Scope and enabling Default Outbound Access happens within a single update
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_outbound_access_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("sharing_scope").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.sharingScopeWithDefaultOutboundAccess(data),
+			ExpectError: regexp.MustCompile("`sharing_scope` can only be specified when `default_outbound_access_enabled` is set to `false`"),
+		},
+	})
+}
+
 func TestAccSubnet_delegation(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
 	r := SubnetResource{}
@@ -859,6 +899,35 @@ resource "azurerm_subnet" "public" {
 `, r.template(data))
 }
 
+func (r SubnetResource) sharingScope(data acceptance.TestData, sharingScope string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                            = "internal"
+  resource_group_name             = azurerm_resource_group.test.name
+  virtual_network_name            = azurerm_virtual_network.test.name
+  address_prefixes                = ["10.0.2.0/24"]
+  default_outbound_access_enabled = false
+  sharing_scope                   = "%s"
+}
+`, r.template(data), sharingScope)
+}
+
+func (r SubnetResource) sharingScopeWithDefaultOutboundAccess(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+  sharing_scope        = "Tenant"
+}
+`, r.template(data))
+}
+
 func (r SubnetResource) delegationUpdated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -6,6 +6,12 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
+)
+
+const (
+	PublicIpTagTypeFirstPartyUsage   = "FirstPartyUsage"
+	PublicIpTagTypeRoutingPreference = "RoutingPreference"
 )
 
 func PublicIpDomainNameLabel(v interface{}, k string) (warnings []string, errors []error) {
@@ -15,3 +21,36 @@ func PublicIpDomainNameLabel(v interface{}, k string) (warnings []string, errors
 	}
 	return warnings, errors
 }
+
+// PublicIpTags validates the IP Tags of a Public IP, which are a mapping of the IP Tag Type to the Tag
+func PublicIpTags(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return warnings, errors
+	}
+
+	for tagType, tag := range value {
+		tagValue, _ := tag.(string)
+		if strings.TrimSpace(tagValue) == "" {
+			errors = append(errors, fmt.Errorf("the value of the IP Tag %q in %q must not be empty", tagType, k))
+			continue
+		}
+
+		switch tagType {
+		case PublicIpTagTypeFirstPartyUsage:
+			// e.g. `/Sql`, `/Storage` or `/NonProd`
+			if !strings.HasPrefix(tagValue, "/") {
+				errors = append(errors, fmt.Errorf("the value of the IP Tag %q in %q must start with `/`, got %q", tagType, k, tagValue))
+			}
+		case PublicIpTagTypeRoutingPreference:
+			if tagValue != "Internet" {
+				errors = append(errors, fmt.Errorf("the value of the IP Tag %q in %q must be `Internet`, got %q", tagType, k, tagValue))
+			}
+		default:
+			errors = append(errors, fmt.Errorf("%q contains an unsupported IP Tag type %q, supported types are %q and %q", k, tagType, PublicIpTagTypeFirstPartyUsage, PublicIpTagTypeRoutingPreference))
+		}
+	}
+
+	return warnings, errors
+}
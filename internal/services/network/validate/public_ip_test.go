@@ -42,3 +42,65 @@ func TestPublicIpDomainNameLabel(t *testing.T) {
 		}
 	}
 }
+
+func TestPublicIpTags(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{},
+			ErrCount: 0,
+		},
+		{
+			Value: map[string]interface{}{
+				"RoutingPreference": "Internet",
+			},
+			ErrCount: 0,
+		},
+		{
+			Value: map[string]interface{}{
+				"RoutingPreference": "Microsoft",
+			},
+			ErrCount: 1,
+		},
+		{
+			Value: map[string]interface{}{
+				"FirstPartyUsage": "/Sql",
+			},
+			ErrCount: 0,
+		},
+		{
+			Value: map[string]interface{}{
+				"FirstPartyUsage": "Sql",
+			},
+			ErrCount: 1,
+		},
+		{
+			Value: map[string]interface{}{
+				"FirstPartyUsage": "",
+			},
+			ErrCount: 1,
+		},
+		{
+			Value: map[string]interface{}{
+				"routingpreference": "Internet",
+			},
+			ErrCount: 1,
+		},
+		{
+			Value: map[string]interface{}{
+				"Unknown": "/Sql",
+			},
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PublicIpTags(tc.Value, "ip_tags")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for the IP Tags %+v but got %d: %+v", tc.ErrCount, tc.Value, len(errors), errors)
+		}
+	}
+}
@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkvirtualappliances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualwans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualHubNetworkVirtualApplianceResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualHubNetworkVirtualApplianceResource{}
+
+type VirtualHubNetworkVirtualApplianceModel struct {
+	Name                              string                                 `tfschema:"name"`
+	VirtualHubId                      string                                 `tfschema:"virtual_hub_id"`
+	Sku                               []VirtualHubNetworkVirtualApplianceSku `tfschema:"sku"`
+	VirtualApplianceAsn               int64                                  `tfschema:"virtual_appliance_asn"`
+	BootStrapConfigurationBlobUrls    []string                               `tfschema:"boot_strap_configuration_blob_urls"`
+	CloudInitConfiguration            string                                 `tfschema:"cloud_init_configuration"`
+	CloudInitConfigurationBlobUrls    []string                               `tfschema:"cloud_init_configuration_blob_urls"`
+	SshPublicKey                      string                                 `tfschema:"ssh_public_key"`
+	Tags                              map[string]string                      `tfschema:"tags"`
+	AddressPrefix                     string                                 `tfschema:"address_prefix"`
+	VirtualApplianceConnectionIds     []string                               `tfschema:"virtual_appliance_connection_ids"`
+	VirtualApplianceNetworkInterfaces []VirtualHubNetworkVirtualApplianceNic `tfschema:"virtual_appliance_network_interface"`
+}
+
+type VirtualHubNetworkVirtualApplianceSku struct {
+	Vendor             string `tfschema:"vendor"`
+	BundledScaleUnit   string `tfschema:"bundled_scale_unit"`
+	MarketPlaceVersion string `tfschema:"market_place_version"`
+}
+
+type VirtualHubNetworkVirtualApplianceNic struct {
+	Name             string `tfschema:"name"`
+	InstanceName     string `tfschema:"instance_name"`
+	PrivateIpAddress string `tfschema:"private_ip_address"`
+	PublicIpAddress  string `tfschema:"public_ip_address"`
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) ResourceType() string {
+	return "azurerm_virtual_hub_network_virtual_appliance"
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) ModelObject() interface{} {
+	return &VirtualHubNetworkVirtualApplianceModel{}
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkvirtualappliances.ValidateNetworkVirtualApplianceID
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_hub_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: virtualwans.ValidateVirtualHubID,
+		},
+
+		"sku": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vendor": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"bundled_scale_unit": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"market_place_version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"virtual_appliance_asn": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+			ForceNew: true,
+			// the upper bound of 4294967295 is not compatible with 32-bit builds
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"boot_strap_configuration_blob_urls": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+		},
+
+		"cloud_init_configuration": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"cloud_init_configuration_blob_urls"},
+		},
+
+		"cloud_init_configuration_blob_urls": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			ConflictsWith: []string{"cloud_init_configuration"},
+		},
+
+		"ssh_public_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (VirtualHubNetworkVirtualApplianceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"address_prefix": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_appliance_connection_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"virtual_appliance_network_interface": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"instance_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"private_ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"public_ip_address": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.Client.NetworkVirtualAppliances
+
+			var model VirtualHubNetworkVirtualApplianceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			hubId, err := virtualwans.ParseVirtualHubID(model.VirtualHubId)
+			if err != nil {
+				return err
+			}
+
+			id := networkvirtualappliances.NewNetworkVirtualApplianceID(hubId.SubscriptionId, hubId.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id, networkvirtualappliances.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			hub, err := metadata.Client.Network.VirtualWANs.VirtualHubsGet(ctx, *hubId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s for %s: %+v", hubId, id, err)
+			}
+			if hub.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", hubId)
+			}
+
+			props := networkvirtualappliances.NetworkVirtualAppliancePropertiesFormat{
+				NvaSku:              expandVirtualHubNetworkVirtualApplianceSku(model.Sku),
+				VirtualApplianceAsn: pointer.To(model.VirtualApplianceAsn),
+				VirtualHub: &networkvirtualappliances.SubResource{
+					Id: pointer.To(hubId.ID()),
+				},
+			}
+
+			if len(model.BootStrapConfigurationBlobUrls) > 0 {
+				props.BootStrapConfigurationBlobs = pointer.To(model.BootStrapConfigurationBlobUrls)
+			}
+
+			if model.CloudInitConfiguration != "" {
+				props.CloudInitConfiguration = pointer.To(model.CloudInitConfiguration)
+			}
+
+			if len(model.CloudInitConfigurationBlobUrls) > 0 {
+				props.CloudInitConfigurationBlobs = pointer.To(model.CloudInitConfigurationBlobUrls)
+			}
+
+			if model.SshPublicKey != "" {
+				props.SshPublicKey = pointer.To(model.SshPublicKey)
+			}
+
+			payload := networkvirtualappliances.NetworkVirtualAppliance{
+				Location:   pointer.To(location.Normalize(pointer.From(hub.Model.Location))),
+				Properties: pointer.To(props),
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.Client.NetworkVirtualAppliances
+
+			id, err := networkvirtualappliances.ParseNetworkVirtualApplianceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, networkvirtualappliances.DefaultGetOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualHubNetworkVirtualApplianceModel{
+				Name: id.NetworkVirtualApplianceName,
+			}
+
+			// the cloud init configuration isn't returned by the API
+			var config VirtualHubNetworkVirtualApplianceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.CloudInitConfiguration = config.CloudInitConfiguration
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.VirtualHub != nil {
+						hubId, err := virtualwans.ParseVirtualHubIDInsensitively(pointer.From(props.VirtualHub.Id))
+						if err != nil {
+							return err
+						}
+						state.VirtualHubId = hubId.ID()
+					}
+
+					state.Sku = flattenVirtualHubNetworkVirtualApplianceSku(props.NvaSku)
+					state.VirtualApplianceAsn = pointer.From(props.VirtualApplianceAsn)
+					state.BootStrapConfigurationBlobUrls = pointer.From(props.BootStrapConfigurationBlobs)
+					state.CloudInitConfigurationBlobUrls = pointer.From(props.CloudInitConfigurationBlobs)
+					state.SshPublicKey = pointer.From(props.SshPublicKey)
+					state.AddressPrefix = pointer.From(props.AddressPrefix)
+
+					connectionIds := make([]string, 0)
+					for _, v := range pointer.From(props.VirtualApplianceConnections) {
+						if v.Id != nil {
+							connectionIds = append(connectionIds, *v.Id)
+						}
+					}
+					state.VirtualApplianceConnectionIds = connectionIds
+
+					state.VirtualApplianceNetworkInterfaces = flattenVirtualHubNetworkVirtualApplianceNics(props.VirtualApplianceNics)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.Client.NetworkVirtualAppliances
+
+			id, err := networkvirtualappliances.ParseNetworkVirtualApplianceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualHubNetworkVirtualApplianceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := networkvirtualappliances.TagsObject{
+					Tags: pointer.To(model.Tags),
+				}
+				if _, err := client.UpdateTags(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualHubNetworkVirtualApplianceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.Client.NetworkVirtualAppliances
+
+			id, err := networkvirtualappliances.ParseNetworkVirtualApplianceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVirtualHubNetworkVirtualApplianceSku(input []VirtualHubNetworkVirtualApplianceSku) *networkvirtualappliances.VirtualApplianceSkuProperties {
+	if len(input) == 0 {
+		return nil
+	}
+
+	sku := input[0]
+	return &networkvirtualappliances.VirtualApplianceSkuProperties{
+		Vendor:             pointer.To(sku.Vendor),
+		BundledScaleUnit:   pointer.To(sku.BundledScaleUnit),
+		MarketPlaceVersion: pointer.To(sku.MarketPlaceVersion),
+	}
+}
+
+func flattenVirtualHubNetworkVirtualApplianceSku(input *networkvirtualappliances.VirtualApplianceSkuProperties) []VirtualHubNetworkVirtualApplianceSku {
+	if input == nil {
+		return []VirtualHubNetworkVirtualApplianceSku{}
+	}
+
+	return []VirtualHubNetworkVirtualApplianceSku{
+		{
+			Vendor:             pointer.From(input.Vendor),
+			BundledScaleUnit:   pointer.From(input.BundledScaleUnit),
+			MarketPlaceVersion: pointer.From(input.MarketPlaceVersion),
+		},
+	}
+}
+
+func flattenVirtualHubNetworkVirtualApplianceNics(input *[]networkvirtualappliances.VirtualApplianceNicProperties) []VirtualHubNetworkVirtualApplianceNic {
+	output := make([]VirtualHubNetworkVirtualApplianceNic, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, VirtualHubNetworkVirtualApplianceNic{
+			Name:             pointer.From(v.Name),
+			InstanceName:     pointer.From(v.InstanceName),
+			PrivateIpAddress: pointer.From(v.PrivateIPAddress),
+			PublicIpAddress:  pointer.From(v.PublicIPAddress),
+		})
+	}
+
+	return output
+}
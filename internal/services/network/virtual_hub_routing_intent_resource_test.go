@@ -78,6 +78,22 @@ func TestAccVirtualHubRoutingIntent_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualHubRoutingIntent_customPolicyNames(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customPolicyNames(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("routing_policy.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r VirtualHubRoutingIntentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := virtualwans.ParseRoutingIntentID(state.ID)
 	if err != nil {
@@ -208,3 +224,26 @@ resource "azurerm_virtual_hub_routing_intent" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VirtualHubRoutingIntentResource) customPolicyNames(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "acctest-internet-%d"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+
+  routing_policy {
+    name         = "acctest-private-%d"
+    destinations = ["PrivateTraffic"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
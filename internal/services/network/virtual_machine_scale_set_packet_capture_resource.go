@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -27,6 +28,7 @@ func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualMachineScaleSetPacketCaptureCreate,
 		Read:   resourceVirtualMachineScaleSetPacketCaptureRead,
+		Update: resourceVirtualMachineScaleSetPacketCaptureUpdate,
 		Delete: resourceVirtualMachineScaleSetPacketCaptureDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -37,9 +39,17 @@ func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// a stopped packet capture can't be started again, so a new one has to be created
+			pluginsdk.ForceNewIfChange("stopped", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -178,6 +188,35 @@ func resourceVirtualMachineScaleSetPacketCapture() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"stopped": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"capture_start_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"errors": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"stop_reason": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -234,6 +273,32 @@ func resourceVirtualMachineScaleSetPacketCaptureCreate(d *pluginsdk.ResourceData
 
 	d.SetId(id.ID())
 
+	if d.Get("stopped").(bool) {
+		if err := client.StopThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", id, err)
+		}
+	}
+
+	return resourceVirtualMachineScaleSetPacketCaptureRead(d, meta)
+}
+
+func resourceVirtualMachineScaleSetPacketCaptureUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PacketCaptures
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := packetcaptures.ParsePacketCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// starting a stopped packet capture forces a new resource, so the only in-place change is stopping it
+	if d.HasChange("stopped") && d.Get("stopped").(bool) {
+		if err := client.StopThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", *id, err)
+		}
+	}
+
 	return resourceVirtualMachineScaleSetPacketCaptureRead(d, meta)
 }
 
@@ -288,6 +353,23 @@ func resourceVirtualMachineScaleSetPacketCaptureRead(d *pluginsdk.ResourceData,
 		}
 	}
 
+	status, err := virtualMachineScaleSetPacketCaptureQueryStatus(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	errors := make([]string, 0)
+	if status.PacketCaptureError != nil {
+		for _, v := range *status.PacketCaptureError {
+			errors = append(errors, string(v))
+		}
+	}
+
+	d.Set("capture_start_time", pointer.From(status.CaptureStartTime))
+	d.Set("errors", errors)
+	d.Set("status", string(pointer.From(status.PacketCaptureStatus)))
+	d.Set("stop_reason", pointer.From(status.StopReason))
+
 	return nil
 }
 
@@ -308,6 +390,23 @@ func resourceVirtualMachineScaleSetPacketCaptureDelete(d *pluginsdk.ResourceData
 	return nil
 }
 
+func virtualMachineScaleSetPacketCaptureQueryStatus(ctx context.Context, client *packetcaptures.PacketCapturesClient, id packetcaptures.PacketCaptureId) (*packetcaptures.PacketCaptureQueryStatusResult, error) {
+	resp, err := client.GetStatus(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("querying the status of %s: %+v", id, err)
+	}
+	if err := resp.Poller.PollUntilDone(ctx); err != nil {
+		return nil, fmt.Errorf("waiting for the status of %s: %+v", id, err)
+	}
+
+	var result packetcaptures.PacketCaptureQueryStatusResult
+	if err := resp.Poller.FinalResult(&result); err != nil {
+		return nil, fmt.Errorf("retrieving the status of %s: %+v", id, err)
+	}
+
+	return &result, nil
+}
+
 func expandVirtualMachineScaleSetPacketCaptureStorageLocation(input []interface{}) packetcaptures.PacketCaptureStorageLocation {
 	location := input[0].(map[string]interface{})
 
@@ -404,7 +503,7 @@ func expandVirtualMachineScaleSetPacketCaptureMachineScope(input []interface{})
 
 func flattenVirtualMachineScaleSetPacketCaptureMachineScope(input *packetcaptures.PacketCaptureMachineScope) ([]interface{}, error) {
 	outputs := make([]interface{}, 0)
-	if input == nil || (input.Exclude == nil && input.Include == nil) || (len(*input.Exclude) == 0 && len(*input.Include) == 0) {
+	if input == nil || (len(pointer.From(input.Exclude)) == 0 && len(pointer.From(input.Include)) == 0) {
 		return outputs, nil
 	}
 
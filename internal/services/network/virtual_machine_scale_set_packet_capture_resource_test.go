@@ -108,6 +108,39 @@ func testAccVirtualMachineScaleSetPacketCapture_machineScope(t *testing.T) {
 	})
 }
 
+func testAccVirtualMachineScaleSetPacketCapture_stopped(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_packet_capture", "test")
+	r := VirtualMachineScaleSetPacketCaptureResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.localDiskConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Running"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stopped(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Stopped"),
+				check.That(data.ResourceName).Key("stop_reason").Exists(),
+			),
+		},
+		data.ImportStep("stopped"),
+		{
+			Config: r.localDiskConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Running"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualMachineScaleSetPacketCaptureResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := packetcaptures.ParsePacketCaptureID(state.ID)
 	if err != nil {
@@ -343,3 +376,22 @@ resource "azurerm_virtual_machine_scale_set_packet_capture" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VirtualMachineScaleSetPacketCaptureResource) stopped(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_packet_capture" "test" {
+  name                         = "acctestpc-%d"
+  network_watcher_id           = azurerm_network_watcher.test.id
+  virtual_machine_scale_set_id = azurerm_linux_virtual_machine_scale_set.test.id
+  stopped                      = true
+
+  storage_location {
+    file_path = "/var/captures/packet.cap"
+  }
+
+  depends_on = [azurerm_virtual_machine_scale_set_extension.test]
+}
+`, r.template(data), data.RandomInteger)
+}
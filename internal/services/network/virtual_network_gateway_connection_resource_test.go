@@ -96,6 +96,39 @@ func TestAccVirtualNetworkGatewayConnection_expressRouteWithFastPath(t *testing.
 	})
 }
 
+func TestAccVirtualNetworkGatewayConnection_expressRouteFastPathUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_connection", "test")
+	r := VirtualNetworkGatewayConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.expressRoute(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_link_fast_path_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("shared_key"),
+		{
+			Config: r.expressRouteWithFastPath(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("express_route_gateway_bypass").HasValue("true"),
+				check.That(data.ResourceName).Key("private_link_fast_path_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("shared_key"),
+		{
+			Config: r.expressRoute(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_link_fast_path_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("shared_key"),
+	})
+}
+
 func TestAccVirtualNetworkGatewayConnection_vnetToVnet(t *testing.T) {
 	data1 := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_connection", "test_1")
 	data2 := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway_connection", "test_2")
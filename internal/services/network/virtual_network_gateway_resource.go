@@ -5,8 +5,10 @@ package network
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -47,9 +49,68 @@ func resourceVirtualNetworkGateway() *pluginsdk.Resource {
 		},
 
 		Schema: resourceVirtualNetworkGatewaySchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualNetworkGatewaySkuCustomizeDiff),
+	}
+}
+
+// virtualNetworkGatewaySkuFamily returns the family of a Virtual Network Gateway SKU, the SKU of a Virtual Network
+// Gateway can only be resized in place to another SKU within the same family
+func virtualNetworkGatewaySkuFamily(sku string) string {
+	switch {
+	case strings.EqualFold(sku, string(virtualnetworkgateways.VirtualNetworkGatewaySkuNameBasic)):
+		return "Basic"
+	case strings.HasPrefix(strings.ToLower(sku), "vpngw") && strings.HasSuffix(strings.ToLower(sku), "az"):
+		return "VpnGwAZ"
+	case strings.HasPrefix(strings.ToLower(sku), "vpngw"):
+		return "VpnGw"
+	case strings.HasPrefix(strings.ToLower(sku), "ergw") && strings.HasSuffix(strings.ToLower(sku), "az"):
+		return "ErGwAZ"
+	default:
+		// the legacy `Standard`, `HighPerformance` and `UltraPerformance` SKUs
+		return "Legacy"
 	}
 }
 
+func virtualNetworkGatewaySkuIsZoneRedundant(sku string) bool {
+	family := virtualNetworkGatewaySkuFamily(sku)
+	return family == "VpnGwAZ" || family == "ErGwAZ"
+}
+
+func resourceVirtualNetworkGatewaySkuCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("sku") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("sku")
+	oldSku := oldRaw.(string)
+	newSku := newRaw.(string)
+	if oldSku == "" || newSku == "" {
+		return nil
+	}
+
+	oldFamily := virtualNetworkGatewaySkuFamily(oldSku)
+	newFamily := virtualNetworkGatewaySkuFamily(newSku)
+	if oldFamily == newFamily {
+		return nil
+	}
+
+	if oldFamily == "Basic" {
+		return fmt.Errorf("the `sku` of a Virtual Network Gateway can't be changed from `Basic` to %q - the Virtual Network Gateway has to be recreated to use another SKU", newSku)
+	}
+
+	if virtualNetworkGatewaySkuIsZoneRedundant(oldSku) && !virtualNetworkGatewaySkuIsZoneRedundant(newSku) {
+		return fmt.Errorf("the `sku` of a Virtual Network Gateway can't be changed from the zone-redundant SKU %q to the non zone-redundant SKU %q", oldSku, newSku)
+	}
+
+	// changing between SKU families (e.g. `VpnGw1` to `VpnGw1AZ`) has to be done through the Gateway SKU Migration,
+	// which keeps the existing tunnels and Public IP Addresses. Recreating the Virtual Network Gateway would break
+	// both, so rather than forcing a new resource this is surfaced during the plan. The migration (prepare, execute
+	// and commit) isn't part of the API version used for Virtual Network Gateways here, so it can't be driven from
+	// this resource until the `virtualnetworkgateways` SDK package is updated to a version which includes it
+	return fmt.Errorf("the `sku` of a Virtual Network Gateway can't be resized from %q to %q in place, since they're different SKU families. Migrate the Virtual Network Gateway to the new SKU using the Gateway SKU Migration (e.g. through the Azure Portal) first - once the migration has been committed, update the `sku` to %q to match", oldSku, newSku, newSku)
+}
+
 func resourceVirtualNetworkGatewaySchema() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -140,6 +141,33 @@ func TestAccVirtualNetworkGateway_vpnGw3(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkGateway_skuResize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway", "test")
+	r := VirtualNetworkGatewayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sku(data, "VpnGw1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.sku(data, "VpnGw2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku").HasValue("VpnGw2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.sku(data, "VpnGw2AZ"),
+			ExpectError: regexp.MustCompile("can't be resized from \"VpnGw2\" to \"VpnGw2AZ\" in place"),
+		},
+	})
+}
+
 func TestAccVirtualNetworkGateway_generation(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_network_gateway", "test")
 	r := VirtualNetworkGatewayResource{}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -127,7 +128,29 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualNetworkPeeringCustomizeDiff),
+	}
+}
+
+func resourceVirtualNetworkPeeringCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if !d.Get("peer_complete_virtual_networks_enabled").(bool) {
+		return nil
 	}
+
+	// the raw config is used since the Subnet names are likely to be unknown until the Subnets have been created
+	config := d.GetRawConfig().AsValueMap()
+	for _, field := range []string{"local_subnet_names", "remote_subnet_names"} {
+		if v, ok := config[field]; ok && !v.IsNull() {
+			return fmt.Errorf("`%s` can only be specified when `peer_complete_virtual_networks_enabled` is `false`", field)
+		}
+	}
+
+	if d.Get("only_ipv6_peering_enabled").(bool) {
+		return fmt.Errorf("`only_ipv6_peering_enabled` can only be enabled when `peer_complete_virtual_networks_enabled` is `false`")
+	}
+
+	return nil
 }
 
 func resourceVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -137,6 +138,19 @@ func TestAccVirtualNetworkPeering_subnetPeering(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkPeering_subnetPeeringWithCompleteVirtualNetworks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering", "test1")
+	r := VirtualNetworkPeeringResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.subnetPeeringWithCompleteVirtualNetworks(data),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`local_subnet_names` can only be specified when `peer_complete_virtual_networks_enabled` is `false`"),
+		},
+	})
+}
+
 func (r VirtualNetworkPeeringResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := virtualnetworkpeerings.ParseVirtualNetworkPeeringID(state.ID)
 	if err != nil {
@@ -325,3 +339,22 @@ resource "azurerm_virtual_network_peering" "test1" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VirtualNetworkPeeringResource) subnetPeeringWithCompleteVirtualNetworks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                      = "acctestpeer-1-%[2]d"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.test1.name
+  remote_virtual_network_id = azurerm_virtual_network.test2.id
+  local_subnet_names        = ["internal1"]
+  remote_subnet_names       = ["internal2"]
+}
+`, r.template(data), data.RandomInteger)
+}
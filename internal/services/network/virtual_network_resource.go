@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/ddosprotectionplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -52,6 +54,8 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 		},
 
 		Schema: resourceVirtualNetworkSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualNetworkCustomizeDiff),
 	}
 }
 
@@ -394,7 +398,7 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("encryption") {
-		// nil out the current values in case `encryption` has been removed from the config file
+		// the API ignores a missing `encryption` property, so it has to be explicitly disabled when the block is removed
 		payload.Properties.Encryption = expandVirtualNetworkEncryption(d.Get("encryption").([]interface{}))
 	}
 
@@ -513,9 +517,73 @@ func expandVirtualNetworkDdosProtectionPlan(input []interface{}) (*virtualnetwor
 	}, &enabled
 }
 
+func resourceVirtualNetworkCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// a new Virtual Network can't have any Network Interfaces attached yet
+	if d.Id() == "" || !d.HasChange("encryption") {
+		return nil
+	}
+
+	enforcement := d.Get("encryption.0.enforcement").(string)
+	if enforcement != string(virtualnetworks.VirtualNetworkEncryptionEnforcementDropUnencrypted) {
+		return nil
+	}
+
+	client := meta.(*clients.Client).Network
+	id, err := commonids.ParseVirtualNetworkID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.VirtualNetworks.Get(ctx, *id, virtualnetworks.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	unsupported := make([]string, 0)
+	seen := make(map[string]struct{})
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Subnets != nil {
+		for _, subnet := range *model.Properties.Subnets {
+			if subnet.Properties == nil || subnet.Properties.IPConfigurations == nil {
+				continue
+			}
+
+			for _, ipConfig := range *subnet.Properties.IPConfigurations {
+				// IP Configurations can also belong to Load Balancers, Private Endpoints etc. which are ignored here
+				ipConfigId, err := commonids.ParseNetworkInterfaceIPConfigurationIDInsensitively(pointer.From(ipConfig.Id))
+				if err != nil {
+					continue
+				}
+
+				nicId := commonids.NewNetworkInterfaceID(ipConfigId.SubscriptionId, ipConfigId.ResourceGroupName, ipConfigId.NetworkInterfaceName)
+				if _, ok := seen[nicId.ID()]; ok {
+					continue
+				}
+				seen[nicId.ID()] = struct{}{}
+
+				nic, err := client.NetworkInterfaces.Get(ctx, nicId, networkinterfaces.DefaultGetOperationOptions())
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", nicId, err)
+				}
+
+				if model := nic.Model; model != nil && model.Properties != nil && !pointer.From(model.Properties.VnetEncryptionSupported) {
+					unsupported = append(unsupported, nicId.ID())
+				}
+			}
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("`encryption.0.enforcement` cannot be set to `%s` since the following Network Interfaces attached to %s don't support Virtual Network Encryption: %s", enforcement, id, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
 func expandVirtualNetworkEncryption(input []interface{}) *virtualnetworks.VirtualNetworkEncryption {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return &virtualnetworks.VirtualNetworkEncryption{
+			Enabled: false,
+		}
 	}
 
 	attr := input[0].(map[string]interface{})
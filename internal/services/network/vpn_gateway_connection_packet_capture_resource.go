@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualwans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VPNGatewayConnectionPacketCaptureResource struct{}
+
+var _ sdk.Resource = VPNGatewayConnectionPacketCaptureResource{}
+
+type VPNGatewayConnectionPacketCaptureModel struct {
+	VpnGatewayConnectionId string   `tfschema:"vpn_gateway_connection_id"`
+	StorageContainerSasUrl string   `tfschema:"storage_container_sas_url"`
+	FilterData             string   `tfschema:"filter_data"`
+	VpnLinkNames           []string `tfschema:"vpn_link_names"`
+}
+
+func (VPNGatewayConnectionPacketCaptureResource) ResourceType() string {
+	return "azurerm_vpn_gateway_connection_packet_capture"
+}
+
+func (VPNGatewayConnectionPacketCaptureResource) ModelObject() interface{} {
+	return &VPNGatewayConnectionPacketCaptureModel{}
+}
+
+func (VPNGatewayConnectionPacketCaptureResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VpnConnectionPacketCaptureID
+}
+
+func (VPNGatewayConnectionPacketCaptureResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"vpn_gateway_connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateVPNConnectionID,
+		},
+
+		// the captured packets are only uploaded when the capture is stopped, which happens when this resource is destroyed
+		"storage_container_sas_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"filter_data": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsJSON,
+		},
+
+		"vpn_link_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (VPNGatewayConnectionPacketCaptureResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VPNGatewayConnectionPacketCaptureResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			var model VPNGatewayConnectionPacketCaptureModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			connectionId, err := commonids.ParseVPNConnectionID(model.VpnGatewayConnectionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVpnConnectionPacketCaptureID(connectionId.SubscriptionId, connectionId.ResourceGroupName, connectionId.GatewayName, connectionId.ConnectionName, "default")
+
+			locks.ByName(connectionId.GatewayName, VPNGatewayResourceName)
+			defer locks.UnlockByName(connectionId.GatewayName, VPNGatewayResourceName)
+
+			existing, err := client.VpnConnectionsGet(ctx, *connectionId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", connectionId, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model` or `properties` was nil", connectionId)
+			}
+
+			input := virtualwans.VpnConnectionPacketCaptureStartParameters{}
+			if model.FilterData != "" {
+				input.FilterData = pointer.To(model.FilterData)
+			}
+			if len(model.VpnLinkNames) > 0 {
+				input.LinkConnectionNames = pointer.To(model.VpnLinkNames)
+			}
+
+			if err := client.VpnConnectionsStartPacketCaptureThenPoll(ctx, *connectionId, input); err != nil {
+				return fmt.Errorf("starting %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VPNGatewayConnectionPacketCaptureResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			id, err := parse.VpnConnectionPacketCaptureID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			connectionId := commonids.NewVPNConnectionID(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.VpnConnectionName)
+
+			existing, err := client.VpnConnectionsGet(ctx, connectionId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", connectionId, err)
+			}
+
+			// the API doesn't expose the state of a running capture, so the remaining values are retained from the configuration
+			var state VPNGatewayConnectionPacketCaptureModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.VpnGatewayConnectionId = connectionId.ID()
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VPNGatewayConnectionPacketCaptureResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			id, err := parse.VpnConnectionPacketCaptureID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VPNGatewayConnectionPacketCaptureModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			connectionId := commonids.NewVPNConnectionID(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.VpnConnectionName)
+
+			locks.ByName(connectionId.GatewayName, VPNGatewayResourceName)
+			defer locks.UnlockByName(connectionId.GatewayName, VPNGatewayResourceName)
+
+			input := virtualwans.VpnConnectionPacketCaptureStopParameters{
+				SasUrl: pointer.To(model.StorageContainerSasUrl),
+			}
+			if len(model.VpnLinkNames) > 0 {
+				input.LinkConnectionNames = pointer.To(model.VpnLinkNames)
+			}
+
+			if err := client.VpnConnectionsStopPacketCaptureThenPoll(ctx, connectionId, input); err != nil {
+				return fmt.Errorf("stopping %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VPNGatewayConnectionPacketCaptureResource struct{}
+
+func TestAccVpnGatewayConnectionPacketCapture_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_connection_packet_capture", "test")
+	r := VPNGatewayConnectionPacketCaptureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccVpnGatewayConnectionPacketCapture_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_connection_packet_capture", "test")
+	r := VPNGatewayConnectionPacketCaptureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r VPNGatewayConnectionPacketCaptureResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VpnConnectionPacketCaptureID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionId := commonids.NewVPNConnectionID(id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.VpnConnectionName)
+	resp, err := clients.Network.VirtualWANs.VpnConnectionsGet(ctx, connectionId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r VPNGatewayConnectionPacketCaptureResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection_packet_capture" "test" {
+  vpn_gateway_connection_id = azurerm_vpn_gateway_connection.test.id
+  storage_container_sas_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+}
+`, r.template(data))
+}
+
+func (r VPNGatewayConnectionPacketCaptureResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection_packet_capture" "test" {
+  vpn_gateway_connection_id = azurerm_vpn_gateway_connection.test.id
+  storage_container_sas_url = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+  vpn_link_names            = ["link1"]
+
+  filter_data = jsonencode({
+    TracingFlags        = 11
+    MaxPacketBufferSize = 120
+    MaxFileSize         = 200
+    Filters = [
+      {
+        CaptureSingleDirectionTrafficOnly = true
+      }
+    ]
+  })
+}
+`, r.template(data))
+}
+
+func (VPNGatewayConnectionPacketCaptureResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vpn_gateway_connection" "test" {
+  name               = "acctest-VpnGwConn-%[2]d"
+  vpn_gateway_id     = azurerm_vpn_gateway.test.id
+  remote_vpn_site_id = azurerm_vpn_site.test.id
+
+  vpn_link {
+    name             = "link1"
+    vpn_site_link_id = azurerm_vpn_site.test.link[0].id
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "packetcaptures"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "%[4]s"
+  expiry = "%[5]s"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = true
+  }
+}
+`, VPNGatewayConnectionResource{}.template(data), data.RandomInteger, data.RandomString, time.Now().UTC().Format("2006-01-02"), time.Now().UTC().Add(48*time.Hour).Format("2006-01-02"))
+}
@@ -228,7 +228,29 @@ func TestAccVpnGatewayConnection_customBgpAddress(t *testing.T) {
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.customBgpAddress(data),
+			Config: r.customBgpAddress(data, 0, "169.254.21.5", "169.254.21.10"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVpnGatewayConnection_updateCustomBgpAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_gateway_connection", "test")
+	r := VPNGatewayConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customBgpAddress(data, 0, "169.254.21.5", "169.254.21.10"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customBgpAddress(data, 10, "169.254.21.6", "169.254.21.11"),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
@@ -580,7 +602,7 @@ resource "azurerm_vpn_gateway_connection" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
-func (r VPNGatewayConnectionResource) customBgpAddress(data acceptance.TestData) string {
+func (r VPNGatewayConnectionResource) customBgpAddress(data acceptance.TestData, routeWeight int, instance0Address, instance1Address string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -616,11 +638,11 @@ resource "azurerm_vpn_gateway" "test" {
     peer_weight = 0
 
     instance_0_bgp_peering_address {
-      custom_ips = ["169.254.21.5"]
+      custom_ips = ["169.254.21.5", "169.254.21.6"]
     }
 
     instance_1_bgp_peering_address {
-      custom_ips = ["169.254.21.10"]
+      custom_ips = ["169.254.21.10", "169.254.21.11"]
     }
   }
 }
@@ -651,19 +673,20 @@ resource "azurerm_vpn_gateway_connection" "test" {
     name             = "link1"
     vpn_site_link_id = azurerm_vpn_site.test.link[0].id
     bgp_enabled      = true
+    route_weight     = %[3]d
 
     custom_bgp_address {
-      ip_address          = "169.254.21.5"
+      ip_address          = "%[4]s"
       ip_configuration_id = azurerm_vpn_gateway.test.bgp_settings.0.instance_0_bgp_peering_address.0.ip_configuration_id
     }
 
     custom_bgp_address {
-      ip_address          = "169.254.21.10"
+      ip_address          = "%[5]s"
       ip_configuration_id = azurerm_vpn_gateway.test.bgp_settings.0.instance_1_bgp_peering_address.0.ip_configuration_id
     }
   }
 }
-`, data.RandomInteger, data.Locations.Primary)
+`, data.RandomInteger, data.Locations.Primary, routeWeight, instance0Address, instance1Address)
 }
 
 func (r VPNGatewayConnectionResource) routeMap(data acceptance.TestData, nameSuffix string) string {
@@ -4,8 +4,10 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -49,6 +51,8 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 			0: migration.WebApplicationFirewallPolicyV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(webApplicationFirewallPolicyCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -72,6 +76,7 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(webapplicationfirewallpolicies.WebApplicationFirewallActionAllow),
 								string(webapplicationfirewallpolicies.WebApplicationFirewallActionBlock),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionJSChallenge),
 								string(webapplicationfirewallpolicies.WebApplicationFirewallActionLog),
 							}, false),
 						},
@@ -403,6 +408,13 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.IntAtLeast(0),
 						},
 
+						"js_challenge_cookie_expiration_in_minutes": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntBetween(5, 1440),
+						},
+
 						"log_scrubbing": {
 							Type:     pluginsdk.TypeList,
 							MaxItems: 1,
@@ -716,13 +728,14 @@ func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *weba
 	fileUploadLimitInMb := v["file_upload_limit_in_mb"].(int)
 
 	result := webapplicationfirewallpolicies.PolicySettings{
-		State:                       pointer.To(enabled),
-		Mode:                        pointer.To(webapplicationfirewallpolicies.WebApplicationFirewallMode(mode)),
-		RequestBodyCheck:            pointer.To(requestBodyCheck),
-		MaxRequestBodySizeInKb:      pointer.To(int64(maxRequestBodySizeInKb)),
-		FileUploadLimitInMb:         pointer.To(int64(fileUploadLimitInMb)),
-		LogScrubbing:                expandWebApplicationFirewallPolicyLogScrubbing(v["log_scrubbing"].([]interface{})),
-		RequestBodyInspectLimitInKB: pointer.To(int64(v["request_body_inspect_limit_in_kb"].(int))),
+		State:                             pointer.To(enabled),
+		Mode:                              pointer.To(webapplicationfirewallpolicies.WebApplicationFirewallMode(mode)),
+		RequestBodyCheck:                  pointer.To(requestBodyCheck),
+		MaxRequestBodySizeInKb:            pointer.To(int64(maxRequestBodySizeInKb)),
+		FileUploadLimitInMb:               pointer.To(int64(fileUploadLimitInMb)),
+		LogScrubbing:                      expandWebApplicationFirewallPolicyLogScrubbing(v["log_scrubbing"].([]interface{})),
+		RequestBodyInspectLimitInKB:       pointer.To(int64(v["request_body_inspect_limit_in_kb"].(int))),
+		JsChallengeCookieExpirationInMins: pointer.To(int64(v["js_challenge_cookie_expiration_in_minutes"].(int))),
 	}
 
 	return &result
@@ -1074,6 +1087,7 @@ func flattenWebApplicationFirewallPolicyPolicySettings(input *webapplicationfire
 	result["file_upload_limit_in_mb"] = int(pointer.From(input.FileUploadLimitInMb))
 	result["log_scrubbing"] = flattenWebApplicationFirewallPolicyLogScrubbing(input.LogScrubbing)
 	result["request_body_inspect_limit_in_kb"] = pointer.From(input.RequestBodyInspectLimitInKB)
+	result["js_challenge_cookie_expiration_in_minutes"] = pointer.From(input.JsChallengeCookieExpirationInMins)
 
 	return []interface{}{result}
 }
@@ -1328,3 +1342,116 @@ func flattenWebApplicationFirewallPoliciesSubResourcesToIDs(input *[]webapplicat
 
 	return ids
 }
+
+// webApplicationFirewallPolicyRuleGroupRuleIdPrefixes maps the rule groups of the OWASP 3.x rule sets to the prefix shared by
+// the IDs of the rules within them, e.g. rule `920300` belongs to `REQUEST-920-PROTOCOL-ENFORCEMENT`
+var webApplicationFirewallPolicyRuleGroupRuleIdPrefixes = map[string]string{
+	"REQUEST-911-METHOD-ENFORCEMENT":                  "911",
+	"REQUEST-913-SCANNER-DETECTION":                   "913",
+	"REQUEST-920-PROTOCOL-ENFORCEMENT":                "920",
+	"REQUEST-921-PROTOCOL-ATTACK":                     "921",
+	"REQUEST-930-APPLICATION-ATTACK-LFI":              "930",
+	"REQUEST-931-APPLICATION-ATTACK-RFI":              "931",
+	"REQUEST-932-APPLICATION-ATTACK-RCE":              "932",
+	"REQUEST-933-APPLICATION-ATTACK-PHP":              "933",
+	"REQUEST-941-APPLICATION-ATTACK-XSS":              "941",
+	"REQUEST-942-APPLICATION-ATTACK-SQLI":             "942",
+	"REQUEST-943-APPLICATION-ATTACK-SESSION-FIXATION": "943",
+	"REQUEST-944-APPLICATION-ATTACK-JAVA":             "944",
+}
+
+// webApplicationFirewallPolicyDefaultRuleSetRuleGroups are the rule groups of the Microsoft Default rule sets - the IDs of the
+// rules within these groups don't follow a single prefix (e.g. `SQLI` in version 2.1 contains rules `99031001` to `99031004`)
+// and as such are only validated by the API
+var webApplicationFirewallPolicyDefaultRuleSetRuleGroups = []string{
+	"METHOD-ENFORCEMENT",
+	"PROTOCOL-ENFORCEMENT",
+	"PROTOCOL-ATTACK",
+	"LFI",
+	"RFI",
+	"RCE",
+	"PHP",
+	"NODEJS",
+	"XSS",
+	"SQLI",
+	"FIX",
+	"JAVA",
+}
+
+// webApplicationFirewallPolicyRuleGroupRuleSetType returns the rule set type (and whether it's an OWASP 3.x version) which a
+// rule group belongs to - rule groups such as `General` which are shared between rule sets return an empty string
+func webApplicationFirewallPolicyRuleGroupRuleSetType(ruleGroupName string) (ruleSetType string, owaspThree bool) {
+	switch {
+	case strings.HasPrefix(ruleGroupName, "REQUEST-"):
+		return "OWASP", true
+	case strings.HasPrefix(ruleGroupName, "crs_"):
+		return "OWASP", false
+	case strings.HasPrefix(ruleGroupName, "MS-ThreatIntel-"):
+		return "Microsoft_DefaultRuleSet", false
+	}
+
+	switch ruleGroupName {
+	case "BadBots", "GoodBots", "KnownBadBots", "UnknownBots":
+		return "Microsoft_BotManagerRuleSet", false
+	}
+
+	if utils.SliceContainsValue(webApplicationFirewallPolicyDefaultRuleSetRuleGroups, ruleGroupName) {
+		return "Microsoft_DefaultRuleSet", false
+	}
+
+	return "", false
+}
+
+func webApplicationFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	managedRules := d.Get("managed_rules").([]interface{})
+	if len(managedRules) == 0 || managedRules[0] == nil {
+		return nil
+	}
+
+	managedRuleSets := managedRules[0].(map[string]interface{})["managed_rule_set"].([]interface{})
+	for _, raw := range managedRuleSets {
+		if raw == nil {
+			continue
+		}
+		ruleSet := raw.(map[string]interface{})
+		ruleSetType := ruleSet["type"].(string)
+		ruleSetVersion := ruleSet["version"].(string)
+
+		for _, rawOverride := range ruleSet["rule_group_override"].([]interface{}) {
+			if rawOverride == nil {
+				continue
+			}
+			override := rawOverride.(map[string]interface{})
+			ruleGroupName := override["rule_group_name"].(string)
+
+			// the values may not be known until apply, in which case these are validated by the API instead
+			if ruleGroupName == "" || ruleSetType == "" || ruleSetVersion == "" {
+				continue
+			}
+
+			expectedType, owaspThree := webApplicationFirewallPolicyRuleGroupRuleSetType(ruleGroupName)
+			if expectedType != "" && expectedType != ruleSetType {
+				return fmt.Errorf("the rule group %q is part of the `%s` rule set and can't be overridden in a `%s` rule set", ruleGroupName, expectedType, ruleSetType)
+			}
+			if expectedType == "OWASP" && owaspThree != strings.HasPrefix(ruleSetVersion, "3.") {
+				return fmt.Errorf("the rule group %q isn't part of version %q of the `OWASP` rule set", ruleGroupName, ruleSetVersion)
+			}
+
+			prefix, ok := webApplicationFirewallPolicyRuleGroupRuleIdPrefixes[ruleGroupName]
+			if !ok {
+				continue
+			}
+			for _, rawRule := range override["rule"].([]interface{}) {
+				if rawRule == nil {
+					continue
+				}
+				ruleId := rawRule.(map[string]interface{})["id"].(string)
+				if ruleId != "" && !strings.HasPrefix(ruleId, prefix) {
+					return fmt.Errorf("the rule %q isn't part of the rule group %q, the IDs of the rules within this group start with %q", ruleId, ruleGroupName, prefix)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/webapplicationfirewallpolicies"
@@ -359,6 +360,43 @@ func TestAccWebApplicationFirewallPolicy_ManagedRuleSetDRS(t *testing.T) {
 	})
 }
 
+func TestAccWebApplicationFirewallPolicy_jsChallenge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
+	r := WebApplicationFirewallResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.jsChallenge(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_rules.0.action").HasValue("JSChallenge"),
+				check.That(data.ResourceName).Key("policy_settings.0.js_challenge_cookie_expiration_in_minutes").HasValue("60"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebApplicationFirewallPolicy_invalidRuleGroupOverride(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
+	r := WebApplicationFirewallResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.ruleGroupOverride(data, "OWASP", "3.2", "REQUEST-920-PROTOCOL-ENFORCEMENT", "942100"),
+			ExpectError: regexp.MustCompile("the rule \"942100\" isn't part of the rule group"),
+		},
+		{
+			Config:      r.ruleGroupOverride(data, "Microsoft_DefaultRuleSet", "2.1", "REQUEST-920-PROTOCOL-ENFORCEMENT", "920300"),
+			ExpectError: regexp.MustCompile("is part of the `OWASP` rule set"),
+		},
+		{
+			Config:      r.ruleGroupOverride(data, "OWASP", "2.2.9", "REQUEST-920-PROTOCOL-ENFORCEMENT", "920300"),
+			ExpectError: regexp.MustCompile("isn't part of version \"2.2.9\""),
+		},
+	})
+}
+
 func TestAccWebApplicationFirewallPolicy_updateCustomRules(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
 	r := WebApplicationFirewallResource{}
@@ -1369,6 +1407,90 @@ resource "azurerm_web_application_firewall_policy" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (WebApplicationFirewallResource) jsChallenge(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  custom_rules {
+    name      = "Rule1"
+    priority  = 1
+    rule_type = "MatchRule"
+    action    = "JSChallenge"
+
+    match_conditions {
+      match_variables {
+        variable_name = "RemoteAddr"
+      }
+
+      operator           = "IPMatch"
+      negation_condition = false
+      match_values       = ["192.168.1.0/24"]
+    }
+  }
+
+  policy_settings {
+    enabled                                   = true
+    mode                                      = "Prevention"
+    js_challenge_cookie_expiration_in_minutes = 60
+  }
+
+  managed_rules {
+    managed_rule_set {
+      type    = "Microsoft_DefaultRuleSet"
+      version = "2.1"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (WebApplicationFirewallResource) ruleGroupOverride(data acceptance.TestData, ruleSetType, ruleSetVersion, ruleGroupName, ruleId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  managed_rules {
+    managed_rule_set {
+      type    = "%[3]s"
+      version = "%[4]s"
+
+      rule_group_override {
+        rule_group_name = "%[5]s"
+        rule {
+          id      = "%[6]s"
+          enabled = true
+          action  = "Log"
+        }
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, ruleSetType, ruleSetVersion, ruleGroupName, ruleId)
+}
+
 func (WebApplicationFirewallResource) withManagedRuleSetDRS(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -31,6 +31,7 @@ func TestAccNetworkFunctionCollectorPolicy(t *testing.T) {
 			"requiresImport": testAccNetworkFunctionCollectorPolicy_requiresImport,
 			"complete":       testAccNetworkFunctionCollectorPolicy_complete,
 			"update":         testAccNetworkFunctionCollectorPolicy_update,
+			"logAnalytics":   testAccNetworkFunctionCollectorPolicy_logAnalytics,
 		},
 	})
 }
@@ -98,6 +99,20 @@ func testAccNetworkFunctionCollectorPolicy_update(t *testing.T) {
 	})
 }
 
+func testAccNetworkFunctionCollectorPolicy_logAnalytics(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_collector_policy", "test")
+	r := NetworkFunctionCollectorPolicyResource{}
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.logAnalytics(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r NetworkFunctionCollectorPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := collectorpolicies.ParseCollectorPolicyID(state.ID)
 	if err != nil {
@@ -262,3 +277,30 @@ resource "azurerm_network_function_collector_policy" "test" {
 }
 `, template, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r NetworkFunctionCollectorPolicyResource) logAnalytics(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctest-ds-%d"
+  target_resource_id         = azurerm_network_function_azure_traffic_collector.test.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  enabled_log {
+    category_group = "allLogs"
+  }
+
+  depends_on = [
+    azurerm_network_function_collector_policy.test
+  ]
+}
+`, r.basic(data), data.RandomInteger, data.RandomInteger)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -149,7 +150,12 @@ func (r SpacecraftResource) Read() sdk.ResourceFunc {
 
 			resp, err := client.Get(ctx, *id)
 			if err != nil {
-				if response.WasNotFound(resp.HttpResponse) {
+				retired := resourceproviders.WasRetired(resp.HttpResponse, resourceproviders.RetiredNamespaceOrbital)
+				if retired && metadata.Client.Features.RetiredServices.RemoveFromStateWhenUnavailable {
+					metadata.Logger.Infof("the Resource Provider for %s has been retired - removing from state", *id)
+					return metadata.MarkAsGone(id)
+				}
+				if !retired && response.WasNotFound(resp.HttpResponse) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("reading %s: %+v", *id, err)
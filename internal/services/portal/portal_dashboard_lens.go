@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	dashboardPartTypeMarkdown     = "Extension/HubsExtension/PartType/MarkdownPart"
+	dashboardPartTypeMonitorChart = "Extension/HubsExtension/PartType/MonitorChartPart"
+	dashboardPartTypeWorkbook     = "Extension/AppInsightsExtension/PartType/NotebookPinnedPart"
+)
+
+// the Portal uses numeric values for the aggregation and chart types of a Metrics Chart, these map them to
+// the names exposed in the schema
+var (
+	dashboardMetricsChartAggregations = map[string]int64{
+		"Sum":     1,
+		"Minimum": 2,
+		"Maximum": 3,
+		"Average": 4,
+		"Count":   7,
+	}
+
+	dashboardMetricsChartTypes = map[string]int64{
+		"Bar":     1,
+		"Line":    2,
+		"Area":    3,
+		"Scatter": 4,
+	}
+)
+
+func dashboardLensSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		ExactlyOneOf: []string{"dashboard_properties", "lens"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"markdown_tile": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: dashboardTileSchema(map[string]*pluginsdk.Schema{
+							"content": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"title": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"subtitle": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						}),
+					},
+				},
+
+				"metrics_chart_tile": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: dashboardTileSchema(map[string]*pluginsdk.Schema{
+							"resource_id": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+
+							"metric_namespace": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"metric_name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"aggregation": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "Average",
+								ValidateFunc: validation.StringInSlice(dashboardMapKeys(dashboardMetricsChartAggregations), false),
+							},
+
+							"chart_type": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "Line",
+								ValidateFunc: validation.StringInSlice(dashboardMapKeys(dashboardMetricsChartTypes), false),
+							},
+
+							"title": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						}),
+					},
+				},
+
+				"workbook_tile": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: dashboardTileSchema(map[string]*pluginsdk.Schema{
+							"workbook_id": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+
+							"source_id": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								Default:      "Azure Monitor",
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+// dashboardTileSchema adds the arguments defining the position of a tile, which are common to all tile types
+func dashboardTileSchema(input map[string]*pluginsdk.Schema) map[string]*pluginsdk.Schema {
+	input["x"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+
+	input["y"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+	}
+
+	input["col_span"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+
+	input["row_span"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+
+	return input
+}
+
+func dashboardMapKeys(input map[string]int64) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func expandDashboardLenses(input []interface{}) *dashboard.DashboardProperties {
+	lenses := make(map[string]dashboard.DashboardLens)
+
+	for i, item := range input {
+		lens := dashboard.DashboardLens{
+			Order: int64(i),
+			Parts: make(map[string]dashboard.DashboardParts),
+		}
+
+		// a lens block containing no tiles is passed as nil
+		raw, _ := item.(map[string]interface{})
+
+		parts := make([]dashboard.DashboardParts, 0)
+		markdownTiles, _ := raw["markdown_tile"].([]interface{})
+		for _, v := range markdownTiles {
+			tile := v.(map[string]interface{})
+			parts = append(parts, dashboard.DashboardParts{
+				Position: expandDashboardTilePosition(tile),
+				Metadata: pointer.To[interface{}](map[string]interface{}{
+					"type":   dashboardPartTypeMarkdown,
+					"inputs": []interface{}{},
+					"settings": map[string]interface{}{
+						"content": map[string]interface{}{
+							"settings": map[string]interface{}{
+								"content":        tile["content"].(string),
+								"title":          tile["title"].(string),
+								"subtitle":       tile["subtitle"].(string),
+								"markdownSource": 1,
+							},
+						},
+					},
+				}),
+			})
+		}
+
+		metricsChartTiles, _ := raw["metrics_chart_tile"].([]interface{})
+		for _, v := range metricsChartTiles {
+			tile := v.(map[string]interface{})
+			chart := map[string]interface{}{
+				"metrics": []interface{}{
+					map[string]interface{}{
+						"resourceMetadata": map[string]interface{}{
+							"id": tile["resource_id"].(string),
+						},
+						"name":            tile["metric_name"].(string),
+						"namespace":       tile["metric_namespace"].(string),
+						"aggregationType": dashboardMetricsChartAggregations[tile["aggregation"].(string)],
+					},
+				},
+				"title": tile["title"].(string),
+				"visualization": map[string]interface{}{
+					"chartType": dashboardMetricsChartTypes[tile["chart_type"].(string)],
+				},
+			}
+			parts = append(parts, dashboard.DashboardParts{
+				Position: expandDashboardTilePosition(tile),
+				Metadata: pointer.To[interface{}](map[string]interface{}{
+					"type": dashboardPartTypeMonitorChart,
+					"inputs": []interface{}{
+						map[string]interface{}{
+							"name": "options",
+							"value": map[string]interface{}{
+								"chart": chart,
+							},
+						},
+						map[string]interface{}{
+							"name":       "sharedTimeRange",
+							"isOptional": true,
+						},
+					},
+				}),
+			})
+		}
+
+		workbookTiles, _ := raw["workbook_tile"].([]interface{})
+		for _, v := range workbookTiles {
+			tile := v.(map[string]interface{})
+			parts = append(parts, dashboard.DashboardParts{
+				Position: expandDashboardTilePosition(tile),
+				Metadata: pointer.To[interface{}](map[string]interface{}{
+					"type": dashboardPartTypeWorkbook,
+					"inputs": []interface{}{
+						map[string]interface{}{
+							"name":  "ComponentId",
+							"value": tile["source_id"].(string),
+						},
+						map[string]interface{}{
+							"name":  "ConfigurationId",
+							"value": tile["workbook_id"].(string),
+						},
+						map[string]interface{}{
+							"name":  "Type",
+							"value": "workbook",
+						},
+					},
+				}),
+			})
+		}
+
+		for j, part := range parts {
+			lens.Parts[strconv.Itoa(j)] = part
+		}
+
+		lenses[strconv.Itoa(i)] = lens
+	}
+
+	return &dashboard.DashboardProperties{
+		Lenses: &lenses,
+	}
+}
+
+func expandDashboardTilePosition(input map[string]interface{}) dashboard.DashboardPartsPosition {
+	return dashboard.DashboardPartsPosition{
+		X:       int64(input["x"].(int)),
+		Y:       int64(input["y"].(int)),
+		ColSpan: int64(input["col_span"].(int)),
+		RowSpan: int64(input["row_span"].(int)),
+	}
+}
+
+// flattenDashboardLenses flattens the parts of the Dashboard into the typed tiles, the parts are ordered by their keys
+// (as they were when expanded) so that the tiles are returned in a stable order - parts of any other type are skipped
+// since they can't be represented by the typed schema.
+func flattenDashboardLenses(input *dashboard.DashboardProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Lenses == nil {
+		return output
+	}
+
+	lenses := make([]dashboard.DashboardLens, 0)
+	for _, lens := range *input.Lenses {
+		lenses = append(lenses, lens)
+	}
+	sort.Slice(lenses, func(i, j int) bool {
+		return lenses[i].Order < lenses[j].Order
+	})
+
+	for _, lens := range lenses {
+		keys := make([]string, 0)
+		for k := range lens.Parts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			a, errA := strconv.Atoi(keys[i])
+			b, errB := strconv.Atoi(keys[j])
+			if errA != nil || errB != nil {
+				return keys[i] < keys[j]
+			}
+			return a < b
+		})
+
+		markdownTiles := make([]interface{}, 0)
+		metricsChartTiles := make([]interface{}, 0)
+		workbookTiles := make([]interface{}, 0)
+
+		for _, key := range keys {
+			part := lens.Parts[key]
+			metadata, ok := pointer.From(part.Metadata).(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			partType, _ := metadata["type"].(string)
+			switch partType {
+			case dashboardPartTypeMarkdown:
+				settings := dashboardNestedMap(metadata, "settings", "content", "settings")
+				tile := flattenDashboardTilePosition(part.Position)
+				tile["content"] = dashboardString(settings, "content")
+				tile["title"] = dashboardString(settings, "title")
+				tile["subtitle"] = dashboardString(settings, "subtitle")
+				markdownTiles = append(markdownTiles, tile)
+
+			case dashboardPartTypeMonitorChart:
+				chart := dashboardNestedMap(dashboardPartInputs(metadata)["options"], "chart")
+				tile := flattenDashboardTilePosition(part.Position)
+				tile["title"] = dashboardString(chart, "title")
+				tile["chart_type"] = dashboardMapKeyForValue(dashboardMetricsChartTypes, dashboardNestedMap(chart, "visualization")["chartType"])
+
+				if metrics, ok := chart["metrics"].([]interface{}); ok && len(metrics) > 0 {
+					metric, _ := metrics[0].(map[string]interface{})
+					tile["resource_id"] = dashboardString(dashboardNestedMap(metric, "resourceMetadata"), "id")
+					tile["metric_name"] = dashboardString(metric, "name")
+					tile["metric_namespace"] = dashboardString(metric, "namespace")
+					tile["aggregation"] = dashboardMapKeyForValue(dashboardMetricsChartAggregations, metric["aggregationType"])
+				}
+				metricsChartTiles = append(metricsChartTiles, tile)
+
+			case dashboardPartTypeWorkbook:
+				inputs := dashboardPartInputs(metadata)
+				tile := flattenDashboardTilePosition(part.Position)
+				tile["workbook_id"], _ = inputs["ConfigurationId"].(string)
+				tile["source_id"], _ = inputs["ComponentId"].(string)
+				workbookTiles = append(workbookTiles, tile)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"markdown_tile":      markdownTiles,
+			"metrics_chart_tile": metricsChartTiles,
+			"workbook_tile":      workbookTiles,
+		})
+	}
+
+	return output
+}
+
+func flattenDashboardTilePosition(input dashboard.DashboardPartsPosition) map[string]interface{} {
+	return map[string]interface{}{
+		"x":        input.X,
+		"y":        input.Y,
+		"col_span": input.ColSpan,
+		"row_span": input.RowSpan,
+	}
+}
+
+// dashboardPartInputs returns the values of the inputs of a part keyed by their name
+func dashboardPartInputs(metadata map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	inputs, ok := metadata["inputs"].([]interface{})
+	if !ok {
+		return output
+	}
+
+	for _, v := range inputs {
+		input, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if name, ok := input["name"].(string); ok {
+			output[name] = input["value"]
+		}
+	}
+
+	return output
+}
+
+func dashboardNestedMap(input interface{}, keys ...string) map[string]interface{} {
+	current, _ := input.(map[string]interface{})
+	for _, key := range keys {
+		current, _ = current[key].(map[string]interface{})
+	}
+	return current
+}
+
+func dashboardString(input map[string]interface{}, key string) string {
+	v, _ := input[key].(string)
+	return v
+}
+
+func dashboardMapKeyForValue(input map[string]int64, value interface{}) string {
+	var v int64
+	switch t := value.(type) {
+	case float64:
+		v = int64(t)
+	case int64:
+		v = t
+	case int:
+		v = int64(t)
+	default:
+		return ""
+	}
+
+	for key, val := range input {
+		if val == v {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package portal
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+)
+
+func TestDashboardLenses_roundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"markdown_tile": []interface{}{
+				map[string]interface{}{
+					"x": 0, "y": 0, "col_span": 3, "row_span": 2,
+					"content":  "## Hello",
+					"title":    "Welcome",
+					"subtitle": "",
+				},
+			},
+			"metrics_chart_tile": []interface{}{
+				map[string]interface{}{
+					"x": 3, "y": 0, "col_span": 6, "row_span": 4,
+					"resource_id":      "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+					"metric_namespace": "microsoft.compute/virtualmachines",
+					"metric_name":      "Percentage CPU",
+					"aggregation":      "Maximum",
+					"chart_type":       "Area",
+					"title":            "CPU",
+				},
+			},
+			"workbook_tile": []interface{}{
+				map[string]interface{}{
+					"x": 0, "y": 2, "col_span": 3, "row_span": 2,
+					"workbook_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Insights/workbooks/00000000-0000-0000-0000-000000000000",
+					"source_id":   "Azure Monitor",
+				},
+			},
+		},
+		map[string]interface{}{
+			"markdown_tile": []interface{}{
+				map[string]interface{}{
+					"x": 0, "y": 0, "col_span": 2, "row_span": 2,
+					"content":  "second",
+					"title":    "",
+					"subtitle": "sub",
+				},
+				map[string]interface{}{
+					"x": 2, "y": 0, "col_span": 2, "row_span": 2,
+					"content":  "third",
+					"title":    "",
+					"subtitle": "",
+				},
+			},
+			"metrics_chart_tile": []interface{}{},
+			"workbook_tile":      []interface{}{},
+		},
+	}
+
+	// round-trip the expanded properties through JSON, as the API would
+	expanded := expandDashboardLenses(input)
+	raw, err := json.Marshal(expanded)
+	if err != nil {
+		t.Fatalf("marshalling: %+v", err)
+	}
+	var properties dashboard.DashboardProperties
+	if err := json.Unmarshal(raw, &properties); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	actual := flattenDashboardLenses(&properties)
+
+	// the positions are flattened as int64, normalise the input to compare
+	expected := normaliseDashboardLensesForTest(input)
+	if !reflect.DeepEqual(normaliseDashboardLensesForTest(actual), expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestDashboardLenses_skipsUnknownParts(t *testing.T) {
+	raw := `{"lenses":{"0":{"order":0,"parts":{"0":{"position":{"x":0,"y":0,"colSpan":2,"rowSpan":2},"metadata":{"type":"Extension/HubsExtension/PartType/ClockPart","inputs":[]}}}}}}`
+	var properties dashboard.DashboardProperties
+	if err := json.Unmarshal([]byte(raw), &properties); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	actual := flattenDashboardLenses(&properties)
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 lens but got %d", len(actual))
+	}
+
+	lens := actual[0].(map[string]interface{})
+	for _, key := range []string{"markdown_tile", "metrics_chart_tile", "workbook_tile"} {
+		if tiles := lens[key].([]interface{}); len(tiles) != 0 {
+			t.Fatalf("expected no %s but got %d", key, len(tiles))
+		}
+	}
+}
+
+func normaliseDashboardLensesForTest(input []interface{}) []interface{} {
+	output := make([]interface{}, 0)
+	for _, l := range input {
+		lens := make(map[string]interface{})
+		for key, tiles := range l.(map[string]interface{}) {
+			normalised := make([]interface{}, 0)
+			for _, t := range tiles.([]interface{}) {
+				tile := make(map[string]interface{})
+				for k, v := range t.(map[string]interface{}) {
+					if i, ok := v.(int); ok {
+						v = int64(i)
+					}
+					tile[k] = v
+				}
+				normalised = append(normalised, tile)
+			}
+			lens[key] = normalised
+		}
+		output = append(output, lens)
+	}
+	return output
+}
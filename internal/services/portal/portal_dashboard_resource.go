@@ -4,6 +4,7 @@
 package portal
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -58,11 +59,25 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 
 			"dashboard_properties": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validate.DashboardProperties,
 				StateFunc:    utils.NormalizeJson,
+				ExactlyOneOf: []string{"dashboard_properties", "lens"},
 			},
+
+			"lens": dashboardLensSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// when the Dashboard is defined using the typed schema the JSON representation is computed from it
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.HasChange("lens") && len(diff.Get("lens").([]interface{})) > 0 {
+					return diff.SetNewComputed("dashboard_properties")
+				}
+				return nil
+			}),
+		),
 	}
 }
 
@@ -91,14 +106,18 @@ func resourcePortalDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	var dashboardProperties dashboard.DashboardProperties
+	if lenses := d.Get("lens").([]interface{}); len(lenses) > 0 {
+		props.Properties = expandDashboardLenses(lenses)
+	} else {
+		var dashboardProperties dashboard.DashboardProperties
 
-	dashboardPropsRaw := d.Get("dashboard_properties").(string)
-	if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
-		return fmt.Errorf("parsing JSON: %+v", err)
-	}
+		dashboardPropsRaw := d.Get("dashboard_properties").(string)
+		if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
+			return fmt.Errorf("parsing JSON: %+v", err)
+		}
 
-	props.Properties = &dashboardProperties
+		props.Properties = &dashboardProperties
+	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s %+v", id, err)
@@ -140,6 +159,14 @@ func resourcePortalDashboardRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("parsing JSON for Dashboard Properties: %+v", err)
 			}
 			d.Set("dashboard_properties", string(v))
+
+			// the typed tiles are only set when the Dashboard is defined using them, since a Dashboard defined
+			// using JSON can contain parts which can't be represented by the typed schema
+			if len(d.Get("lens").([]interface{})) > 0 {
+				if err := d.Set("lens", flattenDashboardLenses(props)); err != nil {
+					return fmt.Errorf("setting `lens`: %+v", err)
+				}
+			}
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
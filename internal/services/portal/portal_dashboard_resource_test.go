@@ -60,6 +60,29 @@ func TestAccPortalDashboard_hiddenTitle(t *testing.T) {
 	})
 }
 
+func TestAccPortalDashboard_typedTiles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.typedTiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dashboard_properties").IsNotEmpty(),
+			),
+		},
+		// the typed tiles can't be determined from the JSON when importing
+		data.ImportStep("lens"),
+		{
+			Config: r.typedTilesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("lens"),
+	})
+}
+
 func (PortalDashboardResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dashboard.ParseDashboardID(state.ID)
 	if err != nil {
@@ -207,3 +230,95 @@ DASH
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (PortalDashboardResource) typedTiles(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    markdown_tile {
+      x        = 0
+      y        = 0
+      col_span = 3
+      row_span = 2
+      title    = "Test MD Tile"
+      content  = "## This is only a test :)"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (PortalDashboardResource) typedTilesUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    markdown_tile {
+      x        = 0
+      y        = 0
+      col_span = 3
+      row_span = 2
+      title    = "Test MD Tile"
+      subtitle = "Updated"
+      content  = "## This is only a test :)"
+    }
+
+    metrics_chart_tile {
+      x                = 3
+      y                = 0
+      col_span         = 6
+      row_span         = 4
+      title            = "Transactions"
+      resource_id      = azurerm_storage_account.test.id
+      metric_namespace = "microsoft.storage/storageaccounts"
+      metric_name      = "Transactions"
+      aggregation      = "Sum"
+      chart_type       = "Bar"
+    }
+  }
+
+  lens {
+    markdown_tile {
+      x        = 0
+      y        = 0
+      col_span = 2
+      row_span = 2
+      content  = "Second Lens"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
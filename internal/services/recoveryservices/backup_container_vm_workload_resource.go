@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceBackupProtectionContainerVMWorkload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectionContainerVMWorkloadCreate,
+		Read:   resourceBackupProtectionContainerVMWorkloadRead,
+		Delete: resourceBackupProtectionContainerVMWorkloadDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := protectioncontainers.ParseProtectionContainerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"virtual_machine_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateVirtualMachineID,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(protectioncontainers.WorkloadTypeSAPHanaDatabase),
+					string(protectioncontainers.WorkloadTypeSQLDataBase),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceBackupProtectionContainerVMWorkloadCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opStatusClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := commonids.ParseVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := protectioncontainers.NewProtectionContainerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), "Azure", backupProtectionContainerVMWorkloadName(*virtualMachineId))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_backup_container_vm_workload", id.ID())
+	}
+
+	parameters := protectioncontainers.ProtectionContainerResource{
+		Properties: &protectioncontainers.AzureVMAppContainerProtectionContainer{
+			SourceResourceId:     pointer.To(virtualMachineId.ID()),
+			FriendlyName:         pointer.To(virtualMachineId.VirtualMachineName),
+			BackupManagementType: pointer.To(protectioncontainers.BackupManagementTypeAzureWorkload),
+			WorkloadType:         pointer.To(protectioncontainers.WorkloadType(d.Get("workload_type").(string))),
+		},
+	}
+
+	resp, err := client.Register(ctx, id, parameters)
+	if err != nil {
+		return fmt.Errorf("registering %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location() // Operation ID found in the Location header
+	if locationURL == nil || err != nil {
+		return fmt.Errorf("unable to determine operation URL for %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+
+	operationID := parsedLocation.Path["operationResults"]
+	if err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opStatusClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for the registration of %s: %+v", id, err)
+	}
+
+	d.SetId(handleAzureSdkForGoBug2824(id.ID()))
+
+	return resourceBackupProtectionContainerVMWorkloadRead(d, meta)
+}
+
+func resourceBackupProtectionContainerVMWorkloadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := protectioncontainers.ParseProtectionContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if model := resp.Model; model != nil {
+		if properties, ok := model.Properties.(protectioncontainers.AzureVMAppContainerProtectionContainer); ok {
+			virtualMachineId := ""
+			if v := properties.SourceResourceId; v != nil {
+				parsed, err := commonids.ParseVirtualMachineIDInsensitively(*v)
+				if err != nil {
+					return err
+				}
+				virtualMachineId = parsed.ID()
+			}
+			d.Set("virtual_machine_id", virtualMachineId)
+			d.Set("workload_type", string(pointer.From(properties.WorkloadType)))
+		}
+	}
+
+	return nil
+}
+
+func resourceBackupProtectionContainerVMWorkloadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := protectioncontainers.ParseProtectionContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Unregister(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("unregistering %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("unregistering %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["backupOperationResults"]
+
+	if err = resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for %s to be unregistered: %+v", id, err)
+	}
+
+	return nil
+}
+
+// backupProtectionContainerVMWorkloadName returns the name of the protection container which Azure Backup uses for the
+// workloads (e.g. SAP HANA or SQL Server databases) running within a Virtual Machine
+func backupProtectionContainerVMWorkloadName(id commonids.VirtualMachineId) string {
+	return strings.Join([]string{"VMAppContainer", "compute", id.ResourceGroupName, id.VirtualMachineName}, ";")
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotectableitems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectionpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceBackupProtectedVMWorkload() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceBackupProtectedVMWorkloadCreateUpdate,
+		Read:   resourceBackupProtectedVMWorkloadRead,
+		Update: resourceBackupProtectedVMWorkloadCreateUpdate,
+		Delete: resourceBackupProtectedVMWorkloadDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := protecteditems.ParseProtectedItemID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(80 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(80 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RecoveryServicesVaultName,
+			},
+
+			"source_vm_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateVirtualMachineID,
+			},
+
+			"workload_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(protecteditems.DataSourceTypeSAPHanaDatabase),
+					string(protecteditems.DataSourceTypeSQLDataBase),
+				}, false),
+			},
+
+			"instance_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"backup_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: protectionpolicies.ValidateBackupPolicyID,
+			},
+		},
+	}
+}
+
+func resourceBackupProtectedVMWorkloadCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	virtualMachineId, err := commonids.ParseVirtualMachineID(d.Get("source_vm_id").(string))
+	if err != nil {
+		return err
+	}
+
+	workloadType := d.Get("workload_type").(string)
+	containerId := protectioncontainers.NewProtectionContainerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("recovery_vault_name").(string), "Azure", backupProtectionContainerVMWorkloadName(*virtualMachineId))
+
+	var id protecteditems.ProtectedItemId
+	if d.IsNewResource() {
+		// the database has a user defined name, but the name of the protected item is only known to Azure Backup
+		itemName, err := resourceBackupProtectedVMWorkloadFindProtectableItem(ctx, d, meta, containerId, workloadType)
+		if err != nil {
+			return err
+		}
+
+		id = protecteditems.NewProtectedItemID(subscriptionId, containerId.ResourceGroupName, containerId.VaultName, containerId.BackupFabricName, containerId.ProtectionContainerName, itemName)
+
+		existing, err := client.Get(ctx, id, protecteditems.GetOperationOptions{})
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_backup_protected_vm_workload", id.ID())
+		}
+	} else {
+		parsed, err := protecteditems.ParseProtectedItemID(d.Id())
+		if err != nil {
+			return err
+		}
+		id = *parsed
+	}
+
+	item := protecteditems.ProtectedItemResource{}
+	switch protecteditems.DataSourceType(workloadType) {
+	case protecteditems.DataSourceTypeSAPHanaDatabase:
+		item.Properties = &protecteditems.AzureVMWorkloadSAPHanaDatabaseProtectedItem{
+			PolicyId:         pointer.To(d.Get("backup_policy_id").(string)),
+			SourceResourceId: pointer.To(virtualMachineId.ID()),
+			WorkloadType:     pointer.To(protecteditems.DataSourceTypeSAPHanaDatabase),
+		}
+	case protecteditems.DataSourceTypeSQLDataBase:
+		item.Properties = &protecteditems.AzureVMWorkloadSQLDatabaseProtectedItem{
+			PolicyId:         pointer.To(d.Get("backup_policy_id").(string)),
+			SourceResourceId: pointer.To(virtualMachineId.ID()),
+			WorkloadType:     pointer.To(protecteditems.DataSourceTypeSQLDataBase),
+		}
+	}
+
+	resp, err := client.CreateOrUpdate(ctx, id, item)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("creating/updating %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.String()))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["operationResults"]
+
+	if _, err := resourceBackupProtectedFileShareWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceBackupProtectedVMWorkloadRead(d, meta)
+}
+
+// resourceBackupProtectedVMWorkloadFindProtectableItem discovers the databases within the protection container and
+// returns the name Azure Backup uses for the configured database (e.g. `SQLDataBase;MSSQLSERVER;example`)
+func resourceBackupProtectedVMWorkloadFindProtectableItem(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, containerId protectioncontainers.ProtectionContainerId, workloadType string) (string, error) {
+	protectableClient := meta.(*clients.Client).RecoveryServices.ProtectableItemsClient
+	protectionContainerClient := meta.(*clients.Client).RecoveryServices.BackupProtectionContainersClient
+	opResultClient := meta.(*clients.Client).RecoveryServices.ProtectionContainerOperationResultsClient
+
+	instanceName := d.Get("instance_name").(string)
+	databaseName := d.Get("database_name").(string)
+
+	// databases created after the Virtual Machine was registered are only listed once they've been inquired
+	respContainer, err := protectionContainerClient.Inquire(ctx, containerId, protectioncontainers.InquireOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("workloadType eq '%s'", workloadType)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("inquiring the workloads of %s: %+v", containerId, err)
+	}
+
+	locationURL, err := respContainer.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return "", fmt.Errorf("inquiring the workloads of %s: Location header missing or empty", containerId)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return "", err
+	}
+	operationID := parsedLocation.Path["operationResults"]
+
+	state := &pluginsdk.StateChangeConf{
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+		Pending:    []string{"202"},
+		Target:     []string{"200", "204"},
+		Refresh:    protectionContainerOperationResultsRefreshFunc(ctx, opResultClient, containerId.VaultName, containerId.ResourceGroupName, containerId.ProtectionContainerName, operationID),
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+	}
+
+	if _, err := state.WaitForStateContext(ctx); err != nil {
+		return "", fmt.Errorf("waiting for the workloads of %s to be inquired: %+v", containerId, err)
+	}
+
+	vaultId := backupprotectableitems.NewVaultID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.VaultName)
+	protectableItems, err := protectableClient.ListComplete(ctx, vaultId, backupprotectableitems.ListOperationOptions{
+		Filter: pointer.To("backupManagementType eq 'AzureWorkload'"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("listing the protectable items of %s: %+v", vaultId, err)
+	}
+
+	containerSegment := strings.ToLower(fmt.Sprintf("/protectionContainers/%s/", containerId.ProtectionContainerName))
+	for _, protectableItem := range protectableItems.Items {
+		if protectableItem.Id == nil || !strings.Contains(strings.ToLower(*protectableItem.Id), containerSegment) {
+			continue
+		}
+
+		var friendlyName, parentName *string
+		switch v := protectableItem.Properties.(type) {
+		case backupprotectableitems.AzureVMWorkloadSAPHanaDatabaseProtectableItem:
+			if workloadType != string(protecteditems.DataSourceTypeSAPHanaDatabase) {
+				continue
+			}
+			friendlyName, parentName = v.FriendlyName, v.ParentName
+		case backupprotectableitems.AzureVMWorkloadSQLDatabaseProtectableItem:
+			if workloadType != string(protecteditems.DataSourceTypeSQLDataBase) {
+				continue
+			}
+			friendlyName, parentName = v.FriendlyName, v.ParentName
+		default:
+			continue
+		}
+
+		if strings.EqualFold(pointer.From(friendlyName), databaseName) && strings.EqualFold(pointer.From(parentName), instanceName) {
+			return pointer.From(protectableItem.Name), nil
+		}
+	}
+
+	return "", fmt.Errorf("database %q of instance %q wasn't found in the protectable items of %s - make sure the Virtual Machine is registered with the Recovery Services Vault using the workload type %q and the database isn't already protected", databaseName, instanceName, containerId, workloadType)
+}
+
+func resourceBackupProtectedVMWorkloadRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := protecteditems.ParseProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading %s", *id)
+
+	resp, err := client.Get(ctx, *id, protecteditems.GetOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("recovery_vault_name", id.VaultName)
+
+	if model := resp.Model; model != nil {
+		var sourceResourceId, friendlyName, parentName, policyId *string
+		switch item := model.Properties.(type) {
+		case protecteditems.AzureVMWorkloadSAPHanaDatabaseProtectedItem:
+			d.Set("workload_type", string(protecteditems.DataSourceTypeSAPHanaDatabase))
+			sourceResourceId, friendlyName, parentName, policyId = item.SourceResourceId, item.FriendlyName, item.ParentName, item.PolicyId
+		case protecteditems.AzureVMWorkloadSQLDatabaseProtectedItem:
+			d.Set("workload_type", string(protecteditems.DataSourceTypeSQLDataBase))
+			sourceResourceId, friendlyName, parentName, policyId = item.SourceResourceId, item.FriendlyName, item.ParentName, item.PolicyId
+		default:
+			return fmt.Errorf("%s isn't a SAP HANA or SQL Server database within a Virtual Machine", id)
+		}
+
+		vmId := ""
+		if sourceResourceId != nil {
+			parsed, err := commonids.ParseVirtualMachineIDInsensitively(*sourceResourceId)
+			if err != nil {
+				return err
+			}
+			vmId = parsed.ID()
+		}
+		d.Set("source_vm_id", vmId)
+		d.Set("instance_name", pointer.From(parentName))
+		d.Set("database_name", pointer.From(friendlyName))
+
+		backupPolicyId := ""
+		if policyId != nil {
+			parsed, err := protectionpolicies.ParseBackupPolicyIDInsensitively(*policyId)
+			if err != nil {
+				return err
+			}
+			backupPolicyId = parsed.ID()
+		}
+		d.Set("backup_policy_id", backupPolicyId)
+	}
+
+	return nil
+}
+
+func resourceBackupProtectedVMWorkloadDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).RecoveryServices.ProtectedItemsClient
+	opClient := meta.(*clients.Client).RecoveryServices.BackupOperationStatusesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := protecteditems.ParseProtectedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	locationURL, err := resp.HttpResponse.Location()
+	if err != nil || locationURL == nil {
+		return fmt.Errorf("deleting %s: Location header missing or empty", id)
+	}
+
+	parsedLocation, err := azure.ParseAzureResourceID(handleAzureSdkForGoBug2824(locationURL.Path))
+	if err != nil {
+		return err
+	}
+	operationID := parsedLocation.Path["backupOperationResults"]
+
+	if _, err := resourceBackupProtectedFileShareWaitForOperation(ctx, opClient, id.VaultName, id.ResourceGroupName, operationID, d); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", id, err)
+	}
+
+	return nil
+}
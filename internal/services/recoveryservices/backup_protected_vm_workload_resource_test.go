@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type BackupProtectedVMWorkloadResource struct{}
+
+func TestAccBackupProtectedVMWorkload_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload", "test")
+	r := BackupProtectedVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccBackupProtectedVMWorkload_updatePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_backup_protected_vm_workload", "test")
+	r := BackupProtectedVMWorkloadResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updatedPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("backup_policy_id").MatchesOtherKey(check.That("azurerm_backup_policy_vm_workload.second").Key("id")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t BackupProtectedVMWorkloadResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := protecteditems.ParseProtectedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ProtectedItemsClient.Get(ctx, *id, protecteditems.GetOperationOptions{})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r BackupProtectedVMWorkloadResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_vm_workload" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  source_vm_id        = azurerm_backup_container_vm_workload.test.virtual_machine_id
+  workload_type       = "SQLDataBase"
+  instance_name       = "MSSQLSERVER"
+  database_name       = "model"
+  backup_policy_id    = azurerm_backup_policy_vm_workload.test.id
+}
+`, r.template(data))
+}
+
+func (r BackupProtectedVMWorkloadResource) updatedPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "second" {
+  name                = "acctest-bpvmw2-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+
+  workload_type = "SQLDataBase"
+
+  settings {
+    time_zone           = "UTC"
+    compression_enabled = false
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "03:00"
+    }
+
+    retention_daily {
+      count = 14
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 60
+    }
+
+    simple_retention {
+      count = 14
+    }
+  }
+}
+
+resource "azurerm_backup_protected_vm_workload" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+  source_vm_id        = azurerm_backup_container_vm_workload.test.virtual_machine_id
+  workload_type       = "SQLDataBase"
+  instance_name       = "MSSQLSERVER"
+  database_name       = "model"
+  backup_policy_id    = azurerm_backup_policy_vm_workload.second.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (BackupProtectedVMWorkloadResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm_workload" "test" {
+  name                = "acctest-bpvmw-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  recovery_vault_name = azurerm_recovery_services_vault.test.name
+
+  workload_type = "SQLDataBase"
+
+  settings {
+    time_zone           = "UTC"
+    compression_enabled = false
+  }
+
+  protection_policy {
+    policy_type = "Full"
+
+    backup {
+      frequency = "Daily"
+      time      = "15:00"
+    }
+
+    retention_daily {
+      count = 8
+    }
+  }
+
+  protection_policy {
+    policy_type = "Log"
+
+    backup {
+      frequency_in_minutes = 15
+    }
+
+    simple_retention {
+      count = 8
+    }
+  }
+}
+`, BackupProtectionContainerVMWorkloadResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotectableitems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/backupprotecteditems"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type BackupProtectionStatusDataSource struct{}
+
+type BackupProtectionStatusDataSourceModel struct {
+	ResourceGroupName string                       `tfschema:"resource_group_name"`
+	ProtectedItems    []BackupProtectedItemModel   `tfschema:"protected_item"`
+	UnprotectedItems  []BackupUnprotectedItemModel `tfschema:"unprotected_item"`
+}
+
+type BackupProtectedItemModel struct {
+	Id               string `tfschema:"id"`
+	VaultId          string `tfschema:"vault_id"`
+	SourceResourceId string `tfschema:"source_resource_id"`
+	FriendlyName     string `tfschema:"friendly_name"`
+	WorkloadType     string `tfschema:"workload_type"`
+	ProtectionState  string `tfschema:"protection_state"`
+	ProtectionStatus string `tfschema:"protection_status"`
+	LastBackupStatus string `tfschema:"last_backup_status"`
+	LastBackupTime   string `tfschema:"last_backup_time"`
+	PolicyId         string `tfschema:"policy_id"`
+}
+
+type BackupUnprotectedItemModel struct {
+	Id               string `tfschema:"id"`
+	VaultId          string `tfschema:"vault_id"`
+	SourceResourceId string `tfschema:"source_resource_id"`
+	FriendlyName     string `tfschema:"friendly_name"`
+	WorkloadType     string `tfschema:"workload_type"`
+	ProtectionState  string `tfschema:"protection_state"`
+}
+
+var _ sdk.DataSource = BackupProtectionStatusDataSource{}
+
+func (r BackupProtectionStatusDataSource) ResourceType() string {
+	return "azurerm_backup_protection_status"
+}
+
+func (r BackupProtectionStatusDataSource) ModelObject() interface{} {
+	return &BackupProtectionStatusDataSourceModel{}
+}
+
+func (r BackupProtectionStatusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_group_name": commonschema.ResourceGroupNameOptional(),
+	}
+}
+
+func (r BackupProtectionStatusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"protected_item": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"vault_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source_resource_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"friendly_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"workload_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"protection_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"protection_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_backup_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_backup_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"unprotected_item": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"vault_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source_resource_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"friendly_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"workload_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"protection_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r BackupProtectionStatusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			vaultsClient := metadata.Client.RecoveryServices.VaultsClient
+			protectedClient := metadata.Client.RecoveryServices.ProtectedItemsGroupClient
+			protectableClient := metadata.Client.RecoveryServices.ProtectableItemsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state BackupProtectionStatusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var id string
+			var vaultList []vaults.Vault
+			if state.ResourceGroupName != "" {
+				resourceGroupId := commonids.NewResourceGroupID(subscriptionId, state.ResourceGroupName)
+				resp, err := vaultsClient.ListByResourceGroupComplete(ctx, resourceGroupId)
+				if err != nil {
+					return fmt.Errorf("listing Recovery Services Vaults in %s: %+v", resourceGroupId, err)
+				}
+				id = resourceGroupId.ID()
+				vaultList = resp.Items
+			} else {
+				subId := commonids.NewSubscriptionID(subscriptionId)
+				resp, err := vaultsClient.ListBySubscriptionIdComplete(ctx, subId)
+				if err != nil {
+					return fmt.Errorf("listing Recovery Services Vaults in %s: %+v", subId, err)
+				}
+				id = subId.ID()
+				vaultList = resp.Items
+			}
+
+			state.ProtectedItems = make([]BackupProtectedItemModel, 0)
+			state.UnprotectedItems = make([]BackupUnprotectedItemModel, 0)
+
+			for _, vault := range vaultList {
+				if vault.Id == nil {
+					continue
+				}
+				vaultId, err := vaults.ParseVaultIDInsensitively(*vault.Id)
+				if err != nil {
+					return err
+				}
+
+				protectedVaultId := backupprotecteditems.NewVaultID(vaultId.SubscriptionId, vaultId.ResourceGroupName, vaultId.VaultName)
+				protectedItems, err := protectedClient.ListComplete(ctx, protectedVaultId, backupprotecteditems.DefaultListOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing protected items in %s: %+v", vaultId, err)
+				}
+				for _, item := range protectedItems.Items {
+					if v := flattenBackupProtectedItem(item, vaultId.ID()); v != nil {
+						state.ProtectedItems = append(state.ProtectedItems, *v)
+					}
+				}
+
+				// the protectable items have to be listed per backup management type, since the service only returns
+				// the items of a single type for each request
+				protectableVaultId := backupprotectableitems.NewVaultID(vaultId.SubscriptionId, vaultId.ResourceGroupName, vaultId.VaultName)
+				for _, managementType := range []string{"AzureIaasVM", "AzureStorage", "AzureWorkload"} {
+					options := backupprotectableitems.ListOperationOptions{
+						Filter: pointer.To(fmt.Sprintf("backupManagementType eq '%s'", managementType)),
+					}
+					protectableItems, err := protectableClient.ListComplete(ctx, protectableVaultId, options)
+					if err != nil {
+						return fmt.Errorf("listing %s protectable items in %s: %+v", managementType, vaultId, err)
+					}
+					for _, item := range protectableItems.Items {
+						if v := flattenBackupUnprotectedItem(item, vaultId.ID()); v != nil {
+							state.UnprotectedItems = append(state.UnprotectedItems, *v)
+						}
+					}
+				}
+			}
+
+			metadata.ResourceData.SetId(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenBackupProtectedItem returns the protection status of the Virtual Machines, File Shares and Databases protected
+// by a vault - other kinds of protected items (such as those protected by an on-premises agent) are ignored.
+func flattenBackupProtectedItem(input backupprotecteditems.ProtectedItemResource, vaultId string) *BackupProtectedItemModel {
+	output := BackupProtectedItemModel{
+		Id:      pointer.From(input.Id),
+		VaultId: vaultId,
+	}
+
+	switch item := input.Properties.(type) {
+	case backupprotecteditems.AzureIaaSComputeVMProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = pointer.From(item.LastBackupStatus)
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureIaaSClassicComputeVMProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = pointer.From(item.LastBackupStatus)
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureIaaSVMProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = pointer.From(item.LastBackupStatus)
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureFileshareProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = pointer.From(item.LastBackupStatus)
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureVMWorkloadSQLDatabaseProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = string(pointer.From(item.LastBackupStatus))
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureVMWorkloadSAPHanaDatabaseProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = string(pointer.From(item.LastBackupStatus))
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureVMWorkloadSAPAseDatabaseProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.ProtectionStatus = pointer.From(item.ProtectionStatus)
+		output.LastBackupStatus = string(pointer.From(item.LastBackupStatus))
+		output.LastBackupTime = pointer.From(item.LastBackupTime)
+		output.PolicyId = pointer.From(item.PolicyId)
+	case backupprotecteditems.AzureSqlProtectedItem:
+		output.SourceResourceId = pointer.From(item.SourceResourceId)
+		output.WorkloadType = string(pointer.From(item.WorkloadType))
+		output.ProtectionState = string(pointer.From(item.ProtectionState))
+		output.PolicyId = pointer.From(item.PolicyId)
+	default:
+		return nil
+	}
+
+	return &output
+}
+
+// flattenBackupUnprotectedItem returns the Virtual Machines, File Shares and Databases which can be protected by a vault
+// but aren't currently - the service also returns items which are already protected, which are ignored.
+func flattenBackupUnprotectedItem(input backupprotectableitems.WorkloadProtectableItemResource, vaultId string) *BackupUnprotectedItemModel {
+	output := BackupUnprotectedItemModel{
+		Id:      pointer.From(input.Id),
+		VaultId: vaultId,
+	}
+
+	var state *backupprotectableitems.ProtectionStatus
+	switch item := input.Properties.(type) {
+	case backupprotectableitems.AzureIaaSComputeVMProtectableItem:
+		output.SourceResourceId = pointer.From(item.VirtualMachineId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = pointer.From(item.WorkloadType)
+		state = item.ProtectionState
+	case backupprotectableitems.AzureIaaSClassicComputeVMProtectableItem:
+		output.SourceResourceId = pointer.From(item.VirtualMachineId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = pointer.From(item.WorkloadType)
+		state = item.ProtectionState
+	case backupprotectableitems.IaaSVMProtectableItem:
+		output.SourceResourceId = pointer.From(item.VirtualMachineId)
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = pointer.From(item.WorkloadType)
+		state = item.ProtectionState
+	case backupprotectableitems.AzureFileShareProtectableItem:
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = pointer.From(item.WorkloadType)
+		state = item.ProtectionState
+	case backupprotectableitems.AzureVMWorkloadSQLDatabaseProtectableItem:
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = pointer.From(item.WorkloadType)
+		state = item.ProtectionState
+	case backupprotectableitems.AzureVMWorkloadSAPHanaDatabaseProtectableItem:
+		output.FriendlyName = pointer.From(item.FriendlyName)
+		output.WorkloadType = pointer.From(item.WorkloadType)
+		state = item.ProtectionState
+	default:
+		return nil
+	}
+
+	if pointer.From(state) == backupprotectableitems.ProtectionStatusProtected {
+		return nil
+	}
+	output.ProtectionState = string(pointer.From(state))
+
+	return &output
+}
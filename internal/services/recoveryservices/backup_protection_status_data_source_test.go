@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type BackupProtectionStatusDataSource struct{}
+
+func TestAccDataSourceBackupProtectionStatus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_backup_protection_status", "test")
+	r := BackupProtectionStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("protected_item.#").HasValue("1"),
+				check.That(data.ResourceName).Key("protected_item.0.source_resource_id").Exists(),
+				check.That(data.ResourceName).Key("protected_item.0.vault_id").Exists(),
+				check.That(data.ResourceName).Key("protected_item.0.protection_state").Exists(),
+				check.That(data.ResourceName).Key("protected_item.0.policy_id").Exists(),
+			),
+		},
+	})
+}
+
+func (BackupProtectionStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_backup_protection_status" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_backup_protected_vm.test]
+}
+`, BackupProtectedVmResource{}.basic(data))
+}
@@ -30,7 +30,9 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azurerm_relay_hybrid_connection": dataSourceRelayHybridConnection(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
@@ -4,6 +4,7 @@
 package relay
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -58,9 +59,25 @@ func resourceRelayHybridConnectionAuthorizationRule() *pluginsdk.Resource {
 			},
 
 			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"primary_key_rotation_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"secondary_key_rotation_triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		}),
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(authorizationRuleCustomizeDiff),
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(hybridConnectionAuthorizationRuleCustomizeDiff),
 	}
 }
 
@@ -96,6 +113,24 @@ func resourceRelayHybridConnectionAuthorizationRuleCreateUpdate(d *pluginsdk.Res
 		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
+	// the keys are generated when the Authorization Rule is created, so they only need to be regenerated when the
+	// rotation triggers change afterwards
+	if !d.IsNewResource() {
+		for field, keyType := range hybridConnectionAuthorizationRuleRotationTriggers {
+			if !d.HasChange(field) {
+				continue
+			}
+
+			log.Printf("[DEBUG] Regenerating the %s for %s..", keyType, resourceId)
+			input := hybridconnections.RegenerateAccessKeyParameters{
+				KeyType: keyType,
+			}
+			if _, err := client.RegenerateKeys(ctx, resourceId, input); err != nil {
+				return fmt.Errorf("regenerating the %s for %s: %+v", keyType, resourceId, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceRelayHybridConnectionAuthorizationRuleRead(d, meta)
@@ -145,6 +180,42 @@ func resourceRelayHybridConnectionAuthorizationRuleRead(d *pluginsdk.ResourceDat
 	return nil
 }
 
+// hybridConnectionAuthorizationRuleRotationTriggers maps the fields which trigger rotating a key to the key they rotate
+var hybridConnectionAuthorizationRuleRotationTriggers = map[string]hybridconnections.KeyType{
+	"primary_key_rotation_triggers":   hybridconnections.KeyTypePrimaryKey,
+	"secondary_key_rotation_triggers": hybridconnections.KeyTypeSecondaryKey,
+}
+
+func hybridConnectionAuthorizationRuleCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if err := authorizationRuleCustomizeDiff(ctx, d, meta); err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("primary_key_rotation_triggers") {
+		if err := d.SetNewComputed("primary_key"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("primary_connection_string"); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("secondary_key_rotation_triggers") {
+		if err := d.SetNewComputed("secondary_key"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("secondary_connection_string"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceRelayHybridConnectionAuthorizationRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Relay.HybridConnectionsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
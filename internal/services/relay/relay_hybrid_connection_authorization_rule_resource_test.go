@@ -34,6 +34,28 @@ func TestAccRelayHybridConnectionAuthorizationRule_basic(t *testing.T) {
 	})
 }
 
+func TestAccRelayHybridConnectionAuthorizationRule_rotationTriggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_hybrid_connection_authorization_rule", "test")
+	r := RelayHybridConnectionAuthorizationRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationTriggers(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("primary_key_rotation_triggers", "secondary_key_rotation_triggers"),
+		{
+			Config: r.rotationTriggers(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("primary_key_rotation_triggers", "secondary_key_rotation_triggers"),
+	})
+}
+
 func TestAccRelayHybridConnectionAuthorizationRule_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_relay_hybrid_connection_authorization_rule", "test")
 	r := RelayHybridConnectionAuthorizationRuleResource{}
@@ -107,6 +129,52 @@ resource "azurerm_relay_hybrid_connection_authorization_rule" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (RelayHybridConnectionAuthorizationRuleResource) rotationTriggers(data acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrnhc-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+
+resource "azurerm_relay_hybrid_connection_authorization_rule" "test" {
+  name                   = "acctestrnak-%[1]d"
+  namespace_name         = azurerm_relay_namespace.test.name
+  hybrid_connection_name = azurerm_relay_hybrid_connection.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+
+  listen = true
+  send   = true
+  manage = false
+
+  primary_key_rotation_triggers = {
+    rotation = "%[3]s"
+  }
+
+  secondary_key_rotation_triggers = {
+    rotation = "%[3]s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, rotation)
+}
+
 func (r RelayHybridConnectionAuthorizationRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/topics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/security/2022-12-01-preview/defenderforstorage"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -26,6 +27,7 @@ type StorageDefenderModel struct {
 	OverrideSubscriptionSettings     bool   `tfschema:"override_subscription_settings_enabled"`
 	MalwareScanningOnUploadEnabled   bool   `tfschema:"malware_scanning_on_upload_enabled"`
 	MalwareScanningOnUploadCapPerMon int64  `tfschema:"malware_scanning_on_upload_cap_gb_per_month"`
+	ScanResultsEventGridTopicId      string `tfschema:"scan_results_event_grid_topic_id"`
 	SensitiveDataDiscoveryEnabled    bool   `tfschema:"sensitive_data_discovery_enabled"`
 }
 
@@ -74,6 +76,12 @@ func (s StorageDefenderResource) Arguments() map[string]*schema.Schema {
 			),
 		},
 
+		"scan_results_event_grid_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: topics.ValidateTopicID,
+		},
+
 		"sensitive_data_discovery_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -127,6 +135,10 @@ func (s StorageDefenderResource) Create() sdk.ResourceFunc {
 				},
 			}
 
+			if plan.ScanResultsEventGridTopicId != "" {
+				input.Properties.MalwareScanning.ScanResultsEventGridTopicResourceId = pointer.To(plan.ScanResultsEventGridTopicId)
+			}
+
 			_, err = client.Create(ctx, id, input)
 			if err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
@@ -189,6 +201,10 @@ func (s StorageDefenderResource) Update() sdk.ResourceFunc {
 				prop.MalwareScanning.OnUpload.CapGBPerMonth = pointer.To(plan.MalwareScanningOnUploadCapPerMon)
 			}
 
+			if metadata.ResourceData.HasChange("scan_results_event_grid_topic_id") {
+				prop.MalwareScanning.ScanResultsEventGridTopicResourceId = pointer.To(plan.ScanResultsEventGridTopicId)
+			}
+
 			if prop.SensitiveDataDiscovery == nil {
 				prop.SensitiveDataDiscovery = &defenderforstorage.SensitiveDataDiscoveryProperties{}
 			}
@@ -251,6 +267,14 @@ func (s StorageDefenderResource) Read() sdk.ResourceFunc {
 							state.MalwareScanningOnUploadEnabled = pointer.From(onUpload.IsEnabled)
 							state.MalwareScanningOnUploadCapPerMon = pointer.From(onUpload.CapGBPerMonth)
 						}
+
+						if v := pointer.From(ms.ScanResultsEventGridTopicResourceId); v != "" {
+							topicId, err := topics.ParseTopicIDInsensitively(v)
+							if err != nil {
+								return err
+							}
+							state.ScanResultsEventGridTopicId = topicId.ID()
+						}
 					}
 
 					if sdd := prop.SensitiveDataDiscovery; sdd != nil {
@@ -113,6 +113,28 @@ func TestAccSecurityCenterStorageDefender_reapply(t *testing.T) {
 	})
 }
 
+func TestAccSecurityCenterStorageDefender_scanResultsEventGridTopic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_security_center_storage_defender", "test")
+	r := SecurityCenterStorageDefenderResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.scanResultsEventGridTopic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSecurityCenterStorageDefender_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_security_center_storage_defender", "test")
 	r := SecurityCenterStorageDefenderResource{}
@@ -182,6 +204,27 @@ resource "azurerm_security_center_storage_defender" "test" {
 `, r.template(data))
 }
 
+func (r SecurityCenterStorageDefenderResource) scanResultsEventGridTopic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_topic" "test" {
+  name                = "acctesteg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_security_center_storage_defender" "test" {
+  storage_account_id                          = azurerm_storage_account.test.id
+  override_subscription_settings_enabled      = true
+  malware_scanning_on_upload_enabled          = true
+  malware_scanning_on_upload_cap_gb_per_month = 4
+  scan_results_event_grid_topic_id            = azurerm_eventgrid_topic.test.id
+  sensitive_data_discovery_enabled            = true
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r SecurityCenterStorageDefenderResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
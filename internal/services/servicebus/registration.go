@@ -54,6 +54,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_servicebus_queue_authorization_rule":           resourceServiceBusQueueAuthorizationRule(),
 		"azurerm_servicebus_subscription":                       resourceServiceBusSubscription(),
 		"azurerm_servicebus_subscription_rule":                  resourceServiceBusSubscriptionRule(),
+		"azurerm_servicebus_subscription_rules":                 resourceServiceBusSubscriptionRules(),
 		"azurerm_servicebus_topic_authorization_rule":           resourceServiceBusTopicAuthorizationRule(),
 		"azurerm_servicebus_topic":                              resourceServiceBusTopic(),
 	}
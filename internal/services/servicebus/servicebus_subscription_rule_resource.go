@@ -234,7 +234,7 @@ func resourceServiceBusSubscriptionRuleCreateUpdate(d *pluginsdk.ResourceData, m
 	}
 
 	if *rule.Properties.FilterType == rules.FilterTypeCorrelationFilter {
-		correlationFilter, err := expandAzureRmServiceBusCorrelationFilter(d)
+		correlationFilter, err := expandAzureRmServiceBusCorrelationFilter(d.Get("correlation_filter").([]interface{}))
 		if err != nil {
 			return fmt.Errorf("expanding `correlation_filter`: %+v", err)
 		}
@@ -328,8 +328,7 @@ func resourceServiceBusSubscriptionRuleDelete(d *pluginsdk.ResourceData, meta in
 	return nil
 }
 
-func expandAzureRmServiceBusCorrelationFilter(d *pluginsdk.ResourceData) (*rules.CorrelationFilter, error) {
-	configs := d.Get("correlation_filter").([]interface{})
+func expandAzureRmServiceBusCorrelationFilter(configs []interface{}) (*rules.CorrelationFilter, error) {
 	if len(configs) == 0 {
 		return nil, fmt.Errorf("`correlation_filter` is required when `filter_type` is set to `CorrelationFilter`")
 	}
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/subscriptions"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// the rule Service Bus creates automatically alongside a new subscription
+const serviceBusSubscriptionDefaultRuleName = "$Default"
+
+func resourceServiceBusSubscriptionRules() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceServiceBusSubscriptionRulesCreateUpdate,
+		Read:   resourceServiceBusSubscriptionRulesRead,
+		Update: resourceServiceBusSubscriptionRulesCreateUpdate,
+		Delete: resourceServiceBusSubscriptionRulesDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := rules.ParseSubscriptions2ID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			//lintignore: S013
+			"subscription_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     subscriptions.ValidateSubscriptions2ID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"rule": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50),
+						},
+
+						"filter_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(rules.FilterTypeSqlFilter),
+								string(rules.FilterTypeCorrelationFilter),
+							}, false),
+						},
+
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+
+						"sql_filter": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.SqlFilter,
+						},
+
+						"correlation_filter": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"correlation_id": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"message_id": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"to": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"reply_to": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"label": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"session_id": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"reply_to_session_id": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"content_type": {
+										Type:     pluginsdk.TypeString,
+										Optional: true,
+									},
+									"properties": {
+										Type:     pluginsdk.TypeMap,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceBusSubscriptionRulesCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseSubscriptions2ID(d.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	existing, err := client.ListBySubscriptionsComplete(ctx, *id, rules.DefaultListBySubscriptionsOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing rules for %s: %+v", id, err)
+	}
+
+	// the Default rule is created alongside the subscription, so only other rules mean these are already managed elsewhere
+	if d.IsNewResource() {
+		for _, item := range existing.Items {
+			if name := pointer.From(item.Name); name != serviceBusSubscriptionDefaultRuleName {
+				return tf.ImportAsExistsError("azurerm_servicebus_subscription_rules", id.ID())
+			}
+		}
+	}
+
+	desired, err := expandServiceBusSubscriptionRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	// the desired rules are created/updated before the stale ones are removed, so that the subscription never ends up
+	// without any rules (and thus silently dropping messages) part-way through the replacement
+	desiredNames := make(map[string]struct{}, len(desired))
+	for _, rule := range desired {
+		ruleName := pointer.From(rule.Name)
+		desiredNames[ruleName] = struct{}{}
+
+		ruleId := rules.NewRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName, ruleName)
+		if _, err := client.CreateOrUpdate(ctx, ruleId, rule); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", ruleId, err)
+		}
+	}
+
+	for _, item := range existing.Items {
+		ruleName := pointer.From(item.Name)
+		if _, ok := desiredNames[ruleName]; ok {
+			continue
+		}
+
+		ruleId := rules.NewRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName, ruleName)
+		log.Printf("[DEBUG] Removing %s as it isn't defined in the configuration", ruleId)
+		if resp, err := client.Delete(ctx, ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	d.SetId(id.ID())
+	return resourceServiceBusSubscriptionRulesRead(d, meta)
+}
+
+func resourceServiceBusSubscriptionRulesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseSubscriptions2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListBySubscriptionsComplete(ctx, *id, rules.DefaultListBySubscriptionsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("listing rules for %s: %+v", id, err)
+	}
+
+	d.Set("subscription_id", subscriptions.NewSubscriptions2ID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName).ID())
+
+	// the API returns the rules in its own order, so keep the order they're defined in to avoid a perpetual diff
+	configuredOrder := make([]string, 0)
+	for _, raw := range d.Get("rule").([]interface{}) {
+		if v, ok := raw.(map[string]interface{}); ok {
+			configuredOrder = append(configuredOrder, v["name"].(string))
+		}
+	}
+
+	if err := d.Set("rule", flattenServiceBusSubscriptionRules(resp.Items, configuredOrder)); err != nil {
+		return fmt.Errorf("setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceServiceBusSubscriptionRulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ServiceBus.SubscriptionRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := rules.ParseSubscriptions2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
+	for _, raw := range d.Get("rule").([]interface{}) {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		ruleId := rules.NewRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, id.TopicName, id.SubscriptionName, v["name"].(string))
+		if resp, err := client.Delete(ctx, ruleId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", ruleId, err)
+		}
+	}
+
+	return nil
+}
+
+func expandServiceBusSubscriptionRules(input []interface{}) ([]rules.Rule, error) {
+	output := make([]rules.Rule, 0)
+	names := make(map[string]struct{})
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		if _, ok := names[name]; ok {
+			return nil, fmt.Errorf("the rule name %q is defined more than once", name)
+		}
+		names[name] = struct{}{}
+
+		filterType := rules.FilterType(v["filter_type"].(string))
+		rule := rules.Rule{
+			Name: pointer.To(name),
+			Properties: &rules.Ruleproperties{
+				FilterType: pointer.To(filterType),
+			},
+		}
+
+		if action := v["action"].(string); action != "" {
+			rule.Properties.Action = &rules.Action{
+				SqlExpression: pointer.To(action),
+			}
+		}
+
+		switch filterType {
+		case rules.FilterTypeCorrelationFilter:
+			correlationFilter, err := expandAzureRmServiceBusCorrelationFilter(v["correlation_filter"].([]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("expanding `correlation_filter` for the rule %q: %+v", name, err)
+			}
+			rule.Properties.CorrelationFilter = correlationFilter
+
+		case rules.FilterTypeSqlFilter:
+			sqlFilter := v["sql_filter"].(string)
+			if sqlFilter == "" {
+				return nil, fmt.Errorf("`sql_filter` is required for the rule %q when `filter_type` is set to `SqlFilter`", name)
+			}
+			rule.Properties.SqlFilter = &rules.SqlFilter{
+				SqlExpression: pointer.To(sqlFilter),
+			}
+		}
+
+		output = append(output, rule)
+	}
+
+	return output, nil
+}
+
+func flattenServiceBusSubscriptionRules(input []rules.Rule, configuredOrder []string) []interface{} {
+	flattened := make(map[string]map[string]interface{})
+	names := make([]string, 0)
+
+	for _, item := range input {
+		name := pointer.From(item.Name)
+		rule := map[string]interface{}{
+			"name":               name,
+			"filter_type":        "",
+			"action":             "",
+			"sql_filter":         "",
+			"correlation_filter": []interface{}{},
+		}
+
+		if props := item.Properties; props != nil {
+			rule["filter_type"] = string(pointer.From(props.FilterType))
+
+			if props.Action != nil {
+				rule["action"] = pointer.From(props.Action.SqlExpression)
+			}
+
+			if props.SqlFilter != nil {
+				rule["sql_filter"] = pointer.From(props.SqlFilter.SqlExpression)
+			}
+
+			rule["correlation_filter"] = flattenAzureRmServiceBusCorrelationFilter((*subscriptions.CorrelationFilter)(props.CorrelationFilter))
+		}
+
+		flattened[name] = rule
+		names = append(names, name)
+	}
+
+	output := make([]interface{}, 0)
+	for _, name := range configuredOrder {
+		if rule, ok := flattened[name]; ok {
+			output = append(output, rule)
+			delete(flattened, name)
+		}
+	}
+
+	// any rules which aren't in the configuration are appended so they show up as a diff
+	for _, name := range names {
+		if rule, ok := flattened[name]; ok {
+			output = append(output, rule)
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceBusSubscriptionRulesResource struct{}
+
+func TestAccServiceBusSubscriptionRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rules", "test")
+	r := ServiceBusSubscriptionRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceBusSubscriptionRules_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rules", "test")
+	r := ServiceBusSubscriptionRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceBusSubscriptionRules_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_subscription_rules", "test")
+	r := ServiceBusSubscriptionRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("3"),
+				check.That(data.ResourceName).Key("rule.0.name").HasValue("sqlrule"),
+				check.That(data.ResourceName).Key("rule.1.name").HasValue("correlationrule"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ServiceBusSubscriptionRulesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := rules.ParseSubscriptions2ID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceBus.SubscriptionRulesClient.ListBySubscriptionsComplete(ctx, *id, rules.DefaultListBySubscriptionsOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing rules for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (r ServiceBusSubscriptionRulesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rules" "test" {
+  subscription_id = azurerm_servicebus_subscription.test.id
+
+  rule {
+    name        = "sqlrule"
+    filter_type = "SqlFilter"
+    sql_filter  = "2=2"
+  }
+}
+`, ServiceBusSubscriptionRuleResource{}.template(data))
+}
+
+func (r ServiceBusSubscriptionRulesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rules" "import" {
+  subscription_id = azurerm_servicebus_subscription_rules.test.subscription_id
+
+  rule {
+    name        = "sqlrule"
+    filter_type = "SqlFilter"
+    sql_filter  = "2=2"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ServiceBusSubscriptionRulesResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rules" "test" {
+  subscription_id = azurerm_servicebus_subscription.test.id
+
+  rule {
+    name        = "sqlrule"
+    filter_type = "SqlFilter"
+    sql_filter  = "3=3"
+    action      = "SET Test='true'"
+  }
+
+  rule {
+    name        = "correlationrule"
+    filter_type = "CorrelationFilter"
+
+    correlation_filter {
+      correlation_id = "test_correlation_id"
+      label          = "test_label"
+
+      properties = {
+        test_key = "test_value"
+      }
+    }
+  }
+
+  rule {
+    name        = "anotherrule"
+    filter_type = "SqlFilter"
+    sql_filter  = "Priority > 5"
+  }
+}
+`, ServiceBusSubscriptionRuleResource{}.template(data))
+}
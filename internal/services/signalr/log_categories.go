@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// the log categories are shared by SignalR and Web PubSub, and are used both for Live Trace / Resource Logs
+// and as the categories available to Diagnostic Settings
+const (
+	logCategoryConnectivityLogs = "ConnectivityLogs"
+	logCategoryHttpRequestLogs  = "HttpRequestLogs"
+	logCategoryMessagingLogs    = "MessagingLogs"
+)
+
+func realTimeServiceLogCategories() []string {
+	return []string{
+		logCategoryConnectivityLogs,
+		logCategoryHttpRequestLogs,
+		logCategoryMessagingLogs,
+	}
+}
+
+func logCategoriesSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
@@ -59,6 +59,8 @@ func dataSourceArmSignalRService() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"log_categories": logCategoriesSchemaComputed(),
+
 			"local_auth_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -146,6 +148,7 @@ func dataSourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("ip_address", props.ExternalIP)
 			d.Set("public_port", props.PublicPort)
 			d.Set("server_port", props.ServerPort)
+			d.Set("log_categories", realTimeServiceLogCategories())
 
 			aadAuthEnabled := true
 			if props.DisableAadAuth != nil {
@@ -25,6 +25,7 @@ func TestAccDataSourceSignalRService_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("ip_address").Exists(),
 				check.That(data.ResourceName).Key("public_port").Exists(),
 				check.That(data.ResourceName).Key("server_port").Exists(),
+				check.That(data.ResourceName).Key("log_categories.#").HasValue("3"),
 				check.That(data.ResourceName).Key("primary_access_key").Exists(),
 				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
 				check.That(data.ResourceName).Key("secondary_access_key").Exists(),
@@ -211,6 +211,7 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("ip_address", props.ExternalIP)
 			d.Set("public_port", props.PublicPort)
 			d.Set("server_port", props.ServerPort)
+			d.Set("log_categories", realTimeServiceLogCategories())
 
 			connectivityLogsEnabled := false
 			messagingLogsEnabled := false
@@ -282,11 +283,11 @@ func resourceArmSignalRServiceRead(d *pluginsdk.ResourceData, meta interface{})
 					}
 
 					switch name {
-					case "MessagingLogs":
+					case logCategoryMessagingLogs:
 						messagingLogsEnabled = strings.EqualFold(cateEnabled, "true")
-					case "ConnectivityLogs":
+					case logCategoryConnectivityLogs:
 						connectivityLogsEnabled = strings.EqualFold(cateEnabled, "true")
-					case "HttpRequestLogs":
+					case logCategoryHttpRequestLogs:
 						httpLogsEnabled = strings.EqualFold(cateEnabled, "true")
 					default:
 						continue
@@ -691,7 +692,7 @@ func expandSignalRLiveTraceConfig(input []interface{}) *signalr.LiveTraceConfigu
 		messageLogEnabled = "true"
 	}
 	resourceCategories = append(resourceCategories, signalr.LiveTraceCategory{
-		Name:    utils.String("MessagingLogs"),
+		Name:    utils.String(logCategoryMessagingLogs),
 		Enabled: utils.String(messageLogEnabled),
 	})
 
@@ -700,7 +701,7 @@ func expandSignalRLiveTraceConfig(input []interface{}) *signalr.LiveTraceConfigu
 		connectivityLogEnabled = "true"
 	}
 	resourceCategories = append(resourceCategories, signalr.LiveTraceCategory{
-		Name:    utils.String("ConnectivityLogs"),
+		Name:    utils.String(logCategoryConnectivityLogs),
 		Enabled: utils.String(connectivityLogEnabled),
 	})
 
@@ -709,7 +710,7 @@ func expandSignalRLiveTraceConfig(input []interface{}) *signalr.LiveTraceConfigu
 		httpLogEnabled = "true"
 	}
 	resourceCategories = append(resourceCategories, signalr.LiveTraceCategory{
-		Name:    utils.String("HttpRequestLogs"),
+		Name:    utils.String(logCategoryHttpRequestLogs),
 		Enabled: utils.String(httpLogEnabled),
 	})
 
@@ -749,11 +750,11 @@ func flattenSignalRLiveTraceConfig(input *signalr.LiveTraceConfiguration) []inte
 			}
 
 			switch name {
-			case "MessagingLogs":
+			case logCategoryMessagingLogs:
 				messagingLogEnabled = strings.EqualFold(cateEnabled, "true")
-			case "ConnectivityLogs":
+			case logCategoryConnectivityLogs:
 				connectivityLogEnabled = strings.EqualFold(cateEnabled, "true")
-			case "HttpRequestLogs":
+			case logCategoryHttpRequestLogs:
 				httpLogsEnabled = strings.EqualFold(cateEnabled, "true")
 			default:
 				continue
@@ -776,7 +777,7 @@ func expandSignalRResourceLogConfig(connectivityLogEnabled bool, messagingLogEna
 		messagingLog = "true"
 	}
 	resourceLogCategories = append(resourceLogCategories, signalr.ResourceLogCategory{
-		Name:    utils.String("MessagingLogs"),
+		Name:    utils.String(logCategoryMessagingLogs),
 		Enabled: utils.String(messagingLog),
 	})
 
@@ -785,7 +786,7 @@ func expandSignalRResourceLogConfig(connectivityLogEnabled bool, messagingLogEna
 		connectivityLog = "true"
 	}
 	resourceLogCategories = append(resourceLogCategories, signalr.ResourceLogCategory{
-		Name:    utils.String("ConnectivityLogs"),
+		Name:    utils.String(logCategoryConnectivityLogs),
 		Enabled: utils.String(connectivityLog),
 	})
 
@@ -794,7 +795,7 @@ func expandSignalRResourceLogConfig(connectivityLogEnabled bool, messagingLogEna
 		httpLog = "true"
 	}
 	resourceLogCategories = append(resourceLogCategories, signalr.ResourceLogCategory{
-		Name:    utils.String("HttpRequestLogs"),
+		Name:    utils.String(logCategoryHttpRequestLogs),
 		Enabled: utils.String(httpLog),
 	})
 
@@ -1028,6 +1029,8 @@ func resourceArmSignalRServiceSchema() map[string]*pluginsdk.Schema {
 			Computed: true,
 		},
 
+		"log_categories": logCategoriesSchemaComputed(),
+
 		"primary_access_key": {
 			Type:      pluginsdk.TypeString,
 			Computed:  true,
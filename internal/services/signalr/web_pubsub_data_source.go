@@ -59,6 +59,8 @@ func dataSourceWebPubsub() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"log_categories": logCategoriesSchemaComputed(),
+
 			"hostname": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -165,6 +167,7 @@ func dataSourceWebPubsubRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("hostname", props.HostName)
 			d.Set("public_port", props.PublicPort)
 			d.Set("server_port", props.ServerPort)
+			d.Set("log_categories", realTimeServiceLogCategories())
 			d.Set("version", props.Version)
 			aadAuthEnabled := true
 			if props.DisableAadAuth != nil {
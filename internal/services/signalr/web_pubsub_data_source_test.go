@@ -31,6 +31,7 @@ func TestAccDataSourceWebPubsub_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("hostname").Exists(),
 				check.That(data.ResourceName).Key("public_port").Exists(),
 				check.That(data.ResourceName).Key("server_port").Exists(),
+				check.That(data.ResourceName).Key("log_categories.#").HasValue("3"),
 				check.That(data.ResourceName).Key("primary_access_key").Exists(),
 				check.That(data.ResourceName).Key("primary_connection_string").Exists(),
 				check.That(data.ResourceName).Key("secondary_access_key").Exists(),
@@ -152,6 +152,8 @@ func resourceWebPubSub() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"log_categories": logCategoriesSchemaComputed(),
+
 			"external_ip": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -325,6 +327,7 @@ func resourceWebPubSubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("hostname", props.HostName)
 			d.Set("public_port", props.PublicPort)
 			d.Set("server_port", props.ServerPort)
+			d.Set("log_categories", realTimeServiceLogCategories())
 			d.Set("version", props.Version)
 
 			aadAuthEnabled := true
@@ -417,7 +420,7 @@ func expandLiveTraceConfig(input []interface{}) *webpubsub.LiveTraceConfiguratio
 		messageLogEnabled = "true"
 	}
 	resourceCategories = append(resourceCategories, webpubsub.LiveTraceCategory{
-		Name:    utils.String("MessagingLogs"),
+		Name:    utils.String(logCategoryMessagingLogs),
 		Enabled: utils.String(messageLogEnabled),
 	})
 
@@ -426,7 +429,7 @@ func expandLiveTraceConfig(input []interface{}) *webpubsub.LiveTraceConfiguratio
 		connectivityLogEnabled = "true"
 	}
 	resourceCategories = append(resourceCategories, webpubsub.LiveTraceCategory{
-		Name:    utils.String("ConnectivityLogs"),
+		Name:    utils.String(logCategoryConnectivityLogs),
 		Enabled: utils.String(connectivityLogEnabled),
 	})
 
@@ -435,7 +438,7 @@ func expandLiveTraceConfig(input []interface{}) *webpubsub.LiveTraceConfiguratio
 		httpLogEnabled = "true"
 	}
 	resourceCategories = append(resourceCategories, webpubsub.LiveTraceCategory{
-		Name:    utils.String("HttpRequestLogs"),
+		Name:    utils.String(logCategoryHttpRequestLogs),
 		Enabled: utils.String(httpLogEnabled),
 	})
 
@@ -475,11 +478,11 @@ func flattenLiveTraceConfig(input *webpubsub.LiveTraceConfiguration) []interface
 			}
 
 			switch name {
-			case "MessagingLogs":
+			case logCategoryMessagingLogs:
 				messagingLogEnabled = strings.EqualFold(cateEnabled, "true")
-			case "ConnectivityLogs":
+			case logCategoryConnectivityLogs:
 				connectivityLogEnabled = strings.EqualFold(cateEnabled, "true")
-			case "HttpRequestLogs":
+			case logCategoryHttpRequestLogs:
 				httpLogsEnabled = strings.EqualFold(cateEnabled, "true")
 			default:
 				continue
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageAccountAzureFilesAuthentication() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageAccountAzureFilesAuthenticationCreateUpdate,
+		Read:   resourceStorageAccountAzureFilesAuthenticationRead,
+		Update: resourceStorageAccountAzureFilesAuthenticationCreateUpdate,
+		Delete: resourceStorageAccountAzureFilesAuthenticationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := commonids.ParseStorageAccountID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+			},
+
+			"directory_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.DirectoryServiceOptionsAADDS),
+					string(storage.DirectoryServiceOptionsAD),
+					string(storageaccounts.DirectoryServiceOptionsAADKERB),
+				}, false),
+			},
+
+			"active_directory": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"domain_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"domain_guid": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+
+						"storage_sid": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"domain_sid": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"forest_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"netbios_domain_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"default_share_level_permission": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(storage.DefaultSharePermissionNone),
+				ValidateFunc: validation.StringInSlice(storageaccounts.PossibleValuesForDefaultSharePermission(), false),
+			},
+		},
+	}
+}
+
+func resourceStorageAccountAzureFilesAuthenticationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroupName, id.StorageAccountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if storageAccount.AccountProperties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	existing := storageAccount.AccountProperties.AzureFilesIdentityBasedAuthentication
+	if d.IsNewResource() && existing != nil && existing.DirectoryServiceOptions != "" && existing.DirectoryServiceOptions != storage.DirectoryServiceOptionsNone {
+		return tf.ImportAsExistsError("azurerm_storage_account_azure_files_authentication", id.ID())
+	}
+
+	authentication, err := expandArmStorageAccountAzureFilesAuthentication([]interface{}{
+		map[string]interface{}{
+			"directory_type":                 d.Get("directory_type"),
+			"active_directory":               d.Get("active_directory"),
+			"default_share_level_permission": d.Get("default_share_level_permission"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// due to service issue: https://github.com/Azure/azure-rest-api-specs/issues/12473, we need to update to None before changing its DirectoryServiceOptions
+	if existing != nil && existing.DirectoryServiceOptions != "" && existing.DirectoryServiceOptions != storage.DirectoryServiceOptionsNone && existing.DirectoryServiceOptions != authentication.DirectoryServiceOptions {
+		log.Printf("[DEBUG] Disabling Azure Files Authentication for %s prior to changing the Directory Type", *id)
+		if err := updateStorageAccountAzureFilesAuthentication(ctx, client, *id, &storage.AzureFilesIdentityBasedAuthentication{
+			DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := updateStorageAccountAzureFilesAuthentication(ctx, client, *id, authentication); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageAccountAzureFilesAuthenticationRead(d, meta)
+}
+
+func resourceStorageAccountAzureFilesAuthenticationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccount, err := client.GetProperties(ctx, id.ResourceGroupName, id.StorageAccountName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(storageAccount.Response) {
+			log.Printf("[INFO] %s was not found - removing Azure Files Authentication from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	var authentication *storage.AzureFilesIdentityBasedAuthentication
+	if props := storageAccount.AccountProperties; props != nil {
+		authentication = props.AzureFilesIdentityBasedAuthentication
+	}
+	if authentication == nil || authentication.DirectoryServiceOptions == "" || authentication.DirectoryServiceOptions == storage.DirectoryServiceOptionsNone {
+		log.Printf("[INFO] Azure Files Authentication is disabled for %s - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", id.ID())
+	d.Set("directory_type", string(authentication.DirectoryServiceOptions))
+	d.Set("default_share_level_permission", flattenArmStorageAccountDefaultSharePermission(authentication.DefaultSharePermission))
+	if err := d.Set("active_directory", flattenArmStorageAccountActiveDirectoryProperties(authentication.ActiveDirectoryProperties)); err != nil {
+		return fmt.Errorf("setting `active_directory`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageAccountAzureFilesAuthenticationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParseStorageAccountID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.StorageAccountName, storageAccountResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageAccountResourceName)
+
+	// Azure Files Authentication can't be removed, so we'll disable it instead
+	return updateStorageAccountAzureFilesAuthentication(ctx, client, *id, &storage.AzureFilesIdentityBasedAuthentication{
+		DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+	})
+}
+
+func updateStorageAccountAzureFilesAuthentication(ctx context.Context, client *storage.AccountsClient, id commonids.StorageAccountId, input *storage.AzureFilesIdentityBasedAuthentication) error {
+	params := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			AzureFilesIdentityBasedAuthentication: input,
+		},
+	}
+
+	if _, err := client.Update(ctx, id.ResourceGroupName, id.StorageAccountName, params); err != nil {
+		return fmt.Errorf("updating Azure Files Authentication for %s: %+v", id, err)
+	}
+
+	return nil
+}
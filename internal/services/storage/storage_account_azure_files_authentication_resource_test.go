@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageAccountAzureFilesAuthenticationResource struct{}
+
+func TestAccStorageAccountAzureFilesAuthentication_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_azure_files_authentication", "test")
+	r := StorageAccountAzureFilesAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountAzureFilesAuthentication_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_azure_files_authentication", "test")
+	r := StorageAccountAzureFilesAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageAccountAzureFilesAuthentication_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_azure_files_authentication", "test")
+	r := StorageAccountAzureFilesAuthenticationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.kerberosWithDomain(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("default_share_level_permission").HasValue("StorageFileDataSmbShareContributor"),
+			),
+		},
+		data.ImportStep(
+			"active_directory.0.storage_sid",
+			"active_directory.0.domain_sid",
+			"active_directory.0.forest_name",
+			"active_directory.0.netbios_domain_name",
+		),
+		{
+			Config: r.activeDirectory(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseStorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.StorageAccounts.GetProperties(ctx, *id, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if authentication := model.Properties.AzureFilesIdentityBasedAuthentication; authentication != nil {
+			return pointer.To(authentication.DirectoryServiceOptions != storageaccounts.DirectoryServiceOptionsNone), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "unlikely23exst2acct%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  lifecycle {
+    ignore_changes = [azure_files_authentication]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  directory_type     = "AADKERB"
+}
+`, r.template(data))
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "import" {
+  storage_account_id = azurerm_storage_account_azure_files_authentication.test.storage_account_id
+  directory_type     = azurerm_storage_account_azure_files_authentication.test.directory_type
+}
+`, r.basic(data))
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) kerberosWithDomain(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "test" {
+  storage_account_id             = azurerm_storage_account.test.id
+  directory_type                 = "AADKERB"
+  default_share_level_permission = "StorageFileDataSmbShareContributor"
+
+  active_directory {
+    domain_name = "adtest.com"
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageAccountAzureFilesAuthenticationResource) activeDirectory(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_azure_files_authentication" "test" {
+  storage_account_id             = azurerm_storage_account.test.id
+  directory_type                 = "AD"
+  default_share_level_permission = "StorageFileDataSmbShareReader"
+
+  active_directory {
+    storage_sid         = "S-1-5-21-2400535526-2334094090-2402026252-0012"
+    domain_name         = "adtest.com"
+    domain_sid          = "S-1-5-21-2400535526-2334094090-2402026252-0012"
+    domain_guid         = "aebfc118-9fa9-4732-a21f-d98e41a77ae1"
+    forest_name         = "adtest.com"
+    netbios_domain_name = "adtest.com"
+  }
+}
+`, r.template(data))
+}
@@ -213,7 +213,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 								Schema: map[string]*pluginsdk.Schema{
 									"domain_guid": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
 										ValidateFunc: validation.IsUUID,
 									},
 
@@ -249,6 +249,13 @@ func resourceStorageAccount() *pluginsdk.Resource {
 								},
 							},
 						},
+
+						"default_share_level_permission": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(storage.DefaultSharePermissionNone),
+							ValidateFunc: validation.StringInSlice(storageaccounts.PossibleValuesForDefaultSharePermission(), false),
+						},
 					},
 				},
 			},
@@ -1300,6 +1307,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
+				if d.HasChange("immutability_policy") {
+					if stateOld, _ := d.GetChange("immutability_policy.0.state"); stateOld.(string) == string(storage.AccountImmutabilityPolicyStateLocked) {
+						return fmt.Errorf("`immutability_policy` cannot be changed once it has been locked, since a Storage Account with a locked immutability policy cannot be recreated")
+					}
+				}
+
 				if d.Get("access_tier") != "" {
 					if accountKind := storage.Kind(d.Get("account_kind").(string)); !slices.Contains(storageKindsSupportsSkuTier, accountKind) {
 						return fmt.Errorf("`access_tier` is only available for accounts of kind: %v", storageKindsSupportsSkuTier)
@@ -2681,6 +2694,9 @@ func expandArmStorageAccountAzureFilesAuthentication(input []interface{}) (*stor
 		if ad == nil {
 			return nil, fmt.Errorf("`active_directory` is required when `directory_type` is `AD`")
 		}
+		if ad.DomainGUID == nil {
+			return nil, fmt.Errorf("`active_directory.0.domain_guid` is required when `directory_type` is `AD`")
+		}
 		if ad.AzureStorageSid == nil {
 			return nil, fmt.Errorf("`active_directory.0.storage_sid` is required when `directory_type` is `AD`")
 		}
@@ -2698,6 +2714,7 @@ func expandArmStorageAccountAzureFilesAuthentication(input []interface{}) (*stor
 	return &storage.AzureFilesIdentityBasedAuthentication{
 		DirectoryServiceOptions:   directoryOption,
 		ActiveDirectoryProperties: ad,
+		DefaultSharePermission:    storage.DefaultSharePermission(v["default_share_level_permission"].(string)),
 	}, nil
 }
 
@@ -2708,9 +2725,13 @@ func expandArmStorageAccountActiveDirectoryProperties(input []interface{}) *stor
 	m := input[0].(map[string]interface{})
 
 	output := &storage.ActiveDirectoryProperties{
-		DomainGUID: utils.String(m["domain_guid"].(string)),
 		DomainName: utils.String(m["domain_name"].(string)),
 	}
+	// the Domain GUID can be omitted when using Microsoft Entra Kerberos, since the Domain Name is enough to
+	// retrieve the Kerberos tickets for hybrid identities
+	if v := m["domain_guid"]; v != "" {
+		output.DomainGUID = utils.String(v.(string))
+	}
 	if v := m["storage_sid"]; v != "" {
 		output.AzureStorageSid = utils.String(v.(string))
 	}
@@ -3242,12 +3263,21 @@ func flattenArmStorageAccountAzureFilesAuthentication(input *storage.AzureFilesI
 
 	return []interface{}{
 		map[string]interface{}{
-			"directory_type":   input.DirectoryServiceOptions,
-			"active_directory": flattenArmStorageAccountActiveDirectoryProperties(input.ActiveDirectoryProperties),
+			"directory_type":                 input.DirectoryServiceOptions,
+			"active_directory":               flattenArmStorageAccountActiveDirectoryProperties(input.ActiveDirectoryProperties),
+			"default_share_level_permission": flattenArmStorageAccountDefaultSharePermission(input.DefaultSharePermission),
 		},
 	}
 }
 
+func flattenArmStorageAccountDefaultSharePermission(input storage.DefaultSharePermission) string {
+	if input == "" {
+		return string(storage.DefaultSharePermissionNone)
+	}
+
+	return string(input)
+}
+
 func flattenArmStorageAccountActiveDirectoryProperties(input *storage.ActiveDirectoryProperties) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
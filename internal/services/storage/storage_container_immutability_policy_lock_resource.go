@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// StorageContainerImmutabilityPolicyLockResource locks an existing Storage Container Immutability Policy, which is
+// modelled as a separate resource since locking a policy is permanent and can't be reverted
+type StorageContainerImmutabilityPolicyLockResource struct{}
+
+var _ sdk.Resource = StorageContainerImmutabilityPolicyLockResource{}
+
+type ContainerImmutabilityPolicyLockModel struct {
+	StorageContainerImmutabilityPolicyId string `tfschema:"storage_container_immutability_policy_id"`
+	AcknowledgeIrreversible              bool   `tfschema:"acknowledge_irreversible"`
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) ResourceType() string {
+	return "azurerm_storage_container_immutability_policy_lock"
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageContainerImmutabilityPolicyID
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) ModelObject() interface{} {
+	return &ContainerImmutabilityPolicyLockModel{}
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_immutability_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageContainerImmutabilityPolicyID,
+		},
+
+		"acknowledge_irreversible": {
+			Type:             pluginsdk.TypeBool,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validateAcknowledgeIrreversibleLock,
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			var model ContainerImmutabilityPolicyLockModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(model.StorageContainerImmutabilityPolicyId)
+			if err != nil {
+				return err
+			}
+
+			containerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+			existing, err := client.GetImmutabilityPolicy(ctx, containerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if response.WasNotFound(existing.HttpResponse) || (StorageContainerImmutabilityPolicyResource{}).isDeleted(existing.Model) {
+				return fmt.Errorf("%s was not found - the Immutability Policy must exist before it can be locked", id)
+			}
+
+			if state := existing.Model.Properties.State; state != nil && *state == blobcontainers.ImmutabilityPolicyStateLocked {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			options := blobcontainers.LockImmutabilityPolicyOperationOptions{
+				IfMatch: existing.Model.Etag,
+			}
+
+			if _, err := client.LockImmutabilityPolicy(ctx, containerId, options); err != nil {
+				return fmt.Errorf("locking %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			containerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+			resp, err := client.GetImmutabilityPolicy(ctx, containerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if (StorageContainerImmutabilityPolicyResource{}).isDeleted(resp.Model) {
+				return metadata.MarkAsGone(id)
+			}
+
+			// the lock only exists once the policy has been locked
+			if state := resp.Model.Properties.State; state == nil || *state != blobcontainers.ImmutabilityPolicyStateLocked {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ContainerImmutabilityPolicyLockModel{
+				StorageContainerImmutabilityPolicyId: id.ID(),
+				// a lock can only have been created once this was acknowledged
+				AcknowledgeIrreversible: true,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a locked Immutability Policy can't be unlocked, so this only removes the lock from the state
+			metadata.Logger.Warnf("%s cannot be unlocked - removing the lock from the state only", id)
+			return nil
+		},
+	}
+}
+
+// validateAcknowledgeIrreversibleLock requires that locking an Immutability Policy is explicitly acknowledged, and
+// surfaces a warning during plan since the lock can't be removed once it's been applied
+func validateAcknowledgeIrreversibleLock(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(bool)
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "expected type of `acknowledge_irreversible` to be bool",
+			AttributePath: path,
+		}}
+	}
+
+	if !v {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "`acknowledge_irreversible` must be set to `true` to lock an Immutability Policy",
+			AttributePath: path,
+		}}
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "Locking an Immutability Policy is irreversible",
+		Detail:        "Once locked, the Immutability Policy cannot be unlocked or deleted and its immutability period can only be extended. The Storage Container and Storage Account it belongs to cannot be deleted whilst they contain data protected by the policy.",
+		AttributePath: path,
+	}}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageContainerImmutabilityPolicyLockResource struct{}
+
+func TestAccStorageContainerImmutabilityPolicyLock_notAcknowledged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy_lock", "test")
+	r := StorageContainerImmutabilityPolicyLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.basic(data, false),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile("`acknowledge_irreversible` must be set to `true` to lock an Immutability Policy"),
+		},
+	})
+}
+
+func TestAccStorageContainerImmutabilityPolicyLock_basic(t *testing.T) {
+	// Locking an immutability policy renders the container and its storage account **immutable**, meaning that this
+	// test will always fail during cleanup. Uncomment the t.Skip() call to run it manually...
+	t.Skip("this test for manual execution only")
+
+	data := acceptance.BuildTestData(t, "azurerm_storage_container_immutability_policy_lock", "test")
+	r := StorageContainerImmutabilityPolicyLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_storage_container_immutability_policy.test").Key("locked").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageContainerImmutabilityPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	containerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+	resp, err := client.Storage.ResourceManager.BlobContainers.GetImmutabilityPolicy(ctx, containerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties.State != nil && *resp.Model.Properties.State == blobcontainers.ImmutabilityPolicyStateLocked), nil
+}
+
+func (r StorageContainerImmutabilityPolicyLockResource) basic(data acceptance.TestData, acknowledged bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_container_immutability_policy" "test" {
+  storage_container_resource_manager_id = azurerm_storage_container.test.resource_manager_id
+  immutability_period_in_days           = 1
+}
+
+resource "azurerm_storage_container_immutability_policy_lock" "test" {
+  storage_container_immutability_policy_id = azurerm_storage_container_immutability_policy.test.id
+  acknowledge_irreversible                 = %[2]t
+}
+`, StorageContainerImmutabilityPolicyResource{}.template(data), acknowledged)
+}
@@ -59,10 +59,12 @@ func (r StorageContainerImmutabilityPolicyResource) Arguments() map[string]*plug
 			ValidateFunc: validation.IntBetween(1, 146000),
 		},
 
+		// NOTE: this is Optional & Computed since the policy can also be locked using the
+		// `azurerm_storage_container_immutability_policy_lock` resource
 		"locked": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
-			Default:  false,
+			Computed: true,
 		},
 
 		"protected_append_writes_all_enabled": {
@@ -104,7 +106,7 @@ func (r StorageContainerImmutabilityPolicyResource) CustomizeDiff() sdk.Resource
 
 			if lockedOld.(bool) {
 				if diff.HasChange("immutability_period_in_days") {
-					if periodOld, periodNew := diff.GetChange("immutability_period_in_days"); periodOld.(int) < periodNew.(int) {
+					if periodOld, periodNew := diff.GetChange("immutability_period_in_days"); periodNew.(int) < periodOld.(int) {
 						return fmt.Errorf("`immutability_period_in_days` cannot be decreased once an immutability policy has been locked")
 					}
 				}
@@ -228,6 +230,23 @@ func (r StorageContainerImmutabilityPolicyResource) Update() sdk.ResourceFunc {
 				},
 			}
 
+			// once locked the policy can no longer be updated, however the immutability period can be extended
+			if state := resp.Model.Properties.State; state != nil && *state == blobcontainers.ImmutabilityPolicyStateLocked {
+				if !metadata.ResourceData.HasChange("immutability_period_in_days") {
+					return nil
+				}
+
+				extendOptions := blobcontainers.ExtendImmutabilityPolicyOperationOptions{
+					IfMatch: resp.Model.Etag,
+				}
+
+				if _, err := client.ExtendImmutabilityPolicy(ctx, *containerId, input, extendOptions); err != nil {
+					return fmt.Errorf("extending %s: %+v", id, err)
+				}
+
+				return nil
+			}
+
 			options := blobcontainers.CreateOrUpdateImmutabilityPolicyOperationOptions{
 				IfMatch: resp.Model.Etag,
 			}
@@ -106,7 +106,7 @@ func TestAccStorageContainerImmutabilityPolicy_completeLocked(t *testing.T) {
 		},
 		data.ImportStep(),
 		{
-			Config:      r.basic(data),
+			Config:      r.completeUnlocked(data),
 			ExpectError: regexp.MustCompile("unable to set `locked = false` - once an immutability policy locked it cannot be unlocked"),
 		},
 	})
@@ -150,6 +150,7 @@ resource "azurerm_storage_container_immutability_policy" "test" {
   immutability_period_in_days           = 2
   protected_append_writes_all_enabled   = false
   protected_append_writes_enabled       = true
+  locked                                = false
 }
 `, template)
 }
@@ -6,8 +6,13 @@ package storage
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/queueservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
@@ -29,6 +34,10 @@ func resourceStorageQueue() *pluginsdk.Resource {
 		Delete: resourceStorageQueueDelete,
 
 		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+			if strings.HasPrefix(id, "/subscriptions/") {
+				_, err := queueservice.ParseQueueID(id)
+				return err
+			}
 			_, err := queues.ParseQueueID(id, storageDomainSuffix)
 			return err
 		}),
@@ -55,9 +64,18 @@ func resourceStorageQueue() *pluginsdk.Resource {
 
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.StorageAccountName,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
 			},
 
 			"metadata": MetaDataSchema(),
@@ -71,6 +89,10 @@ func resourceStorageQueue() *pluginsdk.Resource {
 }
 
 func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("storage_account_id"); ok {
+		return resourceStorageQueueCreateManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -127,6 +149,10 @@ func resourceStorageQueueCreate(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageQueueUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageQueueUpdateManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
@@ -161,6 +187,10 @@ func resourceStorageQueueUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageQueueReadManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -210,6 +240,10 @@ func resourceStorageQueueRead(d *pluginsdk.ResourceData, meta interface{}) error
 }
 
 func resourceStorageQueueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageQueueDeleteManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -241,3 +275,120 @@ func resourceStorageQueueDelete(d *pluginsdk.ResourceData, meta interface{}) err
 
 	return nil
 }
+
+// The ManagementPlane functions below manage the Queue solely through the Resource Manager API, so that no access to the
+// data plane endpoint of the Storage Account is required - these are used when `storage_account_id` is specified.
+
+func resourceStorageQueueCreateManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.QueueService
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := queueservice.NewQueueID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, d.Get("name").(string))
+
+	existing, err := client.QueueGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_queue", id.ID())
+	}
+
+	payload := queueservice.StorageQueue{
+		Properties: &queueservice.QueueProperties{
+			Metadata: pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{}))),
+		},
+	}
+
+	if _, err := client.QueueCreate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageQueueReadManagementPlane(d, meta)
+}
+
+func resourceStorageQueueUpdateManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.QueueService
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := queueservice.ParseQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := queueservice.StorageQueue{
+		Properties: &queueservice.QueueProperties{
+			Metadata: pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{}))),
+		},
+	}
+
+	if _, err := client.QueueUpdate(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStorageQueueReadManagementPlane(d, meta)
+}
+
+func resourceStorageQueueReadManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.QueueService
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := queueservice.ParseQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.QueueGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.QueueName)
+	d.Set("storage_account_id", commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID())
+
+	metaData := make(map[string]string)
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Metadata != nil {
+		metaData = *model.Properties.Metadata
+	}
+	if err := d.Set("metadata", FlattenMetaData(metaData)); err != nil {
+		return fmt.Errorf("setting `metadata`: %s", err)
+	}
+
+	resourceManagerId := parse.NewStorageQueueResourceManagerID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, "default", id.QueueName)
+	d.Set("resource_manager_id", resourceManagerId.ID())
+
+	return nil
+}
+
+func resourceStorageQueueDeleteManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.QueueService
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := queueservice.ParseQueueID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.QueueDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -6,8 +6,11 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/queueservice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -85,7 +88,46 @@ func TestAccStorageQueue_metaData(t *testing.T) {
 	})
 }
 
+func TestAccStorageQueue_managementPlane(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_queue", "test")
+	r := StorageQueueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managementPlane(data, "world"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_manager_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managementPlane(data, "M0rty"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.hello").HasValue("M0rty"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageQueueResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	if strings.HasPrefix(state.ID, "/subscriptions/") {
+		id, err := queueservice.ParseQueueID(state.ID)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Storage.ResourceManager.QueueService.QueueGet(ctx, *id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		return utils.Bool(true), nil
+	}
+
 	id, err := queues.ParseQueueID(state.ID, client.Storage.StorageDomainSuffix)
 	if err != nil {
 		return nil, err
@@ -196,6 +238,22 @@ resource "azurerm_storage_queue" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StorageQueueResource) managementPlane(data acceptance.TestData, value string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_queue" "test" {
+  name               = "mysamplequeue-%d"
+  storage_account_id = azurerm_storage_account.test.id
+
+  metadata = {
+    hello = "%s"
+  }
+}
+`, template, data.RandomInteger, value)
+}
+
 func (r StorageQueueResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
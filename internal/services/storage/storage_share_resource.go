@@ -6,8 +6,13 @@ package storage
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -31,6 +36,10 @@ func resourceStorageShare() *pluginsdk.Resource {
 		Delete: resourceStorageShareDelete,
 
 		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+			if strings.HasPrefix(id, "/subscriptions/") {
+				_, err := fileshares.ParseShareID(id)
+				return err
+			}
 			_, err := shares.ParseShareID(id, storageDomainSuffix)
 			return err
 		}),
@@ -57,9 +66,18 @@ func resourceStorageShare() *pluginsdk.Resource {
 			},
 
 			"storage_account_name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
 			},
 
 			"quota": {
@@ -145,6 +163,10 @@ func resourceStorageShare() *pluginsdk.Resource {
 }
 
 func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("storage_account_id"); ok {
+		return resourceStorageShareCreateManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -231,6 +253,10 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageShareReadManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -294,6 +320,10 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 }
 
 func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageShareUpdateManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
@@ -370,6 +400,10 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageShareDeleteManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -444,3 +478,239 @@ func flattenStorageShareACLs(input []shares.SignedIdentifier) []interface{} {
 
 	return result
 }
+
+// The ManagementPlane functions below manage the Share solely through the Resource Manager API, so that no access to the
+// data plane endpoint of the Storage Account is required - these are used when `storage_account_id` is specified.
+
+func resourceStorageShareCreateManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage.ResourceManager
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := fileshares.NewShareID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, d.Get("name").(string))
+
+	protocol := fileshares.EnabledProtocols(d.Get("enabled_protocol").(string))
+	if protocol == fileshares.EnabledProtocolsNFS {
+		account, err := storageClient.StorageAccounts.GetProperties(ctx, *accountId, storageaccounts.DefaultGetPropertiesOperationOptions())
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *accountId, err)
+		}
+
+		// Only FileStorage (whose sku tier is Premium only) storage account is able to have NFS file shares.
+		// See: https://learn.microsoft.com/en-us/azure/storage/files/storage-files-quick-create-use-linux#applies-to
+		if account.Model == nil || pointer.From(account.Model.Kind) != storageaccounts.KindFileStorage {
+			return fmt.Errorf("NFS File Share is only supported for Storage Account with kind %q", string(storageaccounts.KindFileStorage))
+		}
+	}
+
+	existing, err := storageClient.FileShares.Get(ctx, id, fileshares.DefaultGetOperationOptions())
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_share", id.ID())
+	}
+
+	payload := fileshares.FileShare{
+		Properties: &fileshares.FileShareProperties{
+			EnabledProtocols:  pointer.To(protocol),
+			Metadata:          pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{}))),
+			ShareQuota:        pointer.To(int64(d.Get("quota").(int))),
+			SignedIdentifiers: expandStorageShareACLsManagementPlane(d.Get("acl").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if accessTier := d.Get("access_tier").(string); accessTier != "" {
+		payload.Properties.AccessTier = pointer.To(fileshares.ShareAccessTier(accessTier))
+	}
+
+	if _, err := storageClient.FileShares.Create(ctx, id, payload, fileshares.DefaultCreateOperationOptions()); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageShareReadManagementPlane(d, meta)
+}
+
+func resourceStorageShareUpdateManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fileshares.ParseShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := fileshares.FileShare{
+		Properties: &fileshares.FileShareProperties{},
+	}
+
+	if d.HasChange("quota") {
+		payload.Properties.ShareQuota = pointer.To(int64(d.Get("quota").(int)))
+	}
+
+	if d.HasChange("metadata") {
+		payload.Properties.Metadata = pointer.To(ExpandMetaData(d.Get("metadata").(map[string]interface{})))
+	}
+
+	if d.HasChange("acl") {
+		payload.Properties.SignedIdentifiers = expandStorageShareACLsManagementPlane(d.Get("acl").(*pluginsdk.Set).List())
+	}
+
+	if d.HasChange("access_tier") {
+		payload.Properties.AccessTier = pointer.To(fileshares.ShareAccessTier(d.Get("access_tier").(string)))
+	}
+
+	if _, err := client.Update(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceStorageShareReadManagementPlane(d, meta)
+}
+
+func resourceStorageShareReadManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage.ResourceManager
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fileshares.ParseShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := storageClient.FileShares.Get(ctx, *id, fileshares.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+	account, err := storageClient.StorageAccounts.GetProperties(ctx, accountId, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	url := ""
+	if model := account.Model; model != nil && model.Properties != nil && model.Properties.PrimaryEndpoints != nil {
+		if endpoint := pointer.From(model.Properties.PrimaryEndpoints.File); endpoint != "" {
+			url = strings.TrimSuffix(endpoint, "/") + "/" + id.ShareName
+		}
+	}
+
+	d.Set("name", id.ShareName)
+	d.Set("storage_account_id", accountId.ID())
+	d.Set("url", url)
+	d.Set("resource_manager_id", parse.NewStorageShareResourceManagerID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, "default", id.ShareName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("quota", int(pointer.From(props.ShareQuota)))
+			d.Set("access_tier", string(pointer.From(props.AccessTier)))
+
+			enabledProtocol := string(fileshares.EnabledProtocolsSMB)
+			if props.EnabledProtocols != nil {
+				enabledProtocol = string(*props.EnabledProtocols)
+			}
+			d.Set("enabled_protocol", enabledProtocol)
+
+			if err := d.Set("acl", flattenStorageShareACLsManagementPlane(props.SignedIdentifiers)); err != nil {
+				return fmt.Errorf("flattening `acl`: %+v", err)
+			}
+
+			metaData := make(map[string]string)
+			if props.Metadata != nil {
+				metaData = *props.Metadata
+			}
+			if err := d.Set("metadata", FlattenMetaData(metaData)); err != nil {
+				return fmt.Errorf("flattening `metadata`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageShareDeleteManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := fileshares.ParseShareID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id, fileshares.DefaultDeleteOperationOptions()); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageShareACLsManagementPlane(input []interface{}) *[]fileshares.SignedIdentifier {
+	results := make([]fileshares.SignedIdentifier, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		identifier := fileshares.SignedIdentifier{
+			Id: pointer.To(vals["id"].(string)),
+		}
+
+		if policies := vals["access_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+			policy := policies[0].(map[string]interface{})
+			accessPolicy := &fileshares.AccessPolicy{
+				Permission: pointer.To(policy["permissions"].(string)),
+			}
+			if v := policy["start"].(string); v != "" {
+				accessPolicy.StartTime = pointer.To(v)
+			}
+			if v := policy["expiry"].(string); v != "" {
+				accessPolicy.ExpiryTime = pointer.To(v)
+			}
+			identifier.AccessPolicy = accessPolicy
+		}
+
+		results = append(results, identifier)
+	}
+
+	return &results
+}
+
+func flattenStorageShareACLsManagementPlane(input *[]fileshares.SignedIdentifier) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		accessPolicies := make([]interface{}, 0)
+		if policy := v.AccessPolicy; policy != nil {
+			accessPolicies = append(accessPolicies, map[string]interface{}{
+				"start":       pointer.From(policy.StartTime),
+				"expiry":      pointer.From(policy.ExpiryTime),
+				"permissions": pointer.From(policy.Permission),
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":            pointer.From(v.Id),
+			"access_policy": accessPolicies,
+		})
+	}
+
+	return result
+}
@@ -6,8 +6,13 @@ package storage
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/tableservice"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
@@ -29,6 +34,10 @@ func resourceStorageTable() *pluginsdk.Resource {
 		Update: resourceStorageTableUpdate,
 
 		Importer: helpers.ImporterValidatingStorageResourceId(func(id, storageDomainSuffix string) error {
+			if strings.HasPrefix(id, "/subscriptions/") {
+				_, err := tableservice.ParseTableID(id)
+				return err
+			}
 			_, err := tables.ParseTableID(id, storageDomainSuffix)
 			return err
 		}),
@@ -56,9 +65,18 @@ func resourceStorageTable() *pluginsdk.Resource {
 
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.StorageAccountName,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidateStorageAccountID,
+				ExactlyOneOf: []string{"storage_account_name", "storage_account_id"},
 			},
 
 			"acl": {
@@ -102,6 +120,10 @@ func resourceStorageTable() *pluginsdk.Resource {
 }
 
 func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	if _, ok := d.GetOk("storage_account_id"); ok {
+		return resourceStorageTableCreateManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -167,6 +189,10 @@ func resourceStorageTableCreate(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageTableRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageTableReadManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -224,6 +250,10 @@ func resourceStorageTableRead(d *pluginsdk.ResourceData, meta interface{}) error
 }
 
 func resourceStorageTableDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageTableDeleteManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -255,6 +285,10 @@ func resourceStorageTableDelete(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageTableUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	if strings.HasPrefix(d.Id(), "/subscriptions/") {
+		return resourceStorageTableUpdateManagementPlane(d, meta)
+	}
+
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
@@ -341,3 +375,169 @@ func flattenStorageTableACLs(input *[]tables.SignedIdentifier) []interface{} {
 
 	return result
 }
+
+// The ManagementPlane functions below manage the Table solely through the Resource Manager API, so that no access to the
+// data plane endpoint of the Storage Account is required - these are used when `storage_account_id` is specified.
+
+func resourceStorageTableCreateManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.TableService
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := tableservice.NewTableID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, d.Get("name").(string))
+
+	existing, err := client.TableGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_storage_table", id.ID())
+	}
+
+	payload := tableservice.Table{
+		Properties: &tableservice.TableProperties{
+			SignedIdentifiers: expandStorageTableACLsManagementPlane(d.Get("acl").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if _, err := client.TableCreate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageTableReadManagementPlane(d, meta)
+}
+
+func resourceStorageTableUpdateManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.TableService
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tableservice.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("acl") {
+		payload := tableservice.Table{
+			Properties: &tableservice.TableProperties{
+				SignedIdentifiers: expandStorageTableACLsManagementPlane(d.Get("acl").(*pluginsdk.Set).List()),
+			},
+		}
+
+		if _, err := client.TableUpdate(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating ACLs for %s: %+v", *id, err)
+		}
+	}
+
+	return resourceStorageTableReadManagementPlane(d, meta)
+}
+
+func resourceStorageTableReadManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.TableService
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tableservice.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TableGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s not found, removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TableName)
+	d.Set("storage_account_id", commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName).ID())
+
+	var acls *[]tableservice.TableSignedIdentifier
+	if model := resp.Model; model != nil && model.Properties != nil {
+		acls = model.Properties.SignedIdentifiers
+	}
+	if err = d.Set("acl", flattenStorageTableACLsManagementPlane(acls)); err != nil {
+		return fmt.Errorf("setting `acl`: %v", err)
+	}
+
+	return nil
+}
+
+func resourceStorageTableDeleteManagementPlane(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.TableService
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := tableservice.ParseTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.TableDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandStorageTableACLsManagementPlane(input []interface{}) *[]tableservice.TableSignedIdentifier {
+	results := make([]tableservice.TableSignedIdentifier, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		identifier := tableservice.TableSignedIdentifier{
+			Id: vals["id"].(string),
+		}
+
+		if policies := vals["access_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+			policy := policies[0].(map[string]interface{})
+			identifier.AccessPolicy = &tableservice.TableAccessPolicy{
+				StartTime:  pointer.To(policy["start"].(string)),
+				ExpiryTime: pointer.To(policy["expiry"].(string)),
+				Permission: policy["permissions"].(string),
+			}
+		}
+
+		results = append(results, identifier)
+	}
+
+	return &results
+}
+
+func flattenStorageTableACLsManagementPlane(input *[]tableservice.TableSignedIdentifier) []interface{} {
+	result := make([]interface{}, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		accessPolicies := make([]interface{}, 0)
+		if policy := v.AccessPolicy; policy != nil {
+			accessPolicies = append(accessPolicies, map[string]interface{}{
+				"start":       pointer.From(policy.StartTime),
+				"expiry":      pointer.From(policy.ExpiryTime),
+				"permissions": policy.Permission,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"id":            v.Id,
+			"access_policy": accessPolicies,
+		})
+	}
+
+	return result
+}
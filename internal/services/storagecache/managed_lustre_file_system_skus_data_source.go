@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/skus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagedLustreFileSystemSkusDataSource struct{}
+
+var _ sdk.DataSource = ManagedLustreFileSystemSkusDataSource{}
+
+type ManagedLustreFileSystemSkusDataSourceModel struct {
+	Location string                       `tfschema:"location"`
+	Zone     string                       `tfschema:"zone"`
+	Skus     []ManagedLustreFileSystemSku `tfschema:"skus"`
+}
+
+type ManagedLustreFileSystemSku struct {
+	Name                       string            `tfschema:"name"`
+	ThroughputPerTbInMBps      int64             `tfschema:"throughput_per_tb_in_mbps"`
+	MinimumStorageCapacityInTb int64             `tfschema:"minimum_storage_capacity_in_tb"`
+	Zones                      []string          `tfschema:"zones"`
+	Capabilities               map[string]string `tfschema:"capabilities"`
+}
+
+// managedLustreFileSystemSkuResourceType is the resource type of the SKUs which can be used for a Managed Lustre File System,
+// the SKUs API also returns the SKUs for HPC Caches
+const managedLustreFileSystemSkuResourceType = "amlFilesystems"
+
+func (r ManagedLustreFileSystemSkusDataSource) ResourceType() string {
+	return "azurerm_managed_lustre_file_system_skus"
+}
+
+func (r ManagedLustreFileSystemSkusDataSource) ModelObject() interface{} {
+	return &ManagedLustreFileSystemSkusDataSourceModel{}
+}
+
+func (r ManagedLustreFileSystemSkusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemSkusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"skus": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"throughput_per_tb_in_mbps": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"minimum_storage_capacity_in_tb": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"zones": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"capabilities": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemSkusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageCache.SKUs
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state ManagedLustreFileSystemSkusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			subscription := commonids.NewSubscriptionID(subscriptionId)
+			locationName := location.Normalize(state.Location)
+
+			resp, err := client.ListComplete(ctx, subscription)
+			if err != nil {
+				return fmt.Errorf("listing SKUs for %s: %+v", subscription, err)
+			}
+
+			result := make([]ManagedLustreFileSystemSku, 0)
+			for _, item := range resp.Items {
+				if !strings.EqualFold(pointer.From(item.ResourceType), managedLustreFileSystemSkuResourceType) {
+					continue
+				}
+
+				if sku, ok := flattenManagedLustreFileSystemSku(item, locationName, state.Zone); ok {
+					result = append(result, sku)
+				}
+			}
+
+			sort.Slice(result, func(i, j int) bool {
+				return result[i].Name < result[j].Name
+			})
+			state.Skus = result
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.StorageCache/locations/%s/skus", subscription.ID(), locationName))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenManagedLustreFileSystemSku returns the Managed Lustre File System SKU, and whether it's available in the location
+// (and zone, when specified) for this subscription
+func flattenManagedLustreFileSystemSku(input skus.ResourceSku, locationName string, zone string) (ManagedLustreFileSystemSku, bool) {
+	sku := ManagedLustreFileSystemSku{
+		Name:         pointer.From(input.Name),
+		Zones:        make([]string, 0),
+		Capabilities: make(map[string]string),
+	}
+
+	availableInLocation := false
+	for _, v := range pointer.From(input.Locations) {
+		if strings.EqualFold(location.Normalize(v), locationName) {
+			availableInLocation = true
+		}
+	}
+	for _, info := range pointer.From(input.LocationInfo) {
+		if strings.EqualFold(location.Normalize(pointer.From(info.Location)), locationName) {
+			availableInLocation = true
+			sku.Zones = append(sku.Zones, pointer.From(info.Zones)...)
+		}
+	}
+	if !availableInLocation {
+		return sku, false
+	}
+
+	for _, restriction := range pointer.From(input.Restrictions) {
+		if !strings.EqualFold(pointer.From(restriction.Type), "Location") {
+			continue
+		}
+
+		for _, v := range pointer.From(restriction.Values) {
+			if strings.EqualFold(location.Normalize(v), locationName) {
+				return sku, false
+			}
+		}
+	}
+	sort.Strings(sku.Zones)
+
+	if zone != "" {
+		available := false
+		for _, v := range sku.Zones {
+			if strings.EqualFold(v, zone) {
+				available = true
+			}
+		}
+		if !available {
+			return sku, false
+		}
+	}
+
+	for _, capability := range pointer.From(input.Capabilities) {
+		if capability.Name == nil || capability.Value == nil {
+			continue
+		}
+		sku.Capabilities[*capability.Name] = *capability.Value
+	}
+
+	// the throughput is part of the SKU name, e.g. `AMLFS-Durable-Premium-125` provides 125 MB/s per TiB of storage
+	if i := strings.LastIndex(sku.Name, "-"); i != -1 {
+		if v, err := strconv.ParseInt(sku.Name[i+1:], 10, 64); err == nil {
+			sku.ThroughputPerTbInMBps = v
+		}
+	}
+
+	if properties := GetSkuPropertiesByName(sku.Name); properties != nil {
+		sku.MinimumStorageCapacityInTb = int64(properties.MinimumStorage)
+	}
+
+	return sku, true
+}
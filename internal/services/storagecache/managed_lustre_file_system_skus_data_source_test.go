@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storagecache_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagedLustreFileSystemSkusDataSource struct{}
+
+func TestAccManagedLustreFileSystemSkusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_managed_lustre_file_system_skus", "test")
+	r := ManagedLustreFileSystemSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("skus.0.name").IsNotEmpty(),
+				check.That(data.ResourceName).Key("skus.0.throughput_per_tb_in_mbps").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func TestAccManagedLustreFileSystemSkusDataSource_zone(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_managed_lustre_file_system_skus", "test")
+	r := ManagedLustreFileSystemSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.zone(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").IsNotEmpty(),
+				check.That(data.ResourceName).Key("skus.0.zones.#").IsNotEmpty(),
+			),
+		},
+	})
+}
+
+func (ManagedLustreFileSystemSkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_managed_lustre_file_system_skus" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
+
+func (ManagedLustreFileSystemSkusDataSource) zone(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_managed_lustre_file_system_skus" "test" {
+  location = "%s"
+  zone     = "1"
+}
+`, data.Locations.Primary)
+}
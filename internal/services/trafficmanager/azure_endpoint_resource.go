@@ -123,7 +123,6 @@ func resourceAzureEndpoint() *pluginsdk.Resource {
 
 			"subnet": {
 				Type:     pluginsdk.TypeList,
-				ForceNew: true,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -194,6 +193,11 @@ func resourceAzureEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) er
 		status = endpoints.EndpointStatusDisabled
 	}
 
+	subnets, err := expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	params := endpoints.Endpoint{
 		Name: utils.String(id.EndpointName),
 		Type: utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", endpoints.EndpointTypeAzureEndpoints)),
@@ -202,7 +206,7 @@ func resourceAzureEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) er
 			AlwaysServe:      pointer.To(endpoints.AlwaysServeDisabled),
 			EndpointStatus:   &status,
 			TargetResourceId: utils.String(d.Get("target_resource_id").(string)),
-			Subnets:          expandEndpointSubnetConfig(d.Get("subnet").([]interface{})),
+			Subnets:          subnets,
 		},
 	}
 
@@ -335,7 +339,11 @@ func resourceAzureEndpointUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("subnet") {
-		params.Properties.Subnets = expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+		subnets, err := expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+		if err != nil {
+			return err
+		}
+		params.Properties.Subnets = subnets
 	}
 
 	if d.HasChange("priority") {
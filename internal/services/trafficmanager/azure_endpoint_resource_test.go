@@ -108,6 +108,29 @@ func TestAccAzureEndpoint_subnets(t *testing.T) {
 	})
 }
 
+func TestAccAzureEndpoint_subnetsUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_azure_endpoint", "test")
+	r := AzureEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.subnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.subnetsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r AzureEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := endpoints.ParseEndpointTypeID(state.ID)
 	if err != nil {
@@ -298,6 +321,56 @@ resource "azurerm_traffic_manager_azure_endpoint" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (r AzureEndpointResource) subnetsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctest-TMP-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "Subnet"
+
+  dns_config {
+    relative_name = "acctest-tmp-%[1]d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "HTTPS"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  domain_name_label   = "acctestpublicip-%[1]d"
+}
+
+resource "azurerm_traffic_manager_azure_endpoint" "test" {
+  name               = "acctestend-azure%[1]d"
+  target_resource_id = azurerm_public_ip.test.id
+  weight             = 5
+  profile_id         = azurerm_traffic_manager_profile.test.id
+
+  subnet {
+    first = "10.0.0.0"
+    last  = "10.0.0.255"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (r AzureEndpointResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -4,6 +4,8 @@
 package trafficmanager
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -45,25 +47,37 @@ func flattenEndpointCustomHeaderConfig(input *[]endpoints.EndpointPropertiesCust
 	return result
 }
 
-func expandEndpointSubnetConfig(input []interface{}) *[]endpoints.EndpointPropertiesSubnetsInlined {
+func expandEndpointSubnetConfig(input []interface{}) (*[]endpoints.EndpointPropertiesSubnetsInlined, error) {
 	output := make([]endpoints.EndpointPropertiesSubnetsInlined, 0)
 
 	for _, subnet := range input {
 		subnetBlock := subnet.(map[string]interface{})
-		if subnetBlock["scope"].(int) == 0 && subnetBlock["first"].(string) != "0.0.0.0" {
+		first := subnetBlock["first"].(string)
+		last := subnetBlock["last"].(string)
+		scope := subnetBlock["scope"].(int)
+
+		if last != "" && scope != 0 {
+			return nil, fmt.Errorf("only one of `last` and `scope` can be specified for the `subnet` starting at %q", first)
+		}
+
+		// a `scope` of 0 is only meaningful when matching every address (`0.0.0.0/0`), otherwise a range is required
+		if scope == 0 && first != "0.0.0.0" {
+			if last == "" {
+				return nil, fmt.Errorf("one of `last` or `scope` must be specified for the `subnet` starting at %q", first)
+			}
 			output = append(output, endpoints.EndpointPropertiesSubnetsInlined{
-				First: utils.String(subnetBlock["first"].(string)),
-				Last:  utils.String(subnetBlock["last"].(string)),
+				First: utils.String(first),
+				Last:  utils.String(last),
 			})
 		} else {
 			output = append(output, endpoints.EndpointPropertiesSubnetsInlined{
-				First: utils.String(subnetBlock["first"].(string)),
-				Scope: utils.Int64(int64(subnetBlock["scope"].(int))),
+				First: utils.String(first),
+				Scope: utils.Int64(int64(scope)),
 			})
 		}
 	}
 
-	return &output
+	return &output, nil
 }
 
 func flattenEndpointSubnetConfig(input *[]endpoints.EndpointPropertiesSubnetsInlined) []interface{} {
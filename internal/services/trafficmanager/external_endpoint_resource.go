@@ -131,7 +131,6 @@ func resourceExternalEndpoint() *pluginsdk.Resource {
 
 			"subnet": {
 				Type:     pluginsdk.TypeList,
-				ForceNew: true,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -202,6 +201,11 @@ func resourceExternalEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 		status = endpoints.EndpointStatusDisabled
 	}
 
+	subnets, err := expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	params := endpoints.Endpoint{
 		Name: utils.String(id.EndpointName),
 		Type: utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", endpoints.EndpointTypeExternalEndpoints)),
@@ -210,7 +214,7 @@ func resourceExternalEndpointCreate(d *pluginsdk.ResourceData, meta interface{})
 			CustomHeaders:  expandEndpointCustomHeaderConfig(d.Get("custom_header").([]interface{})),
 			EndpointStatus: &status,
 			Target:         utils.String(d.Get("target").(string)),
-			Subnets:        expandEndpointSubnetConfig(d.Get("subnet").([]interface{})),
+			Subnets:        subnets,
 		},
 	}
 
@@ -348,7 +352,11 @@ func resourceExternalEndpointUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("subnet") {
-		params.Properties.Subnets = expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+		subnets, err := expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+		if err != nil {
+			return err
+		}
+		params.Properties.Subnets = subnets
 	}
 
 	if d.HasChange("priority") {
@@ -141,7 +141,6 @@ func resourceNestedEndpoint() *pluginsdk.Resource {
 
 			"subnet": {
 				Type:     pluginsdk.TypeList,
-				ForceNew: true,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
@@ -214,6 +213,11 @@ func resourceNestedEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		status = endpoints.EndpointStatusDisabled
 	}
 
+	subnets, err := expandEndpointSubnetConfig(d.Get("subnet").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	params := endpoints.Endpoint{
 		Name: utils.String(id.EndpointName),
 		Type: utils.String(fmt.Sprintf("Microsoft.Network/trafficManagerProfiles/%s", endpoints.EndpointTypeNestedEndpoints)),
@@ -222,7 +226,7 @@ func resourceNestedEndpointCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 			EndpointStatus:    &status,
 			MinChildEndpoints: utils.Int64(int64(d.Get("minimum_child_endpoints").(int))),
 			TargetResourceId:  utils.String(d.Get("target_resource_id").(string)),
-			Subnets:           expandEndpointSubnetConfig(d.Get("subnet").([]interface{})),
+			Subnets:           subnets,
 		},
 	}
 
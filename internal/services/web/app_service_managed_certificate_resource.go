@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -47,6 +48,16 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				ValidateFunc: validate.AppServiceCustomHostnameBindingID,
 			},
 
+			"domain_validation_method": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"cname-delegation",
+					"http-token",
+				}, false),
+			},
+
 			"canonical_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -156,6 +167,12 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 		Tags:     tags.Expand(t),
 	}
 
+	// apex domains can't be validated using a CNAME record, so the ownership is validated using an HTTP token served by
+	// the App Service which the A record of the domain points to
+	if v := d.Get("domain_validation_method").(string); v != "" {
+		certificate.CertificateProperties.DomainValidationMethod = utils.String(v)
+	}
+
 	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
 		// API returns 202 where 200 is expected - https://github.com/Azure/azure-sdk-for-go/issues/13665
 		if !utils.ResponseWasStatusCode(resp.Response, 202) {
@@ -217,6 +234,9 @@ func resourceAppServiceManagedCertificateRead(d *pluginsdk.ResourceData, meta in
 	}
 
 	if props := resp.CertificateProperties; props != nil {
+		if props.DomainValidationMethod != nil {
+			d.Set("domain_validation_method", props.DomainValidationMethod)
+		}
 		d.Set("canonical_name", props.CanonicalName)
 		d.Set("friendly_name", props.FriendlyName)
 		d.Set("subject_name", props.SubjectName)
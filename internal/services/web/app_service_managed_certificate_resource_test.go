@@ -77,6 +77,26 @@ func TestAccAppServiceManagedCertificate_basicWindows(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceManagedCertificate_httpTokenValidation(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_managed_certificate", "test")
+	r := AppServiceManagedCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.httpTokenValidation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("domain_validation_method").HasValue("http-token"),
+			),
+		},
+	})
+}
+
 func (t AppServiceManagedCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ManagedCertificateID(state.ID)
 	if err != nil {
@@ -105,6 +125,18 @@ resource "azurerm_app_service_managed_certificate" "test" {
 `, template)
 }
 
+func (t AppServiceManagedCertificateResource) httpTokenValidation(data acceptance.TestData) string {
+	template := t.linuxTemplate(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_managed_certificate" "test" {
+  custom_hostname_binding_id = azurerm_app_service_custom_hostname_binding.test.id
+  domain_validation_method   = "http-token"
+}
+`, template)
+}
+
 func (t AppServiceManagedCertificateResource) requiresImport(data acceptance.TestData) string {
 	template := t.basicLinux(data)
 	return fmt.Sprintf(`
@@ -33,6 +33,7 @@ type WorkloadsSAPDiscoveryVirtualInstanceModel struct {
 	Identity                  []identity.ModelUserAssigned `tfschema:"identity"`
 	ManagedResourceGroupName  string                       `tfschema:"managed_resource_group_name"`
 	ManagedStorageAccountName string                       `tfschema:"managed_storage_account_name"`
+	RunningEnabled            bool                         `tfschema:"running_enabled"`
 	SapProduct                string                       `tfschema:"sap_product"`
 	Tags                      map[string]string            `tfschema:"tags"`
 }
@@ -101,6 +102,12 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Arguments() map[string]*pl
 			ValidateFunc: storageValidate.StorageAccountName,
 		},
 
+		"running_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
 		"identity": commonschema.UserAssignedIdentityOptional(),
 
 		"tags": commonschema.Tags(),
@@ -169,6 +176,14 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Create() sdk.ResourceFunc
 			}
 
 			metadata.SetID(id)
+
+			// the discovered SAP system is left in whichever state it was in, so it only needs stopping here
+			if !model.RunningEnabled {
+				if err := client.StopThenPoll(ctx, id, sapvirtualinstances.StopRequest{}); err != nil {
+					return fmt.Errorf("stopping the SAP system of %s: %+v", id, err)
+				}
+			}
+
 			return nil
 		},
 	}
@@ -204,8 +219,22 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Update() sdk.ResourceFunc
 				parameters.Tags = &model.Tags
 			}
 
-			if _, err := client.Update(ctx, *id, *parameters); err != nil {
-				return fmt.Errorf("updating %s: %+v", *id, err)
+			if metadata.ResourceData.HasChanges("identity", "tags") {
+				if _, err := client.Update(ctx, *id, *parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("running_enabled") {
+				if model.RunningEnabled {
+					if err := client.StartThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("starting the SAP system of %s: %+v", *id, err)
+					}
+				} else {
+					if err := client.StopThenPoll(ctx, *id, sapvirtualinstances.StopRequest{}); err != nil {
+						return fmt.Errorf("stopping the SAP system of %s: %+v", *id, err)
+					}
+				}
 			}
 
 			return nil
@@ -233,7 +262,9 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
-			state := WorkloadsSAPDiscoveryVirtualInstanceModel{}
+			state := WorkloadsSAPDiscoveryVirtualInstanceModel{
+				RunningEnabled: metadata.ResourceData.Get("running_enabled").(bool),
+			}
 			if model := resp.Model; model != nil {
 				state.Name = id.SapVirtualInstanceName
 				state.ResourceGroupName = id.ResourceGroupName
@@ -247,6 +278,9 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Read() sdk.ResourceFunc {
 
 				props := &model.Properties
 				state.Environment = string(props.Environment)
+				if running, known := sapVirtualInstanceIsRunning(props.Status); known {
+					state.RunningEnabled = running
+				}
 				state.SapProduct = string(props.SapProduct)
 				state.Tags = pointer.From(model.Tags)
 
@@ -286,3 +320,16 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Delete() sdk.ResourceFunc
 		},
 	}
 }
+
+// sapVirtualInstanceIsRunning returns whether the SAP system is (being) started, and whether that's known at all - the
+// status is `Unavailable` until the SAP system has been discovered. A partially running SAP system is considered
+// running, since it has to be stopped to bring it into a consistent state
+func sapVirtualInstanceIsRunning(status *sapvirtualinstances.SAPVirtualInstanceStatus) (running bool, known bool) {
+	switch pointer.From(status) {
+	case sapvirtualinstances.SAPVirtualInstanceStatusRunning, sapvirtualinstances.SAPVirtualInstanceStatusPartiallyRunning, sapvirtualinstances.SAPVirtualInstanceStatusStarting:
+		return true, true
+	case sapvirtualinstances.SAPVirtualInstanceStatusOffline, sapvirtualinstances.SAPVirtualInstanceStatusSoftShutdown, sapvirtualinstances.SAPVirtualInstanceStatusStopping:
+		return false, true
+	}
+	return false, false
+}
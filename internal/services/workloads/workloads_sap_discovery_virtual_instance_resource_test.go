@@ -97,6 +97,7 @@ func testAccWorkloadsSAPDiscoveryVirtualInstance_update(t *testing.T) {
 			Config: r.update(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("running_enabled").HasValue("false"),
 			),
 		},
 		data.ImportStep(),
@@ -242,6 +243,7 @@ resource "azurerm_workloads_sap_discovery_virtual_instance" "test" {
   managed_resource_group_name       = "acctestmanagedRG%d"
   central_server_virtual_machine_id = "%s"
   managed_storage_account_name      = "acctestmanagedsa%s"
+  running_enabled                   = false
 
   tags = {
     env = "Test2"
@@ -5,6 +5,8 @@ package pluginsdk
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,6 +75,55 @@ func ForceNewIfChange(key string, f ValueChangeConditionFunc) CustomizeDiffFunc
 	}
 }
 
+// ChangedForceNewKeys returns the (sorted) paths of the ForceNew fields within the schema which have changed, including
+// those nested within blocks (e.g. `network_acls.0.bypass`) - that is, the fields causing an existing resource to be
+// deleted and then recreated.
+func ChangedForceNewKeys(d *schema.ResourceDiff, s map[string]*schema.Schema) []string {
+	keys := make([]string, 0)
+	if d.Id() == "" {
+		return keys
+	}
+
+	seen := make(map[string]struct{})
+	for _, changedKey := range d.GetChangedKeysPrefix("") {
+		key := forceNewKeyForPath(s, strings.Split(changedKey, "."))
+		if key == "" || !d.HasChange(key) {
+			continue
+		}
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// forceNewKeyForPath returns the path to the outermost ForceNew field containing the field at `path`, if any
+func forceNewKeyForPath(s map[string]*schema.Schema, path []string) string {
+	current := s
+	for i := 0; i < len(path); i++ {
+		v, ok := current[path[i]]
+		if !ok {
+			return ""
+		}
+		if v.ForceNew {
+			return strings.Join(path[:i+1], ".")
+		}
+
+		nested, ok := v.Elem.(*schema.Resource)
+		if !ok {
+			return ""
+		}
+		// the next segment is the index (or hash) of the item within the list (or set)
+		i++
+		current = nested.Schema
+	}
+
+	return ""
+}
+
 // ForceNewIf returns a CustomizeDiffFunc that flags the given key as
 // requiring a new resource if the given condition function returns true.
 //
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package readmode
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	// Full retrieves every sub-resource of the resource on each refresh.
+	Full = "full"
+
+	// Shallow only retrieves the sub-resources of the resource when the etag covering them has changed since the
+	// last refresh - either the etag of the resource itself, or (for sub-resources which are versioned independently
+	// of the resource) the etag of the sub-resource, see ChildEtags.
+	Shallow = "shallow"
+)
+
+// Schema returns the `read_mode` argument for resources which support skipping expensive reads during a refresh.
+func Schema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Default:      Full,
+		ValidateFunc: validation.StringInSlice([]string{Full, Shallow}, false),
+	}
+}
+
+// EtagSchema returns the `etag` attribute, which holds the etag of the resource as of the last refresh.
+func EtagSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Computed: true,
+	}
+}
+
+// ChildEtagsSchema returns the `child_etags` attribute, which holds the etags of the sub-resources which are versioned
+// independently of the resource as of the last refresh.
+func ChildEtagsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+// Track records the read mode and the etag returned by the API into the state, returning whether the resource is using
+// the `shallow` read mode and is unchanged since the last refresh - in which case the expensive reads can be skipped
+// and the values already in the state retained.
+func Track(d *pluginsdk.ResourceData, etag *string) bool {
+	mode := d.Get("read_mode").(string)
+	if mode == "" {
+		// the default isn't applied when importing
+		mode = Full
+	}
+	previous := d.Get("etag").(string)
+	current := pointer.From(etag)
+
+	d.Set("read_mode", mode)
+	d.Set("etag", current)
+
+	return mode == Shallow && !d.IsNewResource() && previous != "" && previous == current
+}
+
+// ChildEtags tracks the etags of the sub-resources of a resource which are versioned independently of it - and so
+// whose changes aren't reflected in the etag of the resource. Record is safe to call concurrently.
+type ChildEtags struct {
+	shallow  bool
+	previous map[string]interface{}
+
+	mu      sync.Mutex
+	current map[string]interface{}
+}
+
+// NewChildEtags returns a ChildEtags for the resource, which must already have been passed to Track.
+func NewChildEtags(d *pluginsdk.ResourceData) *ChildEtags {
+	return &ChildEtags{
+		shallow:  d.Get("read_mode").(string) == Shallow && !d.IsNewResource(),
+		previous: d.Get("child_etags").(map[string]interface{}),
+		current:  make(map[string]interface{}),
+	}
+}
+
+// Shallow returns whether the resource is using the `shallow` read mode - in which case the etag of each sub-resource
+// should be retrieved (and passed to Record) before calling Changed.
+func (c *ChildEtags) Shallow() bool {
+	return c.shallow
+}
+
+// Record records the etag of the sub-resource `name` from the response returned by the API.
+func (c *ChildEtags) Record(name string, resp *http.Response) {
+	var etag string
+	if resp != nil {
+		etag = resp.Header.Get("ETag")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.current[name] = etag
+}
+
+// Changed returns whether the sub-resource `name` needs to be retrieved - which is always the case when using the
+// `full` read mode, otherwise only when its etag has changed since the last refresh.
+func (c *ChildEtags) Changed(name string) bool {
+	if !c.shallow {
+		return true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	previous, ok := c.previous[name]
+	current, recorded := c.current[name]
+	return !ok || !recorded || previous != current
+}
+
+// Set records the etags of the sub-resources into the state.
+func (c *ChildEtags) Set(d *pluginsdk.ResourceData) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return d.Set("child_etags", c.current)
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package readmode
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestTrack(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Mode     string
+		Previous string
+		Current  *string
+		Expected bool
+	}{
+		{
+			Name:     "full mode",
+			Mode:     Full,
+			Previous: "abc",
+			Current:  pointer.To("abc"),
+			Expected: false,
+		},
+		{
+			Name:     "mode not set when importing",
+			Mode:     "",
+			Previous: "abc",
+			Current:  pointer.To("abc"),
+			Expected: false,
+		},
+		{
+			Name:     "shallow mode unchanged",
+			Mode:     Shallow,
+			Previous: "abc",
+			Current:  pointer.To("abc"),
+			Expected: true,
+		},
+		{
+			Name:     "shallow mode changed",
+			Mode:     Shallow,
+			Previous: "abc",
+			Current:  pointer.To("def"),
+			Expected: false,
+		},
+		{
+			Name:     "shallow mode without a previous etag",
+			Mode:     Shallow,
+			Previous: "",
+			Current:  pointer.To("abc"),
+			Expected: false,
+		},
+		{
+			Name:     "shallow mode without an etag",
+			Mode:     Shallow,
+			Previous: "",
+			Current:  nil,
+			Expected: false,
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"read_mode": Schema(),
+			"etag":      EtagSchema(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := resource.Data(&terraform.InstanceState{
+			ID: "test",
+			Attributes: map[string]string{
+				"read_mode": v.Mode,
+				"etag":      v.Previous,
+			},
+		})
+
+		if actual := Track(d, v.Current); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+
+		if expected, actual := pointer.From(v.Current), d.Get("etag").(string); actual != expected {
+			t.Fatalf("expected the etag %q to be recorded but got %q", expected, actual)
+		}
+
+		if d.Get("read_mode").(string) == "" {
+			t.Fatalf("expected `read_mode` to be set")
+		}
+	}
+}
+
+func TestChildEtags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Mode     string
+		Previous string
+		Current  string
+		Expected bool
+	}{
+		{
+			Name:     "full mode",
+			Mode:     Full,
+			Previous: "abc",
+			Current:  "abc",
+			Expected: true,
+		},
+		{
+			Name:     "shallow mode unchanged",
+			Mode:     Shallow,
+			Previous: "abc",
+			Current:  "abc",
+			Expected: false,
+		},
+		{
+			Name:     "shallow mode changed",
+			Mode:     Shallow,
+			Previous: "abc",
+			Current:  "def",
+			Expected: true,
+		},
+		{
+			Name:     "shallow mode without a previous etag",
+			Mode:     Shallow,
+			Previous: "",
+			Current:  "abc",
+			Expected: true,
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"read_mode":   Schema(),
+			"etag":        EtagSchema(),
+			"child_etags": ChildEtagsSchema(),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		attributes := map[string]string{
+			"read_mode":     v.Mode,
+			"child_etags.%": "0",
+		}
+		if v.Previous != "" {
+			attributes["child_etags.%"] = "1"
+			attributes["child_etags.policy"] = v.Previous
+		}
+		d := resource.Data(&terraform.InstanceState{
+			ID:         "test",
+			Attributes: attributes,
+		})
+
+		childEtags := NewChildEtags(d)
+		if expected, actual := v.Mode == Shallow, childEtags.Shallow(); actual != expected {
+			t.Fatalf("expected Shallow to be %t but got %t", expected, actual)
+		}
+
+		childEtags.Record("policy", &http.Response{
+			Header: http.Header{
+				"Etag": []string{v.Current},
+			},
+		})
+		if actual := childEtags.Changed("policy"); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+
+		if err := childEtags.Set(d); err != nil {
+			t.Fatalf("setting `child_etags`: %+v", err)
+		}
+		if actual := d.Get("child_etags").(map[string]interface{})["policy"]; actual != v.Current {
+			t.Fatalf("expected the etag %q to be recorded but got %q", v.Current, actual)
+		}
+	}
+}
@@ -0,0 +1,443 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hclwrite"
+)
+
+const (
+	skuStandard = "Standard_AzureFrontDoor"
+	skuPremium  = "Premium_AzureFrontDoor"
+
+	// the `response_timeout_seconds` supported by a Front Door (standard/premium) Profile
+	minimumResponseTimeoutSeconds = 16
+	maximumResponseTimeoutSeconds = 240
+)
+
+var invalidLocalNameCharacters = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// generate returns the configuration of the Front Door (standard/premium) resources equivalent to each of the
+// `azurerm_frontdoor` resources, together with an `import` block for each of them - since these are created by
+// migrating the Front Door (classic) within Azure - and a `removed` block for each `azurerm_frontdoor` resource
+// so the classic resource is removed from the state without being destroyed.
+func generate(frontDoors []stateFrontDoor, skuName string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	removed := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, fd := range frontDoors {
+		profileId, err := migratedProfileId(fd.Values)
+		if err != nil {
+			return nil, fmt.Errorf("determining the ID of the Profile migrated from %q: %+v", fd.Address, err)
+		}
+
+		g := &frontDoorGenerator{
+			buf:       &buf,
+			frontDoor: fd.Values,
+			skuName:   skuName,
+			localName: localNameForAddress(fd.Address),
+			profileId: profileId,
+		}
+		fmt.Fprintf(&buf, "# Migrated from %s\n\n", fd.Address)
+		if err := g.generate(); err != nil {
+			return nil, fmt.Errorf("generating the configuration for %q: %+v", fd.Address, err)
+		}
+		for _, v := range g.imports {
+			fmt.Fprintf(&buf, "import {\n  to = %s\n  id = %s\n}\n\n", v.to, hclString(v.id))
+		}
+
+		// `removed` blocks can't refer to a specific instance of a resource using `count` or `for_each`
+		from := fd.Address
+		if i := strings.Index(from, "["); i != -1 && !strings.Contains(from[i:], ".") {
+			from = from[:i]
+		}
+		if !seen[from] {
+			seen[from] = true
+			removed = append(removed, from)
+		}
+	}
+
+	for _, from := range removed {
+		fmt.Fprintf(&buf, "removed {\n  from = %s\n\n  lifecycle {\n    destroy = false\n  }\n}\n\n", from)
+	}
+
+	return append(hclwrite.Format(bytes.TrimRight(buf.Bytes(), "\n")), '\n'), nil
+}
+
+type frontDoorGenerator struct {
+	buf       *bytes.Buffer
+	frontDoor frontDoor
+	skuName   string
+	localName string
+
+	// profileId is the resource ID of the Front Door (standard/premium) Profile created by the migration
+	profileId string
+
+	// imports are the resources generated so far, which are imported from the migrated Profile
+	imports []importBlock
+
+	// depth is the nesting level of the block currently being written
+	depth int
+}
+
+type importBlock struct {
+	to string
+	id string
+}
+
+func (g *frontDoorGenerator) generate() error {
+	fd := g.frontDoor
+
+	certificateNameCheckEnabled := true
+	responseTimeoutSeconds := 0
+	if len(fd.BackendPoolSettings) > 0 {
+		certificateNameCheckEnabled = fd.BackendPoolSettings[0].EnforceBackendPoolsCertificateNameCheck
+		responseTimeoutSeconds = fd.BackendPoolSettings[0].BackendPoolsSendReceiveTimeoutSeconds
+	}
+
+	g.resource("azurerm_cdn_frontdoor_profile", g.localName, g.profileId)
+	g.attribute("name", hclString(fd.Name))
+	g.attribute("resource_group_name", hclString(fd.ResourceGroupName))
+	g.attribute("sku_name", hclString(g.skuName))
+	if responseTimeoutSeconds >= minimumResponseTimeoutSeconds && responseTimeoutSeconds <= maximumResponseTimeoutSeconds {
+		g.attribute("response_timeout_seconds", strconv.Itoa(responseTimeoutSeconds))
+	}
+	if len(fd.Tags) > 0 {
+		g.tags(fd.Tags)
+	}
+	g.end()
+	profileId := fmt.Sprintf("azurerm_cdn_frontdoor_profile.%s.id", g.localName)
+
+	frontendEndpoints := make(map[string]frontendEndpoint)
+	defaultEndpoint := ""
+	for _, fe := range fd.FrontendEndpoints {
+		frontendEndpoints[fe.Name] = fe
+		if isDefaultFrontendEndpoint(fe) {
+			if defaultEndpoint == "" {
+				defaultEndpoint = fe.Name
+			}
+			g.resource("azurerm_cdn_frontdoor_endpoint", g.childName(fe.Name), g.profileId+"/afdEndpoints/"+fe.Name)
+			g.attribute("name", hclString(fe.Name))
+			g.attribute("cdn_frontdoor_profile_id", profileId)
+			g.end()
+		} else {
+			g.comment("the ownership of the Custom Domain %q has to be validated again using the `validation_token`", fe.HostName)
+			g.resource("azurerm_cdn_frontdoor_custom_domain", g.childName(fe.Name), g.profileId+"/customDomains/"+fe.Name)
+			g.attribute("name", hclString(fe.Name))
+			g.attribute("cdn_frontdoor_profile_id", profileId)
+			g.attribute("host_name", hclString(fe.HostName))
+			g.nested("tls")
+			g.attribute("certificate_type", hclString("ManagedCertificate"))
+			g.end()
+			g.end()
+		}
+
+		if fe.WebApplicationFirewallPolicyLinkId != "" {
+			g.todo("the Web Application Firewall Policy %q linked to the Frontend Endpoint %q has to be migrated to an `azurerm_cdn_frontdoor_firewall_policy` and associated using an `azurerm_cdn_frontdoor_security_policy`", fe.WebApplicationFirewallPolicyLinkId, fe.Name)
+		}
+	}
+	if defaultEndpoint == "" {
+		return fmt.Errorf("no Frontend Endpoint using the default `azurefd.net` host name was found")
+	}
+
+	healthProbes := make(map[string]healthProbe)
+	for _, v := range fd.BackendPoolHealthProbes {
+		healthProbes[v.Name] = v
+	}
+	loadBalancingSettings := make(map[string]loadBalancing)
+	for _, v := range fd.BackendPoolLoadBalancing {
+		loadBalancingSettings[v.Name] = v
+	}
+
+	// Session Affinity is configured on the Frontend Endpoint for Front Door (classic) but on the Origin Group for
+	// Front Door (standard/premium) - so enable it for the Origin Groups which are routed to from those Endpoints
+	sessionAffinity := make(map[string]bool)
+	for _, rule := range fd.RoutingRules {
+		if len(rule.ForwardingConfiguration) == 0 {
+			continue
+		}
+		for _, name := range rule.FrontendEndpoints {
+			if frontendEndpoints[name].SessionAffinityEnabled {
+				sessionAffinity[rule.ForwardingConfiguration[0].BackendPoolName] = true
+			}
+		}
+	}
+
+	backendPools := make(map[string]backendPool)
+	for _, pool := range fd.BackendPools {
+		backendPools[pool.Name] = pool
+
+		lb, ok := loadBalancingSettings[pool.LoadBalancingName]
+		if !ok {
+			return fmt.Errorf("the Load Balancing Settings %q used by the Backend Pool %q were not found", pool.LoadBalancingName, pool.Name)
+		}
+
+		g.resource("azurerm_cdn_frontdoor_origin_group", g.childName(pool.Name), g.profileId+"/originGroups/"+pool.Name)
+		g.attribute("name", hclString(pool.Name))
+		g.attribute("cdn_frontdoor_profile_id", profileId)
+		g.attribute("session_affinity_enabled", strconv.FormatBool(sessionAffinity[pool.Name]))
+		g.nested("load_balancing")
+		g.attribute("additional_latency_in_milliseconds", strconv.Itoa(lb.AdditionalLatencyMilliseconds))
+		g.attribute("sample_size", strconv.Itoa(lb.SampleSize))
+		g.attribute("successful_samples_required", strconv.Itoa(lb.SuccessfulSamplesRequired))
+		g.end()
+		if probe, ok := healthProbes[pool.HealthProbeName]; ok && probe.Enabled {
+			g.nested("health_probe")
+			g.attribute("interval_in_seconds", strconv.Itoa(probe.IntervalInSeconds))
+			g.attribute("path", hclString(probe.Path))
+			g.attribute("protocol", hclString(probe.Protocol))
+			g.attribute("request_type", hclString(probe.ProbeMethod))
+			g.end()
+		}
+		g.end()
+
+		for i, b := range pool.Backends {
+			originName := fmt.Sprintf("%s-origin-%d", pool.Name, i+1)
+			g.resource("azurerm_cdn_frontdoor_origin", g.originName(pool.Name, i), g.profileId+"/originGroups/"+pool.Name+"/origins/"+originName)
+			g.attribute("name", hclString(originName))
+			g.attribute("cdn_frontdoor_origin_group_id", fmt.Sprintf("azurerm_cdn_frontdoor_origin_group.%s.id", g.childName(pool.Name)))
+			g.attribute("enabled", strconv.FormatBool(b.Enabled))
+			g.attribute("certificate_name_check_enabled", strconv.FormatBool(certificateNameCheckEnabled))
+			g.attribute("host_name", hclString(b.Address))
+			g.attribute("http_port", strconv.Itoa(b.HttpPort))
+			g.attribute("https_port", strconv.Itoa(b.HttpsPort))
+			if b.HostHeader != "" {
+				g.attribute("origin_host_header", hclString(b.HostHeader))
+			}
+			g.attribute("priority", strconv.Itoa(b.Priority))
+			g.attribute("weight", strconv.Itoa(b.Weight))
+			g.end()
+		}
+	}
+
+	customDomainRoutes := make(map[string][]string)
+	customDomainOrder := make([]string, 0)
+	for _, rule := range fd.RoutingRules {
+		if len(rule.ForwardingConfiguration) == 0 {
+			g.todo("the Routing Rule %q uses a `redirect_configuration` which has to be migrated to a `url_redirect_action` within an `azurerm_cdn_frontdoor_rule`", rule.Name)
+			continue
+		}
+		forwarding := rule.ForwardingConfiguration[0]
+
+		pool, ok := backendPools[forwarding.BackendPoolName]
+		if !ok {
+			return fmt.Errorf("the Backend Pool %q used by the Routing Rule %q was not found", forwarding.BackendPoolName, rule.Name)
+		}
+
+		endpoint := ""
+		customDomains := make([]string, 0)
+		for _, name := range rule.FrontendEndpoints {
+			fe, ok := frontendEndpoints[name]
+			if !ok {
+				return fmt.Errorf("the Frontend Endpoint %q used by the Routing Rule %q was not found", name, rule.Name)
+			}
+			if isDefaultFrontendEndpoint(fe) {
+				if endpoint == "" {
+					endpoint = name
+				}
+				continue
+			}
+			customDomains = append(customDomains, name)
+		}
+		linkToDefaultDomain := endpoint != ""
+		if endpoint == "" {
+			endpoint = defaultEndpoint
+		}
+
+		routeName := g.childName(rule.Name)
+		g.resource("azurerm_cdn_frontdoor_route", routeName, g.profileId+"/afdEndpoints/"+endpoint+"/routes/"+rule.Name)
+		g.attribute("name", hclString(rule.Name))
+		g.attribute("cdn_frontdoor_endpoint_id", fmt.Sprintf("azurerm_cdn_frontdoor_endpoint.%s.id", g.childName(endpoint)))
+		g.attribute("cdn_frontdoor_origin_group_id", fmt.Sprintf("azurerm_cdn_frontdoor_origin_group.%s.id", g.childName(pool.Name)))
+		originIds := make([]string, 0)
+		for i := range pool.Backends {
+			originIds = append(originIds, fmt.Sprintf("azurerm_cdn_frontdoor_origin.%s.id", g.originName(pool.Name, i)))
+		}
+		g.attribute("cdn_frontdoor_origin_ids", hclList(originIds))
+		if len(customDomains) > 0 {
+			customDomainIds := make([]string, 0)
+			for _, name := range customDomains {
+				customDomainIds = append(customDomainIds, fmt.Sprintf("azurerm_cdn_frontdoor_custom_domain.%s.id", g.childName(name)))
+				if _, ok := customDomainRoutes[name]; !ok {
+					customDomainOrder = append(customDomainOrder, name)
+				}
+				customDomainRoutes[name] = append(customDomainRoutes[name], fmt.Sprintf("azurerm_cdn_frontdoor_route.%s.id", routeName))
+			}
+			g.attribute("cdn_frontdoor_custom_domain_ids", hclList(customDomainIds))
+		}
+		g.attribute("enabled", strconv.FormatBool(rule.Enabled))
+		g.attribute("forwarding_protocol", hclString(forwarding.ForwardingProtocol))
+		g.attribute("https_redirect_enabled", "false")
+		g.attribute("link_to_default_domain", strconv.FormatBool(linkToDefaultDomain))
+		g.attribute("patterns_to_match", hclStringList(rule.PatternsToMatch))
+		g.attribute("supported_protocols", hclStringList(rule.AcceptedProtocols))
+		if forwarding.CustomForwardingPath != "" {
+			g.attribute("cdn_frontdoor_origin_path", hclString(forwarding.CustomForwardingPath))
+		}
+		if forwarding.CacheEnabled {
+			g.nested("cache")
+			behaviour := queryStringCachingBehaviour(forwarding.CacheQueryParameterStripDirective)
+			g.attribute("query_string_caching_behavior", hclString(behaviour))
+			if len(forwarding.CacheQueryParameters) > 0 && strings.Contains(behaviour, "Specified") {
+				g.attribute("query_strings", hclStringList(forwarding.CacheQueryParameters))
+			}
+			g.attribute("compression_enabled", strconv.FormatBool(forwarding.CacheUseDynamicCompression))
+			g.end()
+		}
+		g.end()
+	}
+
+	for _, name := range customDomainOrder {
+		g.resource("azurerm_cdn_frontdoor_custom_domain_association", g.childName(name), g.profileId+"/associations/"+name)
+		g.attribute("cdn_frontdoor_custom_domain_id", fmt.Sprintf("azurerm_cdn_frontdoor_custom_domain.%s.id", g.childName(name)))
+		g.attribute("cdn_frontdoor_route_ids", hclList(customDomainRoutes[name]))
+		g.end()
+	}
+
+	return nil
+}
+
+func (g *frontDoorGenerator) childName(name string) string {
+	return fmt.Sprintf("%s_%s", g.localName, localName(name))
+}
+
+func (g *frontDoorGenerator) originName(poolName string, index int) string {
+	return fmt.Sprintf("%s_%d", g.childName(poolName), index+1)
+}
+
+// resource opens the block for a resource, which is imported from the resource with the ID `id`
+func (g *frontDoorGenerator) resource(resourceType, name, id string) {
+	g.block("resource", resourceType, name)
+	g.imports = append(g.imports, importBlock{
+		to: fmt.Sprintf("%s.%s", resourceType, name),
+		id: id,
+	})
+}
+
+func (g *frontDoorGenerator) block(blockType string, labels ...string) {
+	quoted := make([]string, 0)
+	for _, label := range labels {
+		quoted = append(quoted, strconv.Quote(label))
+	}
+	fmt.Fprintf(g.buf, "%s %s {\n", blockType, strings.Join(quoted, " "))
+	g.depth++
+}
+
+func (g *frontDoorGenerator) nested(blockType string) {
+	fmt.Fprintf(g.buf, "\n%s {\n", blockType)
+	g.depth++
+}
+
+func (g *frontDoorGenerator) end() {
+	g.buf.WriteString("}\n")
+	g.depth--
+	// a blank line is needed between top level blocks, which `hclwrite.Format` doesn't insert itself
+	if g.depth == 0 {
+		g.buf.WriteString("\n")
+	}
+}
+
+func (g *frontDoorGenerator) attribute(name, value string) {
+	fmt.Fprintf(g.buf, "%s = %s\n", name, value)
+}
+
+func (g *frontDoorGenerator) comment(format string, args ...interface{}) {
+	fmt.Fprintf(g.buf, "# %s\n", fmt.Sprintf(format, args...))
+}
+
+// todo writes a comment for something which can't be migrated automatically and needs to be done by hand
+func (g *frontDoorGenerator) todo(format string, args ...interface{}) {
+	fmt.Fprintf(g.buf, "# TODO: %s\n\n", fmt.Sprintf(format, args...))
+}
+
+func (g *frontDoorGenerator) tags(tags map[string]string) {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	g.buf.WriteString("\ntags = {\n")
+	for _, k := range keys {
+		fmt.Fprintf(g.buf, "%s = %s\n", hclString(k), hclString(tags[k]))
+	}
+	g.buf.WriteString("}\n")
+}
+
+// migratedProfileId returns the resource ID of the Front Door (standard/premium) Profile which the Front Door (classic)
+// is migrated to - which has the same name and is within the same Resource Group as the Front Door (classic)
+func migratedProfileId(input frontDoor) (string, error) {
+	// e.g. /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/frontDoors/example
+	segments := strings.Split(strings.Trim(input.Id, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") || segments[1] == "" {
+		return "", fmt.Errorf("expected the `id` to start with `/subscriptions/{subscriptionId}` but got %q", input.Id)
+	}
+
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s", segments[1], input.ResourceGroupName, input.Name), nil
+}
+
+// isDefaultFrontendEndpoint returns whether the Frontend Endpoint uses the default host name of the Front Door,
+// rather than a Custom Domain
+func isDefaultFrontendEndpoint(input frontendEndpoint) bool {
+	return strings.HasSuffix(strings.ToLower(input.HostName), ".azurefd.net")
+}
+
+// queryStringCachingBehaviour maps the `cache_query_parameter_strip_directive` of Front Door (classic) to the
+// `query_string_caching_behavior` of Front Door (standard/premium)
+func queryStringCachingBehaviour(input string) string {
+	switch input {
+	case "StripNone":
+		return "UseQueryString"
+	case "StripOnly":
+		return "IgnoreSpecifiedQueryStrings"
+	case "StripAllExcept":
+		return "IncludeSpecifiedQueryStrings"
+	}
+
+	// `StripAll` is the default for Front Door (classic)
+	return "IgnoreQueryString"
+}
+
+// localNameForAddress returns the local name to use for the resources generated from the resource at `address`
+func localNameForAddress(address string) string {
+	name := address
+	if i := strings.LastIndex(address, frontDoorResourceType+"."); i != -1 {
+		name = address[i+len(frontDoorResourceType)+1:]
+	}
+	return localName(name)
+}
+
+func localName(input string) string {
+	name := strings.Trim(invalidLocalNameCharacters.ReplaceAllString(input, "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "fd_" + name
+	}
+	return name
+}
+
+func hclString(input string) string {
+	quoted := strconv.Quote(input)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func hclStringList(input []string) string {
+	quoted := make([]string, 0, len(input))
+	for _, v := range input {
+		quoted = append(quoted, hclString(v))
+	}
+	return hclList(quoted)
+}
+
+func hclList(input []string) string {
+	return fmt.Sprintf("[%s]", strings.Join(input, ", "))
+}
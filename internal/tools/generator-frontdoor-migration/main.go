@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+func main() {
+	statePath := flag.String("state", "", "The path to the output of `terraform show -json` containing the `azurerm_frontdoor` resources to migrate")
+	skuName := flag.String("sku", skuStandard, fmt.Sprintf("The SKU of the generated `azurerm_cdn_frontdoor_profile`, either %q or %q", skuStandard, skuPremium))
+	flag.Parse()
+
+	if *statePath == "" {
+		log.Fatal("Usage: generator-frontdoor-migration -state <path> [-sku <sku_name>]")
+	}
+
+	if err := run(*statePath, *skuName, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(statePath, skuName string, output io.Writer) error {
+	if skuName != skuStandard && skuName != skuPremium {
+		return fmt.Errorf("`-sku` must be either %q or %q, got %q", skuStandard, skuPremium, skuName)
+	}
+
+	contents, err := os.ReadFile(statePath)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", statePath, err)
+	}
+
+	frontDoors, err := parseFrontDoors(contents)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", statePath, err)
+	}
+	if len(frontDoors) == 0 {
+		return fmt.Errorf("no `azurerm_frontdoor` resources were found in %q", statePath)
+	}
+
+	config, err := generate(frontDoors, skuName)
+	if err != nil {
+		return err
+	}
+
+	_, err = output.Write(config)
+	return err
+}
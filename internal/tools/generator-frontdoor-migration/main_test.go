@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+func TestRun(t *testing.T) {
+	expected, err := os.ReadFile("testdata/expected.tf.golden")
+	if err != nil {
+		t.Fatalf("reading the expected output: %+v", err)
+	}
+
+	var actual bytes.Buffer
+	if err := run("testdata/show.json", skuStandard, &actual); err != nil {
+		t.Fatalf("running: %+v", err)
+	}
+
+	if actual.String() != string(expected) {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(string(expected), actual.String(), false)
+		t.Fatalf("unexpected output:\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestRunInvalidSku(t *testing.T) {
+	var actual bytes.Buffer
+	if err := run("testdata/show.json", "Standard_Verizon", &actual); err == nil {
+		t.Fatalf("expected an error for an invalid SKU but didn't get one")
+	}
+}
+
+func TestParseFrontDoorsChildModules(t *testing.T) {
+	input := `{
+  "values": {
+    "root_module": {
+      "child_modules": [
+        {
+          "resources": [
+            {"address": "module.cdn.azurerm_frontdoor.example[0]", "mode": "managed", "type": "azurerm_frontdoor", "name": "example", "values": {"name": "first"}},
+            {"address": "module.cdn.azurerm_frontdoor.example[1]", "mode": "managed", "type": "azurerm_frontdoor", "name": "example", "values": {"name": "second"}},
+            {"address": "module.cdn.data.azurerm_frontdoor.existing", "mode": "data", "type": "azurerm_frontdoor", "name": "existing", "values": {"name": "third"}}
+          ]
+        }
+      ]
+    }
+  }
+}`
+
+	frontDoors, err := parseFrontDoors([]byte(input))
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	if len(frontDoors) != 2 {
+		t.Fatalf("expected 2 Front Doors but got %d", len(frontDoors))
+	}
+	if frontDoors[1].Values.Name != "second" {
+		t.Fatalf("expected the name of the second Front Door to be %q but got %q", "second", frontDoors[1].Values.Name)
+	}
+}
+
+func TestGenerateRemovedBlocks(t *testing.T) {
+	fd := frontDoor{
+		Id:                "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example-resources/providers/Microsoft.Network/frontDoors/example",
+		Name:              "example",
+		ResourceGroupName: "example-resources",
+		FrontendEndpoints: []frontendEndpoint{
+			{
+				Name:     "default",
+				HostName: "example.azurefd.net",
+			},
+		},
+	}
+
+	output, err := generate([]stateFrontDoor{
+		{Address: "module.cdn.azurerm_frontdoor.example[0]", Values: fd},
+		{Address: "module.cdn.azurerm_frontdoor.example[1]", Values: fd},
+	}, skuPremium)
+	if err != nil {
+		t.Fatalf("generating: %+v", err)
+	}
+
+	if count := strings.Count(string(output), "from = module.cdn.azurerm_frontdoor.example\n"); count != 1 {
+		t.Fatalf("expected a single `removed` block but got %d", count)
+	}
+	for _, expected := range []string{`resource "azurerm_cdn_frontdoor_profile" "example_0"`, `resource "azurerm_cdn_frontdoor_profile" "example_1"`} {
+		if !strings.Contains(string(output), expected) {
+			t.Fatalf("expected the output to contain %q", expected)
+		}
+	}
+}
+
+func TestLocalNameForAddress(t *testing.T) {
+	cases := map[string]string{
+		"azurerm_frontdoor.example":                "example",
+		"module.cdn.azurerm_frontdoor.example":     "example",
+		`azurerm_frontdoor.example["west-europe"]`: "example_west-europe",
+		"azurerm_frontdoor.example[0]":             "example_0",
+	}
+
+	for input, expected := range cases {
+		if actual := localNameForAddress(input); actual != expected {
+			t.Fatalf("expected the local name for %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestQueryStringCachingBehaviour(t *testing.T) {
+	cases := map[string]string{
+		"StripAll":       "IgnoreQueryString",
+		"StripNone":      "UseQueryString",
+		"StripOnly":      "IgnoreSpecifiedQueryStrings",
+		"StripAllExcept": "IncludeSpecifiedQueryStrings",
+		"":               "IgnoreQueryString",
+	}
+
+	for input, expected := range cases {
+		if actual := queryStringCachingBehaviour(input); actual != expected {
+			t.Fatalf("expected the query string caching behaviour for %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const frontDoorResourceType = "azurerm_frontdoor"
+
+// showOutput is the subset of the output of `terraform show -json` which is needed to find the resources
+type showOutput struct {
+	Values *struct {
+		RootModule stateModule `json:"root_module"`
+	} `json:"values"`
+}
+
+type stateModule struct {
+	Resources    []stateResource `json:"resources"`
+	ChildModules []stateModule   `json:"child_modules"`
+}
+
+type stateResource struct {
+	Address string          `json:"address"`
+	Mode    string          `json:"mode"`
+	Type    string          `json:"type"`
+	Name    string          `json:"name"`
+	Values  json.RawMessage `json:"values"`
+}
+
+// stateFrontDoor is an `azurerm_frontdoor` resource found in the state
+type stateFrontDoor struct {
+	Address string
+	Name    string
+	Values  frontDoor
+}
+
+type frontDoor struct {
+	Id                       string                `json:"id"`
+	Name                     string                `json:"name"`
+	ResourceGroupName        string                `json:"resource_group_name"`
+	BackendPools             []backendPool         `json:"backend_pool"`
+	BackendPoolHealthProbes  []healthProbe         `json:"backend_pool_health_probe"`
+	BackendPoolLoadBalancing []loadBalancing       `json:"backend_pool_load_balancing"`
+	BackendPoolSettings      []backendPoolSettings `json:"backend_pool_settings"`
+	FrontendEndpoints        []frontendEndpoint    `json:"frontend_endpoint"`
+	RoutingRules             []routingRule         `json:"routing_rule"`
+	Tags                     map[string]string     `json:"tags"`
+}
+
+type backendPool struct {
+	Name              string    `json:"name"`
+	HealthProbeName   string    `json:"health_probe_name"`
+	LoadBalancingName string    `json:"load_balancing_name"`
+	Backends          []backend `json:"backend"`
+}
+
+type backend struct {
+	Enabled    bool   `json:"enabled"`
+	Address    string `json:"address"`
+	HttpPort   int    `json:"http_port"`
+	HttpsPort  int    `json:"https_port"`
+	Weight     int    `json:"weight"`
+	Priority   int    `json:"priority"`
+	HostHeader string `json:"host_header"`
+}
+
+type healthProbe struct {
+	Name              string `json:"name"`
+	Enabled           bool   `json:"enabled"`
+	Path              string `json:"path"`
+	Protocol          string `json:"protocol"`
+	ProbeMethod       string `json:"probe_method"`
+	IntervalInSeconds int    `json:"interval_in_seconds"`
+}
+
+type loadBalancing struct {
+	Name                          string `json:"name"`
+	SampleSize                    int    `json:"sample_size"`
+	SuccessfulSamplesRequired     int    `json:"successful_samples_required"`
+	AdditionalLatencyMilliseconds int    `json:"additional_latency_milliseconds"`
+}
+
+type backendPoolSettings struct {
+	EnforceBackendPoolsCertificateNameCheck bool `json:"enforce_backend_pools_certificate_name_check"`
+	BackendPoolsSendReceiveTimeoutSeconds   int  `json:"backend_pools_send_receive_timeout_seconds"`
+}
+
+type frontendEndpoint struct {
+	Name                               string `json:"name"`
+	HostName                           string `json:"host_name"`
+	SessionAffinityEnabled             bool   `json:"session_affinity_enabled"`
+	WebApplicationFirewallPolicyLinkId string `json:"web_application_firewall_policy_link_id"`
+}
+
+type routingRule struct {
+	Name                    string                    `json:"name"`
+	Enabled                 bool                      `json:"enabled"`
+	AcceptedProtocols       []string                  `json:"accepted_protocols"`
+	PatternsToMatch         []string                  `json:"patterns_to_match"`
+	FrontendEndpoints       []string                  `json:"frontend_endpoints"`
+	ForwardingConfiguration []forwardingConfiguration `json:"forwarding_configuration"`
+	RedirectConfiguration   []json.RawMessage         `json:"redirect_configuration"`
+}
+
+type forwardingConfiguration struct {
+	BackendPoolName                   string   `json:"backend_pool_name"`
+	CustomForwardingPath              string   `json:"custom_forwarding_path"`
+	ForwardingProtocol                string   `json:"forwarding_protocol"`
+	CacheEnabled                      bool     `json:"cache_enabled"`
+	CacheQueryParameterStripDirective string   `json:"cache_query_parameter_strip_directive"`
+	CacheQueryParameters              []string `json:"cache_query_parameters"`
+	CacheUseDynamicCompression        bool     `json:"cache_use_dynamic_compression"`
+}
+
+// parseFrontDoors returns the managed `azurerm_frontdoor` resources within the output of `terraform show -json`,
+// including those within child modules
+func parseFrontDoors(input []byte) ([]stateFrontDoor, error) {
+	var show showOutput
+	if err := json.Unmarshal(input, &show); err != nil {
+		return nil, fmt.Errorf("unmarshaling the output of `terraform show -json`: %+v", err)
+	}
+	if show.Values == nil {
+		return nil, nil
+	}
+
+	return findFrontDoors(show.Values.RootModule)
+}
+
+func findFrontDoors(module stateModule) ([]stateFrontDoor, error) {
+	output := make([]stateFrontDoor, 0)
+	for _, r := range module.Resources {
+		if r.Mode != "managed" || r.Type != frontDoorResourceType {
+			continue
+		}
+
+		var values frontDoor
+		if err := json.Unmarshal(r.Values, &values); err != nil {
+			return nil, fmt.Errorf("unmarshaling the values of %q: %+v", r.Address, err)
+		}
+
+		output = append(output, stateFrontDoor{
+			Address: r.Address,
+			Name:    r.Name,
+			Values:  values,
+		})
+	}
+
+	for _, child := range module.ChildModules {
+		frontDoors, err := findFrontDoors(child)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, frontDoors...)
+	}
+
+	return output, nil
+}
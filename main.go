@@ -9,6 +9,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -21,6 +22,9 @@ func main() {
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	// the Audit Log is opened when the Provider is configured, and needs flushing once Terraform has stopped the Provider
+	defer common.CloseAuditLoggers()
+
 	if debugMode {
 		//nolint:staticcheck
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/azurerm",